@@ -125,6 +125,11 @@ type ErrProjectNotFound struct {
 	Message string `json:"message" example:"This project does not exist"`
 }
 
+type ErrMemberNotFound struct {
+	Code    int    `json:"code" example:"404"`
+	Message string `json:"message" example:"The user is not a member of this project"`
+}
+
 type ErrInvalidEmail struct {
 	Code    int    `json:"code" example:"400"`
 	Message string `json:"message" example:"Email address is invalid"`
@@ -26,12 +26,41 @@ func (s *ServerGrpc) ValidateRequest(ctx context.Context,
 	}
 
 	uid := claims["uid"].(string)
-	err = validations.RbacValidator(uid, inputRequest.ProjectId,
-		inputRequest.RequiredRoles, inputRequest.Invitation, s.ApplicationService)
+	scope := entities.ActionScope{
+		Action:          inputRequest.Action,
+		EnvironmentID:   inputRequest.EnvironmentId,
+		EnvironmentType: entities.EnvironmentType(inputRequest.EnvironmentType),
+	}
+	customRoles, err := validations.ActionValidator(uid, inputRequest.ProjectId,
+		inputRequest.RequiredRoles, inputRequest.Invitation, scope, s.ApplicationService)
 	if err != nil {
 		return &protos.ValidationResponse{Error: err.Error(), IsValid: false}, err
 	}
-	return &protos.ValidationResponse{Error: "", IsValid: true}, nil
+	return &protos.ValidationResponse{Error: "", IsValid: true, CustomRoles: getProtoProjectRoles(customRoles)}, nil
+}
+
+// getProtoProjectRoles converts the custom roles of a project to their gRPC equivalent
+func getProtoProjectRoles(roles []*entities.ProjectRole) []*protos.ProjectRole {
+	var protoRoles []*protos.ProjectRole
+	for _, role := range roles {
+		protoRole := &protos.ProjectRole{
+			Id:   role.RoleID,
+			Name: role.Name,
+		}
+		for _, permissionSet := range role.PermissionSets {
+			var environmentTypes []string
+			for _, envType := range permissionSet.EnvironmentTypes {
+				environmentTypes = append(environmentTypes, string(envType))
+			}
+			protoRole.PermissionSets = append(protoRole.PermissionSets, &protos.PermissionSet{
+				Actions:          permissionSet.Actions,
+				EnvironmentTypes: environmentTypes,
+				EnvironmentIds:   permissionSet.EnvironmentIDs,
+			})
+		}
+		protoRoles = append(protoRoles, protoRole)
+	}
+	return protoRoles
 }
 
 func (s *ServerGrpc) GetProjectById(ctx context.Context,
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"testing"
 
+	"github.com/golang-jwt/jwt"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/handlers/grpc"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/mocks"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter/protos"
@@ -166,3 +167,124 @@ func TestGetUserById(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateRequest(t *testing.T) {
+	project := &entities.Project{
+		ID: "project-id",
+		Members: []*entities.Member{
+			{
+				UserID:      "executor-id",
+				Role:        entities.RoleViewer,
+				Invitation:  entities.AcceptedInvitation,
+				CustomRoles: []string{"non-prod-runner"},
+			},
+		},
+		Roles: []*entities.ProjectRole{
+			{
+				RoleID: "non-prod-runner",
+				Name:   "Non prod runner",
+				PermissionSets: []entities.PermissionSet{
+					{
+						Actions:          []string{"ReRunChaosExperiment", "StopChaosExperiment"},
+						EnvironmentTypes: []entities.EnvironmentType{entities.EnvironmentTypeNonProd},
+					},
+				},
+			},
+			{
+				RoleID: "probe-author",
+				Name:   "Probe author",
+				PermissionSets: []entities.PermissionSet{
+					{Actions: []string{"AddProbe", "UpdateProbe"}},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		userID          string
+		request         *protos.ValidationRequest
+		expectedRoleIDs []string
+		expectedError   bool
+	}{
+		{
+			name:   "PositiveTestBuiltInRole",
+			userID: "executor-id",
+			request: &protos.ValidationRequest{
+				RequiredRoles: []string{string(entities.RoleOwner), string(entities.RoleViewer)},
+				Action:        "ListExperiment",
+			},
+			expectedRoleIDs: []string{"non-prod-runner"},
+		},
+		{
+			name:   "PositiveTestCustomRoleInScopedEnvironment",
+			userID: "executor-id",
+			request: &protos.ValidationRequest{
+				RequiredRoles:   []string{string(entities.RoleOwner)},
+				Action:          "ReRunChaosExperiment",
+				EnvironmentType: string(entities.EnvironmentTypeNonProd),
+			},
+			expectedRoleIDs: []string{"non-prod-runner"},
+		},
+		{
+			name:   "NegativeTestCustomRoleOutOfScopedEnvironment",
+			userID: "executor-id",
+			request: &protos.ValidationRequest{
+				RequiredRoles:   []string{string(entities.RoleOwner)},
+				Action:          "ReRunChaosExperiment",
+				EnvironmentType: string(entities.EnvironmentTypeProd),
+			},
+			expectedError: true,
+		},
+		{
+			name:   "NegativeTestActionNotGranted",
+			userID: "executor-id",
+			request: &protos.ValidationRequest{
+				RequiredRoles: []string{string(entities.RoleOwner)},
+				Action:        "AddProbe",
+			},
+			expectedError: true,
+		},
+		{
+			name:   "NegativeTestNotAMember",
+			userID: "other-id",
+			request: &protos.ValidationRequest{
+				RequiredRoles: []string{string(entities.RoleOwner)},
+				Action:        "ListExperiment",
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			mockService := &mocks.MockedApplicationService{}
+			s := &grpc.ServerGrpc{
+				ApplicationService: mockService,
+			}
+			token := &jwt.Token{Claims: jwt.MapClaims{"uid": tc.userID}, Valid: true}
+			mockService.On("ValidateToken", "jwt").Return(token, nil)
+			mockService.On("GetUser", tc.userID).Return(&entities.User{ID: tc.userID}, nil)
+			mockService.On("GetProjectByProjectID", project.ID).Return(project, nil)
+
+			tc.request.Jwt = "jwt"
+			tc.request.ProjectId = project.ID
+			tc.request.Invitation = string(entities.AcceptedInvitation)
+
+			resp, err := s.ValidateRequest(context.Background(), tc.request)
+
+			if tc.expectedError {
+				assert.Error(t, err)
+				assert.False(t, resp.IsValid)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, resp.IsValid)
+			var roleIDs []string
+			for _, role := range resp.CustomRoles {
+				roleIDs = append(roleIDs, role.Id)
+			}
+			assert.Equal(t, tc.expectedRoleIDs, roleIDs)
+		})
+	}
+}
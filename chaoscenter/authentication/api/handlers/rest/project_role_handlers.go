@@ -0,0 +1,328 @@
+package rest
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/validations"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ListProjectRoles 		godoc
+//
+//	@Summary		List project roles.
+//	@Description	Return the custom roles of a project.
+//	@Tags			ProjectRouter
+//	@Param			project_id	path	string	true	"Project ID"
+//	@Accept			json
+//	@Produce		json
+//	@Failure		400	{object}	response.ErrProjectNotFound
+//	@Failure		401	{object}	response.ErrUnauthorized
+//	@Failure		500	{object}	response.ErrServerError
+//	@Success		200	{object}	response.Response{}
+//	@Router			/list_project_roles/:project_id [get]
+//
+// ListProjectRoles returns the custom roles defined in the project
+func ListProjectRoles(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		projectID := c.Param("project_id")
+
+		err := validations.RbacValidator(c.MustGet("uid").(string),
+			projectID,
+			validations.MutationRbacRules["listProjectRoles"],
+			string(entities.AcceptedInvitation),
+			service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized],
+				presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		project, err := service.GetProjectByProjectID(projectID)
+		if err != nil {
+			log.Error(err)
+			if err == mongo.ErrNoDocuments {
+				c.JSON(utils.ErrorStatusCodes[utils.ErrProjectNotFound], presenter.CreateErrorResponse(utils.ErrProjectNotFound))
+				return
+			}
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		roles := project.Roles
+		if roles == nil {
+			roles = []*entities.ProjectRole{}
+		}
+		c.JSON(http.StatusOK, gin.H{"data": roles})
+	}
+}
+
+// CreateProjectRole 		godoc
+//
+//	@Summary		Create project role.
+//	@Description	Create a custom role in a project.
+//	@Tags			ProjectRouter
+//	@Accept			json
+//	@Produce		json
+//	@Failure		400	{object}	response.ErrInvalidRequest
+//	@Failure		400	{object}	response.ErrInvalidRole
+//	@Failure		401	{object}	response.ErrUnauthorized
+//	@Failure		500	{object}	response.ErrServerError
+//	@Success		200	{object}	response.Response{}
+//	@Router			/create_project_role [post]
+//
+// CreateProjectRole is used to create a custom role made of permission sets in the project
+func CreateProjectRole(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var roleRequest entities.ProjectRoleInput
+		err := c.BindJSON(&roleRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		if !isValidProjectRole(roleRequest) {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
+			return
+		}
+
+		err = validations.RbacValidator(c.MustGet("uid").(string),
+			roleRequest.ProjectID,
+			validations.MutationRbacRules["createProjectRole"],
+			string(entities.AcceptedInvitation),
+			service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized],
+				presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		role := &entities.ProjectRole{
+			RoleID:         uuid.Must(uuid.NewRandom()).String(),
+			Name:           roleRequest.Name,
+			Description:    roleRequest.Description,
+			PermissionSets: roleRequest.PermissionSets,
+			CreatedAt:      time.Now().UnixMilli(),
+			UpdatedAt:      time.Now().UnixMilli(),
+		}
+		err = service.AddProjectRole(roleRequest.ProjectID, role)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{"data": role})
+	}
+}
+
+// UpdateProjectRole 		godoc
+//
+//	@Summary		Update project role.
+//	@Description	Update a custom role of a project.
+//	@Tags			ProjectRouter
+//	@Accept			json
+//	@Produce		json
+//	@Failure		400	{object}	response.ErrInvalidRequest
+//	@Failure		400	{object}	response.ErrInvalidRole
+//	@Failure		401	{object}	response.ErrUnauthorized
+//	@Failure		500	{object}	response.ErrServerError
+//	@Success		200	{object}	response.Response{}
+//	@Router			/update_project_role [post]
+//
+// UpdateProjectRole is used to update the name, description and permission sets of a custom role in the project
+func UpdateProjectRole(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var roleRequest entities.ProjectRoleInput
+		err := c.BindJSON(&roleRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		if roleRequest.RoleID == "" || !isValidProjectRole(roleRequest) {
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
+			return
+		}
+
+		err = validations.RbacValidator(c.MustGet("uid").(string),
+			roleRequest.ProjectID,
+			validations.MutationRbacRules["updateProjectRole"],
+			string(entities.AcceptedInvitation),
+			service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized],
+				presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		err = service.UpdateProjectRole(roleRequest.ProjectID, &entities.ProjectRole{
+			RoleID:         roleRequest.RoleID,
+			Name:           roleRequest.Name,
+			Description:    roleRequest.Description,
+			PermissionSets: roleRequest.PermissionSets,
+			UpdatedAt:      time.Now().UnixMilli(),
+		})
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Successfully updated role",
+		})
+	}
+}
+
+// DeleteProjectRole 		godoc
+//
+//	@Summary		Delete project role.
+//	@Description	Delete a custom role of a project.
+//	@Tags			ProjectRouter
+//	@Accept			json
+//	@Produce		json
+//	@Failure		400	{object}	response.ErrInvalidRequest
+//	@Failure		401	{object}	response.ErrUnauthorized
+//	@Failure		500	{object}	response.ErrServerError
+//	@Success		200	{object}	response.Response{}
+//	@Router			/delete_project_role [post]
+//
+// DeleteProjectRole is used to delete a custom role of the project, the role is unassigned from its members
+func DeleteProjectRole(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var roleRequest entities.DeleteProjectRoleInput
+		err := c.BindJSON(&roleRequest)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		err = validations.RbacValidator(c.MustGet("uid").(string),
+			roleRequest.ProjectID,
+			validations.MutationRbacRules["deleteProjectRole"],
+			string(entities.AcceptedInvitation),
+			service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized],
+				presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		err = service.DeleteProjectRole(roleRequest.ProjectID, roleRequest.RoleID)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Successfully deleted role",
+		})
+	}
+}
+
+// UpdateMemberCustomRoles 		godoc
+//
+//	@Summary		Update member custom roles.
+//	@Description	Assign custom roles to a member of a project.
+//	@Tags			ProjectRouter
+//	@Accept			json
+//	@Produce		json
+//	@Failure		400	{object}	response.ErrInvalidRequest
+//	@Failure		400	{object}	response.ErrInvalidRole
+//	@Failure		401	{object}	response.ErrUnauthorized
+//	@Failure		404	{object}	response.ErrMemberNotFound
+//	@Failure		500	{object}	response.ErrServerError
+//	@Success		200	{object}	response.Response{}
+//	@Router			/update_member_custom_roles [post]
+//
+// UpdateMemberCustomRoles is used to replace the custom roles assigned to a member of the project
+func UpdateMemberCustomRoles(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		var member entities.MemberCustomRolesInput
+		err := c.BindJSON(&member)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRequest], presenter.CreateErrorResponse(utils.ErrInvalidRequest))
+			return
+		}
+
+		err = validations.RbacValidator(c.MustGet("uid").(string),
+			member.ProjectID,
+			validations.MutationRbacRules["updateMemberCustomRoles"],
+			string(entities.AcceptedInvitation),
+			service)
+		if err != nil {
+			log.Warn(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrUnauthorized],
+				presenter.CreateErrorResponse(utils.ErrUnauthorized))
+			return
+		}
+
+		project, err := service.GetProjectByProjectID(member.ProjectID)
+		if err != nil {
+			log.Error(err)
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		// Only the roles defined in the project can be assigned
+		for _, roleID := range member.RoleIDs {
+			found := false
+			for _, role := range project.Roles {
+				if role.RoleID == roleID {
+					found = true
+					break
+				}
+			}
+			if !found {
+				c.JSON(utils.ErrorStatusCodes[utils.ErrInvalidRole], presenter.CreateErrorResponse(utils.ErrInvalidRole))
+				return
+			}
+		}
+
+		err = service.UpdateMemberCustomRoles(member.ProjectID, member.UserID, member.RoleIDs)
+		if err != nil {
+			log.Error(err)
+			if err == mongo.ErrNoDocuments {
+				c.JSON(utils.ErrorStatusCodes[utils.ErrMemberNotFound], presenter.CreateErrorResponse(utils.ErrMemberNotFound))
+				return
+			}
+			c.JSON(utils.ErrorStatusCodes[utils.ErrServerError], presenter.CreateErrorResponse(utils.ErrServerError))
+			return
+		}
+
+		c.JSON(http.StatusOK, gin.H{
+			"message": "Successfully updated custom roles",
+		})
+	}
+}
+
+// isValidProjectRole checks if the role has a name and only valid permission sets
+func isValidProjectRole(role entities.ProjectRoleInput) bool {
+	if role.Name == "" || len(role.PermissionSets) == 0 {
+		return false
+	}
+	for _, permissionSet := range role.PermissionSets {
+		if !permissionSet.IsValid() {
+			return false
+		}
+	}
+	return true
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"net"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	response "github.com/litmuschaos/litmus/chaoscenter/authentication/api/handlers"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/audit"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/authConfig"
 	"google.golang.org/grpc/credentials"
 
@@ -24,6 +26,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/user"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/utils"
 
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 
 	"github.com/gin-gonic/gin"
@@ -62,6 +65,16 @@ func main() {
 
 	flag.Parse()
 
+	shutdownTracer, err := utils.InitTracer(context.Background())
+	if err != nil {
+		log.Fatalf("unable to set up the tracer %v", err)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			log.Errorf("unable to flush the spans %v", err)
+		}
+	}()
+
 	client, err := utils.MongoConnection()
 	if err != nil {
 		log.Fatal("database connection error $s", err)
@@ -121,9 +134,13 @@ func main() {
 	authConfigCollection := db.Collection(utils.AuthConfigCollection)
 	authConfigRepo := authConfig.NewAuthConfigRepo(authConfigCollection)
 
+	// the audit log is shared with the graphql server which creates its collection
+	auditEventCollection := client.Database(utils.AuditDBName).Collection(utils.AuditEventCollection)
+	auditRepo := audit.NewRepo(auditEventCollection)
+
 	miscRepo := misc.NewRepo(db, client)
 
-	applicationService := services.NewService(userRepo, projectRepo, miscRepo, revokedTokenRepo, apiTokenRepo, authConfigRepo, db)
+	applicationService := services.NewService(userRepo, projectRepo, miscRepo, revokedTokenRepo, apiTokenRepo, authConfigRepo, auditRepo, db)
 
 	err = response.AddSalt(applicationService)
 	if err != nil {
@@ -187,6 +204,7 @@ func runRestServer(applicationService services.ApplicationService) {
 	gin.EnableJsonDecoderDisallowUnknownFields()
 	app := gin.Default()
 	app.Use(middleware.ValidateCors(config.AllowedOrigins))
+	app.Use(middleware.AuditMiddleware(applicationService))
 	// Enable dex routes only if passed via environment variables
 	if utils.DexEnabled {
 		routes.DexRouter(app, applicationService)
@@ -229,7 +247,7 @@ func runGrpcServer(applicationService services.ApplicationService) {
 			" to %s", err)
 	}
 	grpcApplicationServer := grpcHandler.ServerGrpc{ApplicationService: applicationService}
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 	grpcPresenter.RegisterAuthRpcServiceServer(grpcServer, &grpcApplicationServer)
 	log.Infof("Listening and serving gRPC on :%s", utils.GrpcPort)
 	err = grpcServer.Serve(lis)
@@ -253,7 +271,7 @@ func runGrpcServerWithTLS(applicationService services.ApplicationService) {
 	tlsCredentials := credentials.NewTLS(conf)
 
 	// create grpc server with tls credential
-	grpcServer := grpc.NewServer(grpc.Creds(tlsCredentials))
+	grpcServer := grpc.NewServer(grpc.Creds(tlsCredentials), grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
 	grpcApplicationServer := grpcHandler.ServerGrpc{ApplicationService: applicationService}
 
@@ -0,0 +1,152 @@
+package middleware
+
+import (
+	"bytes"
+	"encoding/json"
+	"io"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gin-gonic/gin"
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
+	log "github.com/sirupsen/logrus"
+)
+
+// redactedValue replaces the passwords and tokens of the requests in the audit log
+const redactedValue = "[REDACTED]"
+
+// unauditedPaths are the health checks of the server which are not recorded in the audit log
+var unauditedPaths = map[string]bool{
+	"/status":    true,
+	"/readiness": true,
+}
+
+// auditResponseWriter keeps the body of the response to record the error returned to the client
+type auditResponseWriter struct {
+	gin.ResponseWriter
+	body bytes.Buffer
+}
+
+func (w *auditResponseWriter) Write(data []byte) (int, error) {
+	w.body.Write(data)
+	return w.ResponseWriter.Write(data)
+}
+
+// AuditMiddleware is a Gin Middleware that records every request to the REST endpoints in the audit log
+func AuditMiddleware(service services.ApplicationService) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if c.FullPath() == "" || unauditedPaths[c.FullPath()] {
+			c.Next()
+			return
+		}
+
+		var body map[string]interface{}
+		if c.Request.Body != nil {
+			data, err := io.ReadAll(c.Request.Body)
+			if err == nil {
+				_ = json.Unmarshal(data, &body)
+			}
+			c.Request.Body = io.NopCloser(bytes.NewReader(data))
+		}
+		writer := &auditResponseWriter{ResponseWriter: c.Writer}
+		c.Writer = writer
+		createdAt := time.Now().UnixMilli()
+
+		c.Next()
+
+		event := &entities.AuditEvent{
+			AuditID:     uuid.NewString(),
+			ProjectID:   getAuditProjectID(c, body),
+			Source:      entities.AuditSourceAuth,
+			Actor:       c.GetString("username"),
+			ActorID:     c.GetString("uid"),
+			Action:      c.Request.Method + " " + c.FullPath(),
+			ResourceIDs: getAuditResourceIDs(c, body),
+			After:       redact(body),
+			SourceIP:    c.ClientIP(),
+			Succeeded:   writer.Status() < http.StatusBadRequest,
+			CreatedAt:   createdAt,
+		}
+		if event.Actor == "" {
+			// the login requests are not authenticated, the user is the one logging in
+			event.Actor, _ = body["username"].(string)
+		}
+		if token := strings.TrimPrefix(c.GetHeader("Authorization"), "Bearer "); token != "" {
+			event.IsAPIToken = service.IsApiToken(token)
+		}
+		if !event.Succeeded {
+			event.Error = getAuditError(writer)
+		}
+
+		if err := service.RecordAuditEvent(event); err != nil {
+			log.WithError(err).Errorf("failed to record audit event of %s", event.Action)
+		}
+	}
+}
+
+// getAuditProjectID returns the project of the request from the path or the body, if any
+func getAuditProjectID(c *gin.Context, body map[string]interface{}) string {
+	if projectID := c.Param("project_id"); projectID != "" {
+		return projectID
+	}
+	projectID, _ := body["projectID"].(string)
+	return projectID
+}
+
+// getAuditResourceIDs returns the IDs of the users and projects targeted by the request
+func getAuditResourceIDs(c *gin.Context, body map[string]interface{}) []string {
+	resourceIDs := []string{}
+	for _, param := range c.Params {
+		if param.Key != "project_id" && param.Value != "" {
+			resourceIDs = append(resourceIDs, param.Value)
+		}
+	}
+
+	keys := make([]string, 0, len(body))
+	for key := range body {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		value, ok := body[key].(string)
+		if !ok || value == "" || key == "projectID" {
+			continue
+		}
+		if strings.HasSuffix(key, "ID") || strings.HasSuffix(key, "_id") || key == "uid" || key == "username" {
+			resourceIDs = append(resourceIDs, value)
+		}
+	}
+	return resourceIDs
+}
+
+// redact returns the body of the request without its passwords, tokens and secrets
+func redact(body map[string]interface{}) map[string]interface{} {
+	if body == nil {
+		return nil
+	}
+	redacted := make(map[string]interface{}, len(body))
+	for key, value := range body {
+		lowerKey := strings.ToLower(key)
+		if strings.Contains(lowerKey, "password") || strings.Contains(lowerKey, "token") || strings.Contains(lowerKey, "secret") {
+			redacted[key] = redactedValue
+			continue
+		}
+		redacted[key] = value
+	}
+	return redacted
+}
+
+// getAuditError returns the error returned to the client
+func getAuditError(writer *auditResponseWriter) string {
+	var response struct {
+		Error string `json:"error"`
+	}
+	if err := json.Unmarshal(writer.body.Bytes(), &response); err == nil && response.Error != "" {
+		return response.Error
+	}
+	return http.StatusText(writer.Status())
+}
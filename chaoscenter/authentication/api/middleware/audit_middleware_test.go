@@ -0,0 +1,82 @@
+package middleware_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/middleware"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/api/mocks"
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestAuditMiddleware(t *testing.T) {
+	gin.SetMode(gin.TestMode)
+
+	t.Run("records the request with its actor and targets", func(t *testing.T) {
+		router := gin.New()
+		mockService := new(mocks.MockedApplicationService)
+		router.Use(middleware.AuditMiddleware(mockService))
+		router.POST("/update_member_role", func(c *gin.Context) {
+			c.Set("username", "admin")
+			c.Set("uid", "admin-id")
+			c.JSON(http.StatusOK, gin.H{"message": "Successfully updated Role"})
+		})
+
+		mockService.On("IsApiToken", "api-token").Return(true)
+		mockService.On("RecordAuditEvent", mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Actor == "admin" && event.ActorID == "admin-id" && event.ProjectID == "project" &&
+				event.Action == "POST /update_member_role" && event.Source == entities.AuditSourceAuth &&
+				assert.ObjectsAreEqual([]string{"user-id"}, event.ResourceIDs) && event.IsAPIToken && event.Succeeded &&
+				event.After["role"] == "Viewer"
+		})).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/update_member_role", strings.NewReader(`{"projectID":"project","userID":"user-id","role":"Viewer"}`))
+		req.Header.Set("Authorization", "Bearer api-token")
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("redacts the password of a failed login", func(t *testing.T) {
+		router := gin.New()
+		mockService := new(mocks.MockedApplicationService)
+		router.Use(middleware.AuditMiddleware(mockService))
+		router.POST("/login", func(c *gin.Context) {
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "invalid_credentials"})
+		})
+
+		mockService.On("RecordAuditEvent", mock.MatchedBy(func(event *entities.AuditEvent) bool {
+			return event.Actor == "user" && !event.Succeeded && event.Error == "invalid_credentials" &&
+				event.After["password"] == "[REDACTED]" && !event.IsAPIToken
+		})).Return(nil)
+
+		req := httptest.NewRequest(http.MethodPost, "/login", strings.NewReader(`{"username":"user","password":"secret"}`))
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, req)
+
+		assert.Equal(t, http.StatusUnauthorized, w.Code)
+		mockService.AssertExpectations(t)
+	})
+
+	t.Run("skips the health checks", func(t *testing.T) {
+		router := gin.New()
+		mockService := new(mocks.MockedApplicationService)
+		router.Use(middleware.AuditMiddleware(mockService))
+		router.GET("/status", func(c *gin.Context) {
+			c.JSON(http.StatusOK, gin.H{"status": "up"})
+		})
+
+		w := httptest.NewRecorder()
+		router.ServeHTTP(w, httptest.NewRequest(http.MethodGet, "/status", nil))
+
+		assert.Equal(t, http.StatusOK, w.Code)
+		mockService.AssertNotCalled(t, "RecordAuditEvent", mock.Anything)
+	})
+}
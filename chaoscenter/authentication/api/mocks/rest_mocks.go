@@ -132,6 +132,26 @@ func (m *MockedApplicationService) UpdateMemberRole(projectID, userID string, ro
 	return args.Error(0)
 }
 
+func (m *MockedApplicationService) AddProjectRole(projectID string, role *entities.ProjectRole) error {
+	args := m.Called(projectID, role)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) UpdateProjectRole(projectID string, role *entities.ProjectRole) error {
+	args := m.Called(projectID, role)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) DeleteProjectRole(projectID, roleID string) error {
+	args := m.Called(projectID, roleID)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) UpdateMemberCustomRoles(projectID, userID string, roleIDs []string) error {
+	args := m.Called(projectID, userID, roleIDs)
+	return args.Error(0)
+}
+
 func (m *MockedApplicationService) GetAggregateProjects(pipeline mongo.Pipeline, opts *options.AggregateOptions) (*mongo.Cursor, error) {
 	args := m.Called(pipeline, opts)
 	return args.Get(0).(*mongo.Cursor), args.Error(1)
@@ -197,6 +217,16 @@ func (m *MockedApplicationService) DeleteApiToken(token string) error {
 	return args.Error(0)
 }
 
+func (m *MockedApplicationService) RecordAuditEvent(event *entities.AuditEvent) error {
+	args := m.Called(event)
+	return args.Error(0)
+}
+
+func (m *MockedApplicationService) IsApiToken(token string) bool {
+	args := m.Called(token)
+	return args.Bool(0)
+}
+
 func (m *MockedApplicationService) ListCollection() ([]string, error) {
 	args := m.Called()
 	return args.Get(0).([]string), args.Error(1)
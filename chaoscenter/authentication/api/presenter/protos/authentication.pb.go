@@ -31,6 +31,10 @@ type ValidationRequest struct {
 	ProjectId     string   `protobuf:"bytes,2,opt,name=projectId,proto3" json:"projectId,omitempty"`
 	RequiredRoles []string `protobuf:"bytes,3,rep,name=requiredRoles,proto3" json:"requiredRoles,omitempty"`
 	Invitation    string   `protobuf:"bytes,4,opt,name=invitation,proto3" json:"invitation,omitempty"`
+	// action is the role query performed, the custom roles of the user are only considered if it is set
+	Action          string `protobuf:"bytes,5,opt,name=action,proto3" json:"action,omitempty"`
+	EnvironmentId   string `protobuf:"bytes,6,opt,name=environmentId,proto3" json:"environmentId,omitempty"`
+	EnvironmentType string `protobuf:"bytes,7,opt,name=environmentType,proto3" json:"environmentType,omitempty"`
 }
 
 func (x *ValidationRequest) Reset() {
@@ -93,14 +97,36 @@ func (x *ValidationRequest) GetInvitation() string {
 	return ""
 }
 
+func (x *ValidationRequest) GetAction() string {
+	if x != nil {
+		return x.Action
+	}
+	return ""
+}
+
+func (x *ValidationRequest) GetEnvironmentId() string {
+	if x != nil {
+		return x.EnvironmentId
+	}
+	return ""
+}
+
+func (x *ValidationRequest) GetEnvironmentType() string {
+	if x != nil {
+		return x.EnvironmentType
+	}
+	return ""
+}
+
 // The validation response that will contain the results of the validation request
 type ValidationResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Error   string `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
-	IsValid bool   `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	Error       string         `protobuf:"bytes,1,opt,name=error,proto3" json:"error,omitempty"`
+	IsValid     bool           `protobuf:"varint,2,opt,name=isValid,proto3" json:"isValid,omitempty"`
+	CustomRoles []*ProjectRole `protobuf:"bytes,3,rep,name=customRoles,proto3" json:"customRoles,omitempty"`
 }
 
 func (x *ValidationResponse) Reset() {
@@ -149,6 +175,141 @@ func (x *ValidationResponse) GetIsValid() bool {
 	return false
 }
 
+func (x *ValidationResponse) GetCustomRoles() []*ProjectRole {
+	if x != nil {
+		return x.CustomRoles
+	}
+	return nil
+}
+
+// PermissionSet is the message struct that holds the actions granted by a custom role and their environment scope
+type PermissionSet struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Actions          []string `protobuf:"bytes,1,rep,name=actions,proto3" json:"actions,omitempty"`
+	EnvironmentTypes []string `protobuf:"bytes,2,rep,name=environmentTypes,proto3" json:"environmentTypes,omitempty"`
+	EnvironmentIds   []string `protobuf:"bytes,3,rep,name=environmentIds,proto3" json:"environmentIds,omitempty"`
+}
+
+func (x *PermissionSet) Reset() {
+	*x = PermissionSet{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authentication_proto_msgTypes[2]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PermissionSet) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PermissionSet) ProtoMessage() {}
+
+func (x *PermissionSet) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_proto_msgTypes[2]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PermissionSet.ProtoReflect.Descriptor instead.
+func (*PermissionSet) Descriptor() ([]byte, []int) {
+	return file_authentication_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *PermissionSet) GetActions() []string {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+func (x *PermissionSet) GetEnvironmentTypes() []string {
+	if x != nil {
+		return x.EnvironmentTypes
+	}
+	return nil
+}
+
+func (x *PermissionSet) GetEnvironmentIds() []string {
+	if x != nil {
+		return x.EnvironmentIds
+	}
+	return nil
+}
+
+// ProjectRole is the message struct that holds the details about a custom role of the project
+type ProjectRole struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id             string           `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name           string           `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	PermissionSets []*PermissionSet `protobuf:"bytes,3,rep,name=permissionSets,proto3" json:"permissionSets,omitempty"`
+}
+
+func (x *ProjectRole) Reset() {
+	*x = ProjectRole{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_authentication_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ProjectRole) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ProjectRole) ProtoMessage() {}
+
+func (x *ProjectRole) ProtoReflect() protoreflect.Message {
+	mi := &file_authentication_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ProjectRole.ProtoReflect.Descriptor instead.
+func (*ProjectRole) Descriptor() ([]byte, []int) {
+	return file_authentication_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *ProjectRole) GetId() string {
+	if x != nil {
+		return x.Id
+	}
+	return ""
+}
+
+func (x *ProjectRole) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *ProjectRole) GetPermissionSets() []*PermissionSet {
+	if x != nil {
+		return x.PermissionSets
+	}
+	return nil
+}
+
 // GetProjectByIdRequest is the message struct for requesting project details by ID
 type GetProjectByIdRequest struct {
 	state         protoimpl.MessageState
@@ -161,7 +322,7 @@ type GetProjectByIdRequest struct {
 func (x *GetProjectByIdRequest) Reset() {
 	*x = GetProjectByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authentication_proto_msgTypes[2]
+		mi := &file_authentication_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -174,7 +335,7 @@ func (x *GetProjectByIdRequest) String() string {
 func (*GetProjectByIdRequest) ProtoMessage() {}
 
 func (x *GetProjectByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_proto_msgTypes[2]
+	mi := &file_authentication_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -187,7 +348,7 @@ func (x *GetProjectByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetProjectByIdRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_proto_rawDescGZIP(), []int{2}
+	return file_authentication_proto_rawDescGZIP(), []int{4}
 }
 
 func (x *GetProjectByIdRequest) GetProjectID() string {
@@ -214,7 +375,7 @@ type ProjectMembers struct {
 func (x *ProjectMembers) Reset() {
 	*x = ProjectMembers{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authentication_proto_msgTypes[3]
+		mi := &file_authentication_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -227,7 +388,7 @@ func (x *ProjectMembers) String() string {
 func (*ProjectMembers) ProtoMessage() {}
 
 func (x *ProjectMembers) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_proto_msgTypes[3]
+	mi := &file_authentication_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -240,7 +401,7 @@ func (x *ProjectMembers) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ProjectMembers.ProtoReflect.Descriptor instead.
 func (*ProjectMembers) Descriptor() ([]byte, []int) {
-	return file_authentication_proto_rawDescGZIP(), []int{3}
+	return file_authentication_proto_rawDescGZIP(), []int{5}
 }
 
 func (x *ProjectMembers) GetUid() string {
@@ -304,7 +465,7 @@ type GetProjectByIdResponse struct {
 func (x *GetProjectByIdResponse) Reset() {
 	*x = GetProjectByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authentication_proto_msgTypes[4]
+		mi := &file_authentication_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -317,7 +478,7 @@ func (x *GetProjectByIdResponse) String() string {
 func (*GetProjectByIdResponse) ProtoMessage() {}
 
 func (x *GetProjectByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_proto_msgTypes[4]
+	mi := &file_authentication_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -330,7 +491,7 @@ func (x *GetProjectByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetProjectByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetProjectByIdResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_proto_rawDescGZIP(), []int{4}
+	return file_authentication_proto_rawDescGZIP(), []int{6}
 }
 
 func (x *GetProjectByIdResponse) GetId() string {
@@ -401,7 +562,7 @@ type GetUserByIdRequest struct {
 func (x *GetUserByIdRequest) Reset() {
 	*x = GetUserByIdRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authentication_proto_msgTypes[5]
+		mi := &file_authentication_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -414,7 +575,7 @@ func (x *GetUserByIdRequest) String() string {
 func (*GetUserByIdRequest) ProtoMessage() {}
 
 func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_proto_msgTypes[5]
+	mi := &file_authentication_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -427,7 +588,7 @@ func (x *GetUserByIdRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIdRequest.ProtoReflect.Descriptor instead.
 func (*GetUserByIdRequest) Descriptor() ([]byte, []int) {
-	return file_authentication_proto_rawDescGZIP(), []int{5}
+	return file_authentication_proto_rawDescGZIP(), []int{7}
 }
 
 func (x *GetUserByIdRequest) GetUserID() string {
@@ -456,7 +617,7 @@ type GetUserByIdResponse struct {
 func (x *GetUserByIdResponse) Reset() {
 	*x = GetUserByIdResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_authentication_proto_msgTypes[6]
+		mi := &file_authentication_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -469,7 +630,7 @@ func (x *GetUserByIdResponse) String() string {
 func (*GetUserByIdResponse) ProtoMessage() {}
 
 func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_authentication_proto_msgTypes[6]
+	mi := &file_authentication_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -482,7 +643,7 @@ func (x *GetUserByIdResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserByIdResponse.ProtoReflect.Descriptor instead.
 func (*GetUserByIdResponse) Descriptor() ([]byte, []int) {
-	return file_authentication_proto_rawDescGZIP(), []int{6}
+	return file_authentication_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetUserByIdResponse) GetId() string {
@@ -545,7 +706,7 @@ var File_authentication_proto protoreflect.FileDescriptor
 
 var file_authentication_proto_rawDesc = []byte{
 	0x0a, 0x14, 0x61, 0x75, 0x74, 0x68, 0x65, 0x6e, 0x74, 0x69, 0x63, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0x89,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x22, 0xf1,
 	0x01, 0x0a, 0x11, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
 	0x75, 0x65, 0x73, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x6a, 0x77, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
 	0x09, 0x52, 0x03, 0x6a, 0x77, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6a, 0x65, 0x63,
@@ -554,11 +715,36 @@ var file_authentication_proto_rawDesc = []byte{
 	0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x71,
 	0x75, 0x69, 0x72, 0x65, 0x64, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x69, 0x6e,
 	0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
-	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x44, 0x0a, 0x12, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69,
-	0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64,
+	0x69, 0x6e, 0x76, 0x69, 0x74, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x49, 0x64, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x6e, 0x76, 0x69, 0x72,
+	0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x6e, 0x76, 0x69,
+	0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x22, 0x7b, 0x0a, 0x12, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f,
+	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x12, 0x18,
+	0x0a, 0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x07, 0x69, 0x73, 0x56, 0x61, 0x6c, 0x69, 0x64, 0x12, 0x35, 0x0a, 0x0b, 0x63, 0x75, 0x73, 0x74,
+	0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x73, 0x2e, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f,
+	0x6c, 0x65, 0x52, 0x0b, 0x63, 0x75, 0x73, 0x74, 0x6f, 0x6d, 0x52, 0x6f, 0x6c, 0x65, 0x73, 0x22,
+	0x7d, 0x0a, 0x0d, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x12, 0x18, 0x0a, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28,
+	0x09, 0x52, 0x07, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2a, 0x0a, 0x10, 0x65, 0x6e,
+	0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x18, 0x02,
+	0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f,
+	0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0e,
+	0x65, 0x6e, 0x76, 0x69, 0x72, 0x6f, 0x6e, 0x6d, 0x65, 0x6e, 0x74, 0x49, 0x64, 0x73, 0x22, 0x70,
+	0x0a, 0x0b, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x6c, 0x65, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6e, 0x61, 0x6d,
+	0x65, 0x12, 0x3d, 0x0a, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x65, 0x74, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x73, 0x2e, 0x50, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74,
+	0x52, 0x0e, 0x70, 0x65, 0x72, 0x6d, 0x69, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65, 0x74, 0x73,
 	0x22, 0x35, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x50, 0x72, 0x6f, 0x6a, 0x65, 0x63, 0x74, 0x42, 0x79,
 	0x49, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x70, 0x72, 0x6f,
 	0x6a, 0x65, 0x63, 0x74, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x70, 0x72,
@@ -637,29 +823,33 @@ func file_authentication_proto_rawDescGZIP() []byte {
 	return file_authentication_proto_rawDescData
 }
 
-var file_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_authentication_proto_msgTypes = make([]protoimpl.MessageInfo, 9)
 var file_authentication_proto_goTypes = []interface{}{
 	(*ValidationRequest)(nil),      // 0: protos.ValidationRequest
 	(*ValidationResponse)(nil),     // 1: protos.ValidationResponse
-	(*GetProjectByIdRequest)(nil),  // 2: protos.GetProjectByIdRequest
-	(*ProjectMembers)(nil),         // 3: protos.ProjectMembers
-	(*GetProjectByIdResponse)(nil), // 4: protos.GetProjectByIdResponse
-	(*GetUserByIdRequest)(nil),     // 5: protos.GetUserByIdRequest
-	(*GetUserByIdResponse)(nil),    // 6: protos.GetUserByIdResponse
+	(*PermissionSet)(nil),          // 2: protos.PermissionSet
+	(*ProjectRole)(nil),            // 3: protos.ProjectRole
+	(*GetProjectByIdRequest)(nil),  // 4: protos.GetProjectByIdRequest
+	(*ProjectMembers)(nil),         // 5: protos.ProjectMembers
+	(*GetProjectByIdResponse)(nil), // 6: protos.GetProjectByIdResponse
+	(*GetUserByIdRequest)(nil),     // 7: protos.GetUserByIdRequest
+	(*GetUserByIdResponse)(nil),    // 8: protos.GetUserByIdResponse
 }
 var file_authentication_proto_depIdxs = []int32{
-	3, // 0: protos.GetProjectByIdResponse.members:type_name -> protos.ProjectMembers
-	0, // 1: protos.authRpcService.ValidateRequest:input_type -> protos.ValidationRequest
-	2, // 2: protos.authRpcService.GetProjectById:input_type -> protos.GetProjectByIdRequest
-	5, // 3: protos.authRpcService.GetUserById:input_type -> protos.GetUserByIdRequest
-	1, // 4: protos.authRpcService.ValidateRequest:output_type -> protos.ValidationResponse
-	4, // 5: protos.authRpcService.GetProjectById:output_type -> protos.GetProjectByIdResponse
-	6, // 6: protos.authRpcService.GetUserById:output_type -> protos.GetUserByIdResponse
-	4, // [4:7] is the sub-list for method output_type
-	1, // [1:4] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	3, // 0: protos.ValidationResponse.customRoles:type_name -> protos.ProjectRole
+	2, // 1: protos.ProjectRole.permissionSets:type_name -> protos.PermissionSet
+	5, // 2: protos.GetProjectByIdResponse.members:type_name -> protos.ProjectMembers
+	0, // 3: protos.authRpcService.ValidateRequest:input_type -> protos.ValidationRequest
+	4, // 4: protos.authRpcService.GetProjectById:input_type -> protos.GetProjectByIdRequest
+	7, // 5: protos.authRpcService.GetUserById:input_type -> protos.GetUserByIdRequest
+	1, // 6: protos.authRpcService.ValidateRequest:output_type -> protos.ValidationResponse
+	6, // 7: protos.authRpcService.GetProjectById:output_type -> protos.GetProjectByIdResponse
+	8, // 8: protos.authRpcService.GetUserById:output_type -> protos.GetUserByIdResponse
+	6, // [6:9] is the sub-list for method output_type
+	3, // [3:6] is the sub-list for method input_type
+	3, // [3:3] is the sub-list for extension type_name
+	3, // [3:3] is the sub-list for extension extendee
+	0, // [0:3] is the sub-list for field type_name
 }
 
 func init() { file_authentication_proto_init() }
@@ -693,7 +883,7 @@ func file_authentication_proto_init() {
 			}
 		}
 		file_authentication_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectByIdRequest); i {
+			switch v := v.(*PermissionSet); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -705,7 +895,7 @@ func file_authentication_proto_init() {
 			}
 		}
 		file_authentication_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ProjectMembers); i {
+			switch v := v.(*ProjectRole); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -717,7 +907,7 @@ func file_authentication_proto_init() {
 			}
 		}
 		file_authentication_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetProjectByIdResponse); i {
+			switch v := v.(*GetProjectByIdRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -729,7 +919,7 @@ func file_authentication_proto_init() {
 			}
 		}
 		file_authentication_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetUserByIdRequest); i {
+			switch v := v.(*ProjectMembers); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -741,6 +931,30 @@ func file_authentication_proto_init() {
 			}
 		}
 		file_authentication_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetProjectByIdResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authentication_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetUserByIdRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_authentication_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*GetUserByIdResponse); i {
 			case 0:
 				return &v.state
@@ -759,7 +973,7 @@ func file_authentication_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_authentication_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   9,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -20,6 +20,7 @@ func ProjectRouter(router *gin.Engine, service services.ApplicationService) {
 	router.GET("/list_projects", rest.GetProjectsByUserID(service))
 	router.GET("/get_projects_stats", rest.GetProjectStats(service))
 	router.GET("/list_invitations_with_filters/:invitation_state", rest.ListInvitations(service))
+	router.GET("/list_project_roles/:project_id", rest.ListProjectRoles(service))
 	router.POST("/create_project", rest.CreateProject(service))
 	router.POST("/send_invitation", rest.SendInvitation(service))
 	router.POST("/accept_invitation", rest.AcceptInvitation(service))
@@ -29,4 +30,8 @@ func ProjectRouter(router *gin.Engine, service services.ApplicationService) {
 	router.POST("/update_project_name", rest.UpdateProjectName(service))
 	router.POST("/update_member_role", rest.UpdateMemberRole(service))
 	router.POST("/delete_project/:project_id", rest.DeleteProject(service))
+	router.POST("/create_project_role", rest.CreateProjectRole(service))
+	router.POST("/update_project_role", rest.UpdateProjectRole(service))
+	router.POST("/delete_project_role", rest.DeleteProjectRole(service))
+	router.POST("/update_member_custom_roles", rest.UpdateMemberCustomRoles(service))
 }
@@ -0,0 +1,31 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Repository holds the mongo database implementation of the Service, the audit log is
+// append-only so the repository doesn't provide any way to update or delete the events
+type Repository interface {
+	InsertAuditEvent(event *entities.AuditEvent) error
+}
+
+type repository struct {
+	Collection *mongo.Collection
+}
+
+// InsertAuditEvent appends the event to the audit log
+func (r repository) InsertAuditEvent(event *entities.AuditEvent) error {
+	_, err := r.Collection.InsertOne(context.Background(), event)
+	return err
+}
+
+// NewRepo creates a new instance of this repository
+func NewRepo(collection *mongo.Collection) Repository {
+	return &repository{
+		Collection: collection,
+	}
+}
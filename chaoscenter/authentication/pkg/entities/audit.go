@@ -0,0 +1,22 @@
+package entities
+
+// AuditSourceAuth is the source of the audit events of the REST endpoints of the authentication server
+const AuditSourceAuth = "AUTH"
+
+// AuditEvent struct for storing the entries of the audit log, the log is shared with the
+// GraphQL server which records the mutations and lists the events
+type AuditEvent struct {
+	AuditID     string                 `bson:"audit_id"`
+	ProjectID   string                 `bson:"project_id,omitempty"`
+	Source      string                 `bson:"source"`
+	Actor       string                 `bson:"actor"`
+	ActorID     string                 `bson:"actor_id,omitempty"`
+	Action      string                 `bson:"action"`
+	ResourceIDs []string               `bson:"resource_ids"`
+	After       map[string]interface{} `bson:"after,omitempty"`
+	SourceIP    string                 `bson:"source_ip"`
+	IsAPIToken  bool                   `bson:"is_api_token"`
+	Succeeded   bool                   `bson:"succeeded"`
+	Error       string                 `bson:"error,omitempty"`
+	CreatedAt   int64                  `bson:"created_at"`
+}
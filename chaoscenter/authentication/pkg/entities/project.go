@@ -3,12 +3,13 @@ package entities
 // Project contains the required fields to be stored in the database for a project
 type Project struct {
 	Audit       `bson:",inline"`
-	ID          string    `bson:"_id" json:"projectID"`
-	Name        string    `bson:"name" json:"name"`
-	Members     []*Member `bson:"members" json:"members"`
-	State       *string   `bson:"state" json:"state"`
-	Tags        []*string `bson:"tags" json:"tags"`
-	Description *string   `bson:"description" json:"description"`
+	ID          string         `bson:"_id" json:"projectID"`
+	Name        string         `bson:"name" json:"name"`
+	Members     []*Member      `bson:"members" json:"members"`
+	State       *string        `bson:"state" json:"state"`
+	Tags        []*string      `bson:"tags" json:"tags"`
+	Description *string        `bson:"description" json:"description"`
+	Roles       []*ProjectRole `bson:"roles,omitempty" json:"roles,omitempty"`
 }
 
 type Owner struct {
@@ -40,6 +41,7 @@ type Member struct {
 	Invitation    Invitation `bson:"invitation" json:"invitation"`
 	JoinedAt      int64      `bson:"joined_at" json:"joinedAt"`
 	DeactivatedAt *int64     `bson:"deactivated_at,omitempty" json:"deactivatedAt,omitempty"`
+	CustomRoles   []string   `bson:"custom_roles,omitempty" json:"customRoles,omitempty"`
 }
 
 type Members struct {
@@ -127,6 +129,7 @@ func (project *Project) GetProjectOutput() *Project {
 		Name:    project.Name,
 		Members: project.GetMemberOutput(),
 		State:   project.State,
+		Roles:   project.Roles,
 		Audit: Audit{
 			IsRemoved: project.IsRemoved,
 			CreatedAt: project.CreatedAt,
@@ -153,10 +156,11 @@ func (project *Project) GetMemberOutput() []*Member { // add logic to get member
 func (member *Member) GetMemberOutput() *Member {
 
 	return &Member{
-		UserID:     member.UserID,
-		Role:       member.Role,
-		Invitation: member.Invitation,
-		JoinedAt:   member.JoinedAt,
+		UserID:      member.UserID,
+		Role:        member.Role,
+		Invitation:  member.Invitation,
+		JoinedAt:    member.JoinedAt,
+		CustomRoles: member.CustomRoles,
 	}
 }
 
@@ -0,0 +1,187 @@
+package entities
+
+// EnvironmentType defines the type of the chaos environment a permission set can be scoped to
+type EnvironmentType string
+
+const (
+	EnvironmentTypeProd    EnvironmentType = "PROD"
+	EnvironmentTypeNonProd EnvironmentType = "NON_PROD"
+)
+
+// roleQueries are the role queries of the chaos center server which a permission set can grant, the keys
+// are the values the server validates, including their original spelling
+var roleQueries = map[string]bool{
+	"userInfrastructureReg":    true,
+	"ListInfrastructures":      true,
+	"GetInfrastructure ":       true,
+	"DeleteInfrastructures":    true,
+	"GetManifest":              true,
+	"GetInfraDetails":          true,
+	"UpdateInfraRunLimit":      true,
+	"CreateChaosExperiment":    true,
+	"ReRunChaosExperiment":     true,
+	"DeleteChaosEnvironment":   true,
+	"UpdateChaosExperiment":    true,
+	"ListExperiment":           true,
+	"CreateEnvironment":        true,
+	"StopChaosExperiment":      true,
+	"ListWorkflowRuns":         true,
+	"GetWorkflowRun":           true,
+	"AddChaosHub":              true,
+	"UpdateChaosHub":           true,
+	"DeleteChaosHub":           true,
+	"ListCharts":               true,
+	"SaveChaosHub":             true,
+	"EnableGitOps":             true,
+	"DisableGitOps":            true,
+	"UpdateGitOps":             true,
+	"GetGitOpsDetails":         true,
+	"CreateImageRegistry":      true,
+	"UpdateImageRegistry":      true,
+	"DeleteImageRegistry":      true,
+	"ListImageRegistry":        true,
+	"GetImageRegistry":         true,
+	"UpdateEnvironment":        true,
+	"DeleteEnvironment":        true,
+	"GetEnvironment":           true,
+	"ListEnvironments":         true,
+	"CreateChaosPipeline":      true,
+	"UpdateChaosPipeline":      true,
+	"DeleteChaosPipeline":      true,
+	"RunChaosPipeline":         true,
+	"GetChaosPipeline":         true,
+	"ListChaosPipelines":       true,
+	"CreateWebhook":            true,
+	"UpdateWebhook":            true,
+	"DeleteWebhook":            true,
+	"GetWebhook":               true,
+	"ListWebhooks":             true,
+	"ListAuditEvents":          true,
+	"ExportAuditEvents":        true,
+	"GetRunRetentionPolicy":    true,
+	"UpdateRunRetentionPolicy": true,
+	"ResetRunRetentionPolicy":  true,
+	"ListFaultResults":         true,
+	"GetFaultAnalytics":        true,
+	"ListRunApprovals":         true,
+	"DecideRunApproval":        true,
+	"AddProbe":                 true,
+	"DeleteProbe":              true,
+	"UpdateProbe":              true,
+	"GetProbe":                 true,
+	"ListProbes":               true,
+}
+
+// ProjectRole is a custom role defined in a project, it grants the actions of its permission sets to the
+// members it is assigned to on top of their built-in role
+type ProjectRole struct {
+	RoleID         string          `bson:"role_id" json:"roleID"`
+	Name           string          `bson:"name" json:"name"`
+	Description    *string         `bson:"description,omitempty" json:"description,omitempty"`
+	PermissionSets []PermissionSet `bson:"permission_sets" json:"permissionSets"`
+	CreatedAt      int64           `bson:"created_at" json:"createdAt"`
+	UpdatedAt      int64           `bson:"updated_at" json:"updatedAt"`
+}
+
+// PermissionSet grants a set of actions, the actions are the role queries of the chaos center server.
+// A permission set with environment types or IDs only grants its actions within the matching environments
+type PermissionSet struct {
+	Actions          []string          `bson:"actions" json:"actions"`
+	EnvironmentTypes []EnvironmentType `bson:"environment_types,omitempty" json:"environmentTypes,omitempty"`
+	EnvironmentIDs   []string          `bson:"environment_ids,omitempty" json:"environmentIDs,omitempty"`
+}
+
+// ActionScope is the action a member performs along with the environment it is performed in, if any
+type ActionScope struct {
+	Action          string
+	EnvironmentID   string
+	EnvironmentType EnvironmentType
+}
+
+type ProjectRoleInput struct {
+	ProjectID      string          `json:"projectID"`
+	RoleID         string          `json:"roleID"`
+	Name           string          `json:"name"`
+	Description    *string         `json:"description"`
+	PermissionSets []PermissionSet `json:"permissionSets"`
+}
+
+type DeleteProjectRoleInput struct {
+	ProjectID string `json:"projectID"`
+	RoleID    string `json:"roleID"`
+}
+
+type MemberCustomRolesInput struct {
+	ProjectID string   `json:"projectID"`
+	UserID    string   `json:"userID"`
+	RoleIDs   []string `json:"roleIDs"`
+}
+
+// IsValid checks if the permission set grants at least one action, only grants role queries of the chaos center
+// server and is only scoped to known environment types
+func (p PermissionSet) IsValid() bool {
+	if len(p.Actions) == 0 {
+		return false
+	}
+	for _, action := range p.Actions {
+		if !roleQueries[action] {
+			return false
+		}
+	}
+	for _, envType := range p.EnvironmentTypes {
+		if envType != EnvironmentTypeProd && envType != EnvironmentTypeNonProd {
+			return false
+		}
+	}
+	return true
+}
+
+// Allows checks if the permission set grants the action of the scope, a permission set scoped to environments
+// doesn't grant an action which isn't performed in one of them
+func (p PermissionSet) Allows(scope ActionScope) bool {
+	if !containsString(p.Actions, scope.Action) {
+		return false
+	}
+	if len(p.EnvironmentTypes) == 0 && len(p.EnvironmentIDs) == 0 {
+		return true
+	}
+	if scope.EnvironmentID != "" && containsString(p.EnvironmentIDs, scope.EnvironmentID) {
+		return true
+	}
+	for _, envType := range p.EnvironmentTypes {
+		if scope.EnvironmentType != "" && envType == scope.EnvironmentType {
+			return true
+		}
+	}
+	return false
+}
+
+// Allows checks if any of the permission sets of the role grants the action of the scope
+func (r *ProjectRole) Allows(scope ActionScope) bool {
+	for _, permissionSet := range r.PermissionSets {
+		if permissionSet.Allows(scope) {
+			return true
+		}
+	}
+	return false
+}
+
+// GetMemberCustomRoles returns the custom roles of the project assigned to the member
+func (project *Project) GetMemberCustomRoles(member *Member) []*ProjectRole {
+	var roles []*ProjectRole
+	for _, role := range project.Roles {
+		if containsString(member.CustomRoles, role.RoleID) {
+			roles = append(roles, role)
+		}
+	}
+	return roles
+}
+
+func containsString(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -27,6 +27,10 @@ type Repository interface {
 	UpdateInvite(projectID string, userID string, invitation entities.Invitation, role *entities.MemberRole) error
 	UpdateProjectName(projectID string, projectName string) error
 	UpdateMemberRole(projectID string, userID string, role *entities.MemberRole) error
+	AddProjectRole(projectID string, role *entities.ProjectRole) error
+	UpdateProjectRole(projectID string, role *entities.ProjectRole) error
+	DeleteProjectRole(projectID string, roleID string) error
+	UpdateMemberCustomRoles(projectID string, userID string, roleIDs []string) error
 	GetAggregateProjects(pipeline mongo.Pipeline, opts *options.AggregateOptions) (*mongo.Cursor, error)
 	UpdateProjectState(ctx context.Context, userID string, deactivateTime int64, isDeactivate bool) error
 	GetOwnerProjects(ctx context.Context, userID string) ([]*entities.Project, error)
@@ -330,6 +334,84 @@ func (r repository) UpdateMemberRole(projectID string, userID string, role *enti
 	return nil
 }
 
+// AddProjectRole : Adds a custom role to the project.
+func (r repository) AddProjectRole(projectID string, role *entities.ProjectRole) error {
+	query := bson.D{{"_id", projectID}}
+	update := bson.D{{"$push", bson.D{{"roles", role}}}}
+
+	result, err := r.Collection.UpdateOne(context.TODO(), query, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("could not find matching projectID in database")
+	}
+
+	return nil
+}
+
+// UpdateProjectRole : Updates the name, description and permission sets of a custom role of the project.
+func (r repository) UpdateProjectRole(projectID string, role *entities.ProjectRole) error {
+	query := bson.D{{"_id", projectID}, {"roles.role_id", role.RoleID}}
+	update := bson.D{{"$set", bson.D{
+		{"roles.$.name", role.Name},
+		{"roles.$.description", role.Description},
+		{"roles.$.permission_sets", role.PermissionSets},
+		{"roles.$.updated_at", role.UpdatedAt},
+	}}}
+
+	result, err := r.Collection.UpdateOne(context.TODO(), query, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("could not find matching role in the project")
+	}
+
+	return nil
+}
+
+// DeleteProjectRole : Removes a custom role from the project along with its assignments to the members.
+func (r repository) DeleteProjectRole(projectID string, roleID string) error {
+	query := bson.D{{"_id", projectID}, {"roles.role_id", roleID}}
+	update := bson.D{{"$pull", bson.D{
+		{"roles", bson.D{{"role_id", roleID}}},
+		{"members.$[].custom_roles", roleID},
+	}}}
+
+	result, err := r.Collection.UpdateOne(context.TODO(), query, update)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return errors.New("could not find matching role in the project")
+	}
+
+	return nil
+}
+
+// UpdateMemberCustomRoles : Replaces the custom roles assigned to the member in the project, mongo.ErrNoDocuments
+// is returned if the user isn't a member of the project.
+func (r repository) UpdateMemberCustomRoles(projectID string, userID string, roleIDs []string) error {
+	opts := options.Update().SetArrayFilters(options.ArrayFilters{
+		Filters: []interface{}{
+			bson.D{{"elem.user_id", userID}},
+		},
+	})
+	query := bson.D{{"_id", bson.D{{"$eq", projectID}}}, {"members.user_id", userID}}
+	update := bson.D{{"$set", bson.M{"members.$[elem].custom_roles": roleIDs}}}
+
+	result, err := r.Collection.UpdateOne(context.TODO(), query, update, opts)
+	if err != nil {
+		return err
+	}
+	if result.MatchedCount == 0 {
+		return mongo.ErrNoDocuments
+	}
+
+	return nil
+}
+
 // GetAggregateProjects takes a mongo pipeline to retrieve the project details from the database
 func (r repository) GetAggregateProjects(pipeline mongo.Pipeline, opts *options.AggregateOptions) (*mongo.Cursor, error) {
 	results, err := r.Collection.Aggregate(context.TODO(), pipeline, opts)
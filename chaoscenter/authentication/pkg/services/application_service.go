@@ -1,6 +1,7 @@
 package services
 
 import (
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/audit"
 	authConfig2 "github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/authConfig"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/misc"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/project"
@@ -16,6 +17,7 @@ type ApplicationService interface {
 	miscService
 	sessionService
 	authConfigService
+	auditService
 }
 
 type applicationService struct {
@@ -25,11 +27,12 @@ type applicationService struct {
 	revokedTokenRepository session.RevokedTokenRepository
 	apiTokenRepository     session.ApiTokenRepository
 	authConfigRepo         authConfig2.Repository
+	auditRepository        audit.Repository
 	db                     *mongo.Database
 }
 
 // NewService creates a new instance of this service
-func NewService(userRepo user.Repository, projectRepo project.Repository, miscRepo misc.Repository, revokedTokenRepo session.RevokedTokenRepository, apiTokenRepo session.ApiTokenRepository, authConfigRepo authConfig2.Repository, db *mongo.Database) ApplicationService {
+func NewService(userRepo user.Repository, projectRepo project.Repository, miscRepo misc.Repository, revokedTokenRepo session.RevokedTokenRepository, apiTokenRepo session.ApiTokenRepository, authConfigRepo authConfig2.Repository, auditRepo audit.Repository, db *mongo.Database) ApplicationService {
 	return &applicationService{
 		userRepository:         userRepo,
 		projectRepository:      projectRepo,
@@ -38,5 +41,6 @@ func NewService(userRepo user.Repository, projectRepo project.Repository, miscRe
 		db:                     db,
 		authConfigRepo:         authConfigRepo,
 		miscRepository:         miscRepo,
+		auditRepository:        auditRepo,
 	}
 }
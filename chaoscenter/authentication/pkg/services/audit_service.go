@@ -0,0 +1,19 @@
+package services
+
+import "github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
+
+// auditService is the interface for the audit log of the requests
+type auditService interface {
+	RecordAuditEvent(event *entities.AuditEvent) error
+	IsApiToken(token string) bool
+}
+
+// RecordAuditEvent appends the event to the audit log
+func (a applicationService) RecordAuditEvent(event *entities.AuditEvent) error {
+	return a.auditRepository.InsertAuditEvent(event)
+}
+
+// IsApiToken checks if the given JWT Token is an API token of a user
+func (a applicationService) IsApiToken(token string) bool {
+	return a.apiTokenRepository.IsApiToken(token)
+}
@@ -21,6 +21,10 @@ type projectService interface {
 	UpdateInvite(projectID string, userID string, invitation entities.Invitation, role *entities.MemberRole) error
 	UpdateProjectName(projectID string, projectName string) error
 	UpdateMemberRole(projectID string, userID string, role *entities.MemberRole) error
+	AddProjectRole(projectID string, role *entities.ProjectRole) error
+	UpdateProjectRole(projectID string, role *entities.ProjectRole) error
+	DeleteProjectRole(projectID string, roleID string) error
+	UpdateMemberCustomRoles(projectID string, userID string, roleIDs []string) error
 	GetAggregateProjects(pipeline mongo.Pipeline, opts *options.AggregateOptions) (*mongo.Cursor, error)
 	UpdateProjectState(ctx context.Context, userID string, deactivateTime int64, isDeactivate bool) error
 	GetOwnerProjectIDs(ctx context.Context, userID string) ([]*entities.Project, error)
@@ -71,6 +75,22 @@ func (a applicationService) UpdateMemberRole(projectID string, userID string, ro
 	return a.projectRepository.UpdateMemberRole(projectID, userID, role)
 }
 
+func (a applicationService) AddProjectRole(projectID string, role *entities.ProjectRole) error {
+	return a.projectRepository.AddProjectRole(projectID, role)
+}
+
+func (a applicationService) UpdateProjectRole(projectID string, role *entities.ProjectRole) error {
+	return a.projectRepository.UpdateProjectRole(projectID, role)
+}
+
+func (a applicationService) DeleteProjectRole(projectID string, roleID string) error {
+	return a.projectRepository.DeleteProjectRole(projectID, roleID)
+}
+
+func (a applicationService) UpdateMemberCustomRoles(projectID string, userID string, roleIDs []string) error {
+	return a.projectRepository.UpdateMemberCustomRoles(projectID, userID, roleIDs)
+}
+
 func (a applicationService) GetAggregateProjects(pipeline mongo.Pipeline, opts *options.AggregateOptions) (*mongo.Cursor, error) {
 	return a.projectRepository.GetAggregateProjects(pipeline, opts)
 }
@@ -13,6 +13,7 @@ type ApiTokenRepository interface {
 	CreateApiToken(apiToken *entities.ApiToken) error
 	GetApiTokensByUserID(userID string) ([]entities.ApiToken, error)
 	DeleteApiToken(token string) error
+	IsApiToken(token string) bool
 }
 
 // CreateApiToken creates a new API token
@@ -47,6 +48,15 @@ func (r repository) DeleteApiToken(token string) error {
 	return err
 }
 
+// IsApiToken checks if the given JWT Token is an API token
+func (r repository) IsApiToken(token string) bool {
+	err := r.Collection.FindOne(context.TODO(), bson.M{
+		"token": bson.M{"$eq": token},
+	}).Err()
+
+	return err == nil
+}
+
 // NewApiTokenRepo creates a new instance of this repository
 func NewApiTokenRepo(collection *mongo.Collection) ApiTokenRepository {
 	return &repository{
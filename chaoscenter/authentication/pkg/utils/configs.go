@@ -29,12 +29,15 @@ var (
 	CaCertPath                   = os.Getenv("CA_CERT_TLS_PATH")
 	RestPort                     = os.Getenv("REST_PORT")
 	GrpcPort                     = os.Getenv("GRPC_PORT")
+	OtelExporterOtlpEndpoint     = os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT")
 	DBName                       = "auth"
 	UserCollection               = "users"
 	ProjectCollection            = "project"
 	AuthConfigCollection         = "auth-config"
 	RevokedTokenCollection       = "revoked-token"
 	ApiTokenCollection           = "api-token"
+	AuditDBName                  = "litmus"
+	AuditEventCollection         = "auditEvents"
 	UsernameField                = "username"
 	ExpiresAtField               = "expires_at"
 	PasswordEncryptionCost       = 8
@@ -15,6 +15,7 @@ var (
 	ErrUserExists                    AppError = errors.New("user_exists")
 	ErrUserNotFound                  AppError = errors.New("user does not exist")
 	ErrProjectNotFound               AppError = errors.New("project does not exist")
+	ErrMemberNotFound                AppError = errors.New("member does not exist")
 	ErrWrongPassword                 AppError = errors.New("password doesn't match")
 	ErrUpdatingAdmin                 AppError = errors.New("cannot remove admin")
 	ErrUserDeactivated               AppError = errors.New("your account has been deactivated")
@@ -37,6 +38,7 @@ var ErrorStatusCodes = map[AppError]int{
 	ErrStrictUsernamePolicyViolation: 401,
 	ErrUserNotFound:                  400,
 	ErrProjectNotFound:               400,
+	ErrMemberNotFound:                404,
 	ErrUpdatingAdmin:                 400,
 	ErrUserDeactivated:               400,
 	ErrUserAlreadyDeactivated:        400,
@@ -59,6 +61,7 @@ var ErrorDescriptions = map[AppError]string{
 	ErrEmptyProjectName:              "Project name can't be empty",
 	ErrInvalidRole:                   "Role is invalid",
 	ErrProjectNotFound:               "This project does not exist",
+	ErrMemberNotFound:                "The user is not a member of this project",
 	ErrInvalidEmail:                  "Email address is invalid",
 	ErrPasswordNotUpdated:            "Please update your default password",
 	ErrOldPassword:                   "old and new passwords can't be same",
@@ -7,6 +7,7 @@ import (
 	grpc2 "github.com/litmuschaos/litmus/chaoscenter/authentication/api/presenter/protos"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -22,7 +23,8 @@ func GetProjectGRPCSvcClient(conn *grpc.ClientConn) (grpc2.ProjectClient, *grpc.
 		litmusGqlGrpcPort = DefaultLitmusGqlGrpcPort
 	}
 
-	conn, err := grpc.Dial(litmusGqlGrpcEndpoint+litmusGqlGrpcPort, grpc.WithInsecure(), grpc.WithBlock())
+	conn, err := grpc.Dial(litmusGqlGrpcEndpoint+litmusGqlGrpcPort, grpc.WithInsecure(), grpc.WithBlock(),
+		grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 	if err != nil {
 		logrus.Fatalf("did not connect: %s", err)
 	}
@@ -3,6 +3,7 @@ package validations
 import (
 	"errors"
 
+	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/entities"
 	"github.com/litmuschaos/litmus/chaoscenter/authentication/pkg/services"
 
 	log "github.com/sirupsen/logrus"
@@ -49,3 +50,61 @@ func RbacValidator(uid string, projectID string,
 
 	return nil
 }
+
+// ActionValidator checks if the user can perform the action of the scope in the project, the action is granted
+// either by the built-in role of the user being among the required roles or by a custom role assigned to the user.
+// The custom roles of the user are returned along so that the callers can make use of them
+func ActionValidator(uid string, projectID string,
+	requiredRoles []string, invitation string, scope entities.ActionScope,
+	service services.ApplicationService) ([]*entities.ProjectRole, error) {
+
+	user, err := service.GetUser(uid)
+	if err != nil {
+		log.Errorf("authgRPC Error: querying for user -  %s", err)
+		return nil, err
+	}
+	if user.DeactivatedAt != nil {
+		log.Error("authgRPC Error: Deactivated User")
+		return nil, errors.New("auth gRPC - Deactivated User")
+	}
+
+	project, err := service.GetProjectByProjectID(projectID)
+	if err != nil {
+		log.Errorf("authgRPC Error: %s", err)
+		return nil, err
+	}
+
+	var member *entities.Member
+	for _, m := range project.Members {
+		if m.UserID == uid && string(m.Invitation) == invitation {
+			member = m
+			break
+		}
+	}
+	if member == nil {
+		return nil, errors.New("auth gRPC - Unauthorized")
+	}
+
+	customRoles := project.GetMemberCustomRoles(member)
+	if isRequiredRole(member.Role, requiredRoles) {
+		return customRoles, nil
+	}
+	if scope.Action != "" {
+		for _, role := range customRoles {
+			if role.Allows(scope) {
+				return customRoles, nil
+			}
+		}
+	}
+
+	return nil, errors.New("auth gRPC - Unauthorized")
+}
+
+func isRequiredRole(role entities.MemberRole, requiredRoles []string) bool {
+	for _, requiredRole := range requiredRoles {
+		if string(role) == requiredRole {
+			return true
+		}
+	}
+	return false
+}
@@ -7,10 +7,15 @@ var MutationRbacRules = map[string][]string{
 	"acceptInvitation": {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleExecutor)},
 	"declineInvitation": {string(entities.RoleOwner), string(entities.RoleViewer),
 		string(entities.RoleExecutor)},
-	"removeInvitation":  {string(entities.RoleOwner)},
-	"leaveProject":      {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleExecutor)},
-	"updateProjectName": {string(entities.RoleOwner)},
-	"updateMemberRole":  {string(entities.RoleOwner)},
-	"deleteProject":     {string(entities.RoleOwner)},
-	"getProject":        {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleExecutor)},
+	"removeInvitation":        {string(entities.RoleOwner)},
+	"leaveProject":            {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleExecutor)},
+	"updateProjectName":       {string(entities.RoleOwner)},
+	"updateMemberRole":        {string(entities.RoleOwner)},
+	"deleteProject":           {string(entities.RoleOwner)},
+	"createProjectRole":       {string(entities.RoleOwner)},
+	"updateProjectRole":       {string(entities.RoleOwner)},
+	"deleteProjectRole":       {string(entities.RoleOwner)},
+	"updateMemberCustomRoles": {string(entities.RoleOwner)},
+	"listProjectRoles":        {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleExecutor)},
+	"getProject":              {string(entities.RoleOwner), string(entities.RoleViewer), string(entities.RoleExecutor)},
 }
@@ -0,0 +1,55 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/sirupsen/logrus"
+)
+
+// ListAuditEvents is the resolver for the listAuditEvents field.
+func (r *queryResolver) ListAuditEvents(ctx context.Context, projectID string, request *model.ListAuditEventsRequest) (*model.ListAuditEventsResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list audit events")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListAuditEvents,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.auditService.ListAuditEvents(ctx, projectID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// ExportAuditEvents is the resolver for the exportAuditEvents field.
+func (r *queryResolver) ExportAuditEvents(ctx context.Context, projectID string, filter *model.AuditEventFilterInput) (string, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to export audit events")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ExportAuditEvents,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return "", err
+	}
+
+	events, err := r.auditService.ExportAuditEvents(ctx, projectID, filter)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return "", err
+	}
+	return events, nil
+}
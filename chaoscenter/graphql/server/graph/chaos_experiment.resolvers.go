@@ -7,15 +7,34 @@ package graph
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
 	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
 	"go.mongodb.org/mongo-driver/bson"
 )
 
+// ExecutionData is the resolver for the executionData field.
+func (r *experimentRunResolver) ExecutionData(ctx context.Context, obj *model.ExperimentRun) (string, error) {
+	if obj.ExecutionData != "" {
+		return obj.ExecutionData, nil
+	}
+
+	executionData, err := r.chaosExperimentRunHandler.GetExperimentRunExecutionData(ctx, obj.ProjectID, obj.ExperimentRunID, obj.NotifyID)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"projectId":       obj.ProjectID,
+			"experimentRunId": obj.ExperimentRunID,
+		}).Error(err)
+		return "", err
+	}
+	return executionData, nil
+}
+
 // CreateChaosExperiment is the resolver for the createChaosExperiment field.
 func (r *mutationResolver) CreateChaosExperiment(ctx context.Context, request model.ChaosExperimentRequest, projectID string) (*model.ChaosExperimentResponse, error) {
 	logFields := logrus.Fields{
@@ -23,9 +42,7 @@ func (r *mutationResolver) CreateChaosExperiment(ctx context.Context, request mo
 	}
 
 	logrus.WithFields(logFields).Info("request received to create chaos experiment")
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateEnvironment],
-		model.InvitationAccepted.String())
+	err := r.validateExperimentRole(ctx, projectID, authorization.CreateEnvironment, "", request.InfraID)
 	if err != nil {
 		return nil, err
 	}
@@ -36,6 +53,9 @@ func (r *mutationResolver) CreateChaosExperiment(ctx context.Context, request mo
 		return nil, err
 	}
 
+	// the handler suspends the schedule of a cron experiment whose environment requires an approval to enable it
+	scheduleEnabled := !gjson.Get(request.ExperimentManifest, "spec.suspend").Bool()
+
 	uiResponse, err := r.chaosExperimentHandler.CreateChaosExperiment(ctx, &request, projectID, username)
 	if err != nil {
 		return nil, errors.New("could not create experiment, error: " + err.Error())
@@ -59,10 +79,33 @@ func (r *mutationResolver) CreateChaosExperiment(ctx context.Context, request mo
 				return nil, err
 			}
 			logrus.WithFields(logFields).WithField("workflowId", experiment.ExperimentID).Info("cron experiment created successfully")
+
+			// The cron experiment is scheduled suspended and its schedule is enabled once it is approved
+			if scheduleEnabled {
+				approval, err := r.runApprovalService.RequestApproval(ctx, projectID, experiment, model.RunApprovalActionEnableCron, nil, nil, username)
+				if err != nil {
+					logrus.WithFields(logFields).Error(err)
+					return nil, err
+				}
+				if approval != nil {
+					logrus.WithFields(logFields).WithField("approvalId", approval.ApprovalID).Info("enabling the cron experiment is waiting for an approval")
+				}
+			}
+			return uiResponse, nil
+		}
+
+		// The experiment is created but its run waits for an approval if its environment requires one
+		approval, err := r.runApprovalService.RequestApproval(ctx, projectID, experiment, model.RunApprovalActionRun, nil, nil, username)
+		if err != nil {
+			logrus.WithFields(logFields).Error(err)
+			return nil, err
+		}
+		if approval != nil {
+			logrus.WithFields(logFields).WithField("approvalId", approval.ApprovalID).Info("experiment run is waiting for an approval")
 			return uiResponse, nil
 		}
 
-		_, err = r.chaosExperimentRunHandler.RunChaosWorkFlow(ctx, projectID, experiment, data_store.Store)
+		_, err = r.chaosExperimentRunHandler.RunChaosWorkFlow(ctx, projectID, experiment, nil, nil, data_store.Store)
 		if err != nil {
 			logrus.WithFields(logFields).Error(err)
 			return nil, err
@@ -80,9 +123,7 @@ func (r *mutationResolver) SaveChaosExperiment(ctx context.Context, request mode
 		"projectId":    projectID,
 	}
 	logrus.WithFields(logFields).Info("request received to save chaos experiment")
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateChaosExperiment],
-		model.InvitationAccepted.String())
+	err := r.validateExperimentRole(ctx, projectID, authorization.CreateChaosExperiment, request.ID, request.InfraID)
 	if err != nil {
 		return "", err
 	}
@@ -112,9 +153,11 @@ func (r *mutationResolver) UpdateChaosExperiment(ctx context.Context, request mo
 	}
 
 	logrus.WithFields(logFields).Info("request received to update chaos experiment")
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ReRunChaosExperiment],
-		model.InvitationAccepted.String())
+	var experimentID string
+	if request.ExperimentID != nil {
+		experimentID = *request.ExperimentID
+	}
+	err := r.validateExperimentRole(ctx, projectID, authorization.ReRunChaosExperiment, experimentID, request.InfraID)
 	if err != nil {
 		return nil, err
 	}
@@ -143,9 +186,7 @@ func (r *mutationResolver) DeleteChaosExperiment(ctx context.Context, experiment
 
 	logrus.WithFields(logFields).Info("request received to delete chaos experiment")
 
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteChaosExperiment],
-		model.InvitationAccepted.String())
+	err := r.validateExperimentRole(ctx, projectID, authorization.DeleteChaosExperiment, experimentID, "")
 	if err != nil {
 		return false, err
 	}
@@ -173,9 +214,7 @@ func (r *mutationResolver) UpdateCronExperimentState(ctx context.Context, experi
 
 	logrus.WithFields(logFields).Info("request received to update cron chaos experiment")
 
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateChaosExperiment],
-		model.InvitationAccepted.String())
+	err := r.validateExperimentRole(ctx, projectID, authorization.UpdateChaosExperiment, experimentID, "")
 	if err != nil {
 		return false, err
 	}
@@ -186,6 +225,27 @@ func (r *mutationResolver) UpdateCronExperimentState(ctx context.Context, experi
 		return false, err
 	}
 
+	// Enabling the cron schedule waits for an approval if the environment of the experiment requires one
+	if !disable {
+		experiment, err := r.chaosExperimentHandler.GetDBExperiment(bson.D{
+			{"experiment_id", experimentID},
+			{"project_id", projectID},
+			{"is_removed", false},
+		})
+		if err != nil {
+			return false, errors.New("could not get experiment, error: " + err.Error())
+		}
+		approval, err := r.runApprovalService.RequestApproval(ctx, projectID, experiment, model.RunApprovalActionEnableCron, nil, nil, username)
+		if err != nil {
+			logrus.WithFields(logFields).Error(err)
+			return false, err
+		}
+		if approval != nil {
+			logrus.WithFields(logFields).WithField("approvalId", approval.ApprovalID).Info("enabling the cron experiment is waiting for an approval")
+			return false, fmt.Errorf("enabling the cron experiment requires an approval, approval request %s is pending", approval.ApprovalID)
+		}
+	}
+
 	uiResponse, err := r.chaosExperimentHandler.UpdateCronExperimentState(ctx, experimentID, disable, projectID, data_store.Store, username)
 	if err != nil {
 		logrus.WithFields(logFields).Error(err)
@@ -194,6 +254,61 @@ func (r *mutationResolver) UpdateCronExperimentState(ctx context.Context, experi
 	return uiResponse, err
 }
 
+// RollbackChaosExperiment is the resolver for the rollbackChaosExperiment field.
+func (r *mutationResolver) RollbackChaosExperiment(ctx context.Context, projectID string, experimentID string, revisionID string) (*model.ChaosExperimentResponse, error) {
+	logFields := logrus.Fields{
+		"projectId":         projectID,
+		"chaosExperimentId": experimentID,
+		"revisionId":        revisionID,
+	}
+
+	logrus.WithFields(logFields).Info("request received to rollback chaos experiment")
+	err := r.validateExperimentRole(ctx, projectID, authorization.UpdateChaosExperiment, experimentID, "")
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentHandler.RollbackChaosExperiment(ctx, projectID, experimentID, revisionID, data_store.Store, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, err
+}
+
+// ImportExperimentBundle is the resolver for the importExperimentBundle field.
+func (r *mutationResolver) ImportExperimentBundle(ctx context.Context, projectID string, request model.ImportExperimentBundleRequest) (*model.ImportExperimentBundleResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"infraId":   request.InfraID,
+	}
+
+	logrus.WithFields(logFields).Info("request received to import chaos experiment bundle")
+	err := r.validateExperimentRole(ctx, projectID, authorization.CreateChaosExperiment, "", request.InfraID)
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentHandler.ImportExperimentBundle(ctx, projectID, request, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, err
+}
+
 // GetExperiment is the resolver for the getExperiment field.
 func (r *queryResolver) GetExperiment(ctx context.Context, projectID string, experimentID string) (*model.GetExperimentResponse, error) {
 	logFields := logrus.Fields{
@@ -202,7 +317,7 @@ func (r *queryResolver) GetExperiment(ctx context.Context, projectID string, exp
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos experiment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListExperiment],
+		authorization.ListExperiment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -225,7 +340,7 @@ func (r *queryResolver) ListExperiment(ctx context.Context, projectID string, re
 	logrus.WithFields(logFields).Info("request received to list chaos experiments")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListExperiment],
+		authorization.ListExperiment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -246,7 +361,7 @@ func (r *queryResolver) GetExperimentStats(ctx context.Context, projectID string
 	logrus.WithFields(logFields).Info("request received to get chaos experiment stats")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListExperiment],
+		authorization.ListExperiment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -260,11 +375,105 @@ func (r *queryResolver) GetExperimentStats(ctx context.Context, projectID string
 	return uiResponse, err
 }
 
+// ListExperimentRevisions is the resolver for the listExperimentRevisions field.
+func (r *queryResolver) ListExperimentRevisions(ctx context.Context, projectID string, experimentID string) ([]*model.ExperimentRevision, error) {
+	logFields := logrus.Fields{
+		"projectId":         projectID,
+		"chaosExperimentId": experimentID,
+	}
+	logrus.WithFields(logFields).Info("request received to list chaos experiment revisions")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListExperiment,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	revisions, err := r.chaosExperimentHandler.ListExperimentRevisions(ctx, projectID, experimentID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return revisions, err
+}
+
+// GetExperimentRevisionDiff is the resolver for the getExperimentRevisionDiff field.
+func (r *queryResolver) GetExperimentRevisionDiff(ctx context.Context, projectID string, experimentID string, revisionIDA string, revisionIDB string) ([]*model.ManifestDiff, error) {
+	logFields := logrus.Fields{
+		"projectId":         projectID,
+		"chaosExperimentId": experimentID,
+		"revisionIdA":       revisionIDA,
+		"revisionIdB":       revisionIDB,
+	}
+	logrus.WithFields(logFields).Info("request received to get chaos experiment revision diff")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListExperiment,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := r.chaosExperimentHandler.GetExperimentRevisionDiff(ctx, projectID, experimentID, revisionIDA, revisionIDB)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return diff, err
+}
+
+// ExportExperimentBundle is the resolver for the exportExperimentBundle field.
+func (r *queryResolver) ExportExperimentBundle(ctx context.Context, projectID string, experimentID string) (string, error) {
+	logFields := logrus.Fields{
+		"projectId":         projectID,
+		"chaosExperimentId": experimentID,
+	}
+	logrus.WithFields(logFields).Info("request received to export chaos experiment bundle")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListExperiment,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return "", err
+	}
+
+	bundle, err := r.chaosExperimentHandler.ExportExperimentBundle(ctx, projectID, experimentID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return "", err
+	}
+	return bundle, err
+}
+
+// ValidateChaosExperiment is the resolver for the validateChaosExperiment field.
+func (r *queryResolver) ValidateChaosExperiment(ctx context.Context, projectID string, request model.ChaosExperimentRequest) (*model.ValidateChaosExperimentResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"infraId":   request.InfraID,
+	}
+	logrus.WithFields(logFields).Info("request received to validate chaos experiment")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.CreateChaosExperiment,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentHandler.ValidateChaosExperiment(ctx, projectID, request, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, nil
+}
+
+// ExperimentRun returns generated.ExperimentRunResolver implementation.
+func (r *Resolver) ExperimentRun() generated.ExperimentRunResolver { return &experimentRunResolver{r} }
+
 // Mutation returns generated.MutationResolver implementation.
 func (r *Resolver) Mutation() generated.MutationResolver { return &mutationResolver{r} }
 
 // Query returns generated.QueryResolver implementation.
 func (r *Resolver) Query() generated.QueryResolver { return &queryResolver{r} }
 
+type experimentRunResolver struct{ *Resolver }
 type mutationResolver struct{ *Resolver }
 type queryResolver struct{ *Resolver }
@@ -17,20 +17,28 @@ import (
 
 // ChaosExperimentRun is the resolver for the chaosExperimentRun field.
 func (r *mutationResolver) ChaosExperimentRun(ctx context.Context, request model.ExperimentRunRequest) (string, error) {
-	return r.chaosExperimentRunHandler.ChaosExperimentRunEvent(request)
+	return r.chaosExperimentRunHandler.ChaosExperimentRunEvent(request, data_store.Store)
+}
+
+// ReconcileExperimentRuns is the resolver for the reconcileExperimentRuns field.
+func (r *mutationResolver) ReconcileExperimentRuns(ctx context.Context, request model.ReconcileExperimentRunsRequest) (string, error) {
+	infra, err := r.chaosInfrastructureService.VerifyInfra(*request.InfraID)
+	if err != nil {
+		logrus.Error("Validation failed : ", request.InfraID.InfraID)
+		return "", err
+	}
+	return r.chaosExperimentRunHandler.ReconcileInfraExperimentRuns(ctx, infra.InfraID, request.ExperimentRunIDs, data_store.Store)
 }
 
 // RunChaosExperiment is the resolver for the runChaosExperiment field.
-func (r *mutationResolver) RunChaosExperiment(ctx context.Context, experimentID string, projectID string) (*model.RunChaosExperimentResponse, error) {
+func (r *mutationResolver) RunChaosExperiment(ctx context.Context, experimentID string, projectID string, inputs []*model.ExperimentInputValueRequest, priority *int) (*model.RunChaosExperimentResponse, error) {
 	logFields := logrus.Fields{
 		"projectId":         projectID,
 		"chaosExperimentId": experimentID,
 	}
 
 	logrus.WithFields(logFields).Info("request received to run chaos experiment")
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ReRunChaosExperiment],
-		model.InvitationAccepted.String())
+	err := r.validateExperimentRole(ctx, projectID, authorization.ReRunChaosExperiment, experimentID, "")
 	if err != nil {
 		return nil, err
 	}
@@ -45,9 +53,25 @@ func (r *mutationResolver) RunChaosExperiment(ctx context.Context, experimentID
 		return nil, errors.New("could not get experiment run, error: " + err.Error())
 	}
 
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	// Runs in the environments requiring run approvals wait for a project owner to approve them
+	approval, err := r.runApprovalService.RequestApproval(ctx, projectID, experiment, model.RunApprovalActionRun, inputs, priority, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	if approval != nil {
+		return &model.RunChaosExperimentResponse{NotifyID: "", ApprovalID: &approval.ApprovalID}, nil
+	}
+
 	var uiResponse *model.RunChaosExperimentResponse
 
-	uiResponse, err = r.chaosExperimentRunHandler.RunChaosWorkFlow(ctx, projectID, experiment, data_store.Store)
+	uiResponse, err = r.chaosExperimentRunHandler.RunChaosWorkFlow(ctx, projectID, experiment, inputs, priority, data_store.Store)
 	if err != nil {
 		logrus.WithFields(logFields).Error(err)
 		return nil, err
@@ -66,9 +90,7 @@ func (r *mutationResolver) StopExperimentRuns(ctx context.Context, projectID str
 	}
 
 	logrus.WithFields(logFields).Info("request received to stop chaos experiment")
-	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.StopChaosExperiment],
-		model.InvitationAccepted.String())
+	err := r.validateExperimentRole(ctx, projectID, authorization.StopChaosExperiment, experimentID, "")
 	if err != nil {
 		return false, err
 	}
@@ -89,7 +111,7 @@ func (r *queryResolver) GetExperimentRun(ctx context.Context, projectID string,
 	}
 	logrus.WithFields(logFields).Info("request received to fetch chaos experiment run")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetWorkflowRun],
+		authorization.GetWorkflowRun,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -113,7 +135,7 @@ func (r *queryResolver) ListExperimentRun(ctx context.Context, projectID string,
 	logrus.WithFields(logFields).Info("request received to list chaos experiment run")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflowRuns],
+		authorization.ListWorkflowRuns,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -133,7 +155,7 @@ func (r *queryResolver) GetExperimentRunStats(ctx context.Context, projectID str
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos experiment run stats")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListWorkflowRuns],
+		authorization.ListWorkflowRuns,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -146,3 +168,104 @@ func (r *queryResolver) GetExperimentRunStats(ctx context.Context, projectID str
 	}
 	return uiResponse, err
 }
+
+// GetResiliencyTrends is the resolver for the getResiliencyTrends field.
+func (r *queryResolver) GetResiliencyTrends(ctx context.Context, projectID string, request model.ResiliencyTrendRequest) (*model.ResiliencyTrendResponse, error) {
+	logFields := logrus.Fields{
+		"projectId":   projectID,
+		"granularity": request.Granularity,
+	}
+	logrus.WithFields(logFields).Info("request received to get resiliency trends")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListWorkflowRuns,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentRunHandler.GetResiliencyTrends(ctx, projectID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, err
+}
+
+// CompareExperimentRuns is the resolver for the compareExperimentRuns field.
+func (r *queryResolver) CompareExperimentRuns(ctx context.Context, projectID string, runA string, runB string) (*model.CompareExperimentRunsResponse, error) {
+	logFields := logrus.Fields{
+		"projectId":             projectID,
+		"chaosExperimentRunIdA": runA,
+		"chaosExperimentRunIdB": runB,
+	}
+	logrus.WithFields(logFields).Info("request received to compare chaos experiment runs")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetWorkflowRun,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	uiResponse, err := r.chaosExperimentRunHandler.CompareExperimentRuns(ctx, projectID, runA, runB)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return uiResponse, err
+}
+
+// ExperimentRunEvents is the resolver for the experimentRunEvents field.
+func (r *subscriptionResolver) ExperimentRunEvents(ctx context.Context, projectID string, experimentRunID *string, notifyID *string) (<-chan *model.ExperimentRun, error) {
+	logFields := logrus.Fields{
+		"projectId":            projectID,
+		"chaosExperimentRunId": experimentRunID,
+		"notifyID":             notifyID,
+	}
+	logrus.WithFields(logFields).Info("request received to subscribe to chaos experiment run events")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetWorkflowRun,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	var identifier string
+	switch {
+	case experimentRunID != nil && *experimentRunID != "":
+		identifier = *experimentRunID
+	case notifyID != nil && *notifyID != "":
+		identifier = *notifyID
+	default:
+		return nil, errors.New("experimentRunID or notifyID not provided")
+	}
+
+	experimentRunEvent := make(chan *model.ExperimentRun, 1)
+	key := data_store.ExperimentRunEventKey(projectID, identifier)
+
+	// The channel is registered before the current state of the run is read, so that an event published
+	// in the meantime is not missed
+	data_store.Store.Mutex.Lock()
+	data_store.Store.ExperimentEventPublish[key] = append(data_store.Store.ExperimentEventPublish[key], experimentRunEvent)
+	data_store.Store.Mutex.Unlock()
+
+	// Send the current state of the run, if it has already completed there is nothing left to listen for
+	if expRun, err := r.chaosExperimentRunHandler.GetExperimentRun(ctx, projectID, experimentRunID, notifyID); err == nil {
+		switch expRun.Phase {
+		case model.ExperimentRunStatusCompleted, model.ExperimentRunStatusCompletedWithError, model.ExperimentRunStatusStopped,
+			model.ExperimentRunStatusError, model.ExperimentRunStatusTimeout, model.ExperimentRunStatusTerminated, model.ExperimentRunStatusSkipped:
+			data_store.Store.SendExperimentRunSnapshot(key, experimentRunEvent, expRun, true)
+		default:
+			data_store.Store.SendExperimentRunSnapshot(key, experimentRunEvent, expRun, false)
+		}
+	}
+
+	go func() {
+		<-ctx.Done()
+		logrus.WithFields(logFields).Info("closed chaos experiment run events listener")
+
+		// The channel is closed by the publisher once the run completes, only the registration is removed here
+		data_store.Store.RemoveExperimentRunObserver(key, experimentRunEvent)
+	}()
+
+	return experimentRunEvent, nil
+}
@@ -32,7 +32,7 @@ func (r *mutationResolver) RegisterInfra(ctx context.Context, projectID string,
 	logrus.WithFields(logFields).Info("request received for new a chaos infrastructure")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UserInfrastructureReg],
+		authorization.UserInfrastructureReg,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -61,7 +61,7 @@ func (r *mutationResolver) DeleteInfra(ctx context.Context, projectID string, in
 	logrus.WithFields(logFields).Info("request received to delete chaos infrastructure")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteInfrastructures],
+		authorization.DeleteInfrastructures,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -75,6 +75,35 @@ func (r *mutationResolver) DeleteInfra(ctx context.Context, projectID string, in
 	return dcaResponse, err
 }
 
+// UpdateInfraRunLimit is the resolver for the updateInfraRunLimit field.
+func (r *mutationResolver) UpdateInfraRunLimit(ctx context.Context, projectID string, infraID string, request model.InfraRunLimitRequest) (string, error) {
+	logFields := logrus.Fields{
+		"projectId":    projectID,
+		"chaosInfraId": infraID,
+	}
+
+	logrus.WithFields(logFields).Info("request received to update the run limit of chaos infrastructure")
+
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateInfraRunLimit,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return "", err
+	}
+
+	response, err := r.chaosInfrastructureService.UpdateInfraRunLimit(ctx, projectID, infraID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return "", err
+	}
+
+	// Raising the limit can free slots for the queued runs of the infra
+	if err := r.runDispatcher.DispatchQueuedRuns(ctx, infraID, data_store.Store); err != nil {
+		logrus.WithFields(logFields).Error(err)
+	}
+	return response, nil
+}
+
 // GetManifestWithInfraID is the resolver for the getManifestWithInfraID field.
 func (r *mutationResolver) GetManifestWithInfraID(ctx context.Context, projectID string, infraID string, accessKey string) (string, error) {
 	logFields := logrus.Fields{
@@ -129,7 +158,7 @@ func (r *queryResolver) GetInfra(ctx context.Context, projectID string, infraID
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetInfrastructure],
+		authorization.GetInfrastructure,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -149,7 +178,7 @@ func (r *queryResolver) ListInfras(ctx context.Context, projectID string, reques
 	}
 	logrus.WithFields(logFields).Info("request received to list chaos infrastructures")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListInfrastructures],
+		authorization.ListInfrastructures,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -171,7 +200,7 @@ func (r *queryResolver) GetInfraDetails(ctx context.Context, infraID string, pro
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure details")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetInfraDetails],
+		authorization.GetInfraDetails,
 		model.InvitationAccepted.String())
 
 	gcaResponse, err := r.chaosInfrastructureService.GetInfraDetails(ctx, infraID, projectID)
@@ -205,7 +234,7 @@ func (r *queryResolver) GetInfraManifest(ctx context.Context, infraID string, up
 
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure manifest")
 	err = authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetManifest],
+		authorization.GetManifest,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -231,7 +260,7 @@ func (r *queryResolver) GetInfraStats(ctx context.Context, projectID string) (*m
 	}
 	logrus.WithFields(logFields).Info("request received to get chaos infrastructure stats")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetInfraDetails],
+		authorization.GetInfraDetails,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -333,7 +362,7 @@ func (r *subscriptionResolver) GetPodLog(ctx context.Context, request model.PodL
 		logrus.Print("CLOSED LOG LISTENER: ", request.InfraID, request.PodName)
 		delete(data_store.Store.ExperimentLog, reqID.String())
 	}()
-	go r.chaosExperimentHandler.GetLogs(reqID.String(), request, *data_store.Store)
+	go r.chaosExperimentHandler.GetLogs(ctx, reqID.String(), request, *data_store.Store)
 	return workflowLog, nil
 }
 
@@ -350,7 +379,7 @@ func (r *subscriptionResolver) GetKubeObject(ctx context.Context, request model.
 		logrus.Println("Closed KubeObj Listener")
 		delete(data_store.Store.KubeObjectData, reqID.String())
 	}()
-	go r.chaosExperimentHandler.GetKubeObjData(reqID.String(), request, *data_store.Store)
+	go r.chaosExperimentHandler.GetKubeObjData(ctx, reqID.String(), request, *data_store.Store)
 
 	return kubeObjData, nil
 }
@@ -368,7 +397,7 @@ func (r *subscriptionResolver) GetKubeNamespace(ctx context.Context, request mod
 		logrus.Println("Closed KubeNamespace Listener")
 		delete(data_store.Store.KubeNamespaceData, reqID.String())
 	}()
-	go r.chaosExperimentHandler.GetKubeNamespaceData(reqID.String(), request, *data_store.Store)
+	go r.chaosExperimentHandler.GetKubeNamespaceData(ctx, reqID.String(), request, *data_store.Store)
 
 	return kubeNamespaceData, nil
 }
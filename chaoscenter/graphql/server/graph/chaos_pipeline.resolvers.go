@@ -0,0 +1,232 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateChaosPipeline is the resolver for the createChaosPipeline field.
+func (r *mutationResolver) CreateChaosPipeline(ctx context.Context, projectID string, request model.CreateChaosPipelineRequest) (*model.ChaosPipeline, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to create chaos pipeline")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.CreateChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := r.chaosPipelineService.CreatePipeline(ctx, projectID, request, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+// UpdateChaosPipeline is the resolver for the updateChaosPipeline field.
+func (r *mutationResolver) UpdateChaosPipeline(ctx context.Context, projectID string, request model.UpdateChaosPipelineRequest) (*model.ChaosPipeline, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"pipelineId": request.PipelineID,
+	}
+	logrus.WithFields(logFields).Info("request received to update chaos pipeline")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline, err := r.chaosPipelineService.UpdatePipeline(ctx, projectID, request, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return pipeline, nil
+}
+
+// DeleteChaosPipeline is the resolver for the deleteChaosPipeline field.
+func (r *mutationResolver) DeleteChaosPipeline(ctx context.Context, projectID string, pipelineID string) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"pipelineId": pipelineID,
+	}
+	logrus.WithFields(logFields).Info("request received to delete chaos pipeline")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.DeleteChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := r.chaosPipelineService.DeletePipeline(ctx, projectID, pipelineID, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return false, err
+	}
+	return deleted, nil
+}
+
+// RunChaosPipeline is the resolver for the runChaosPipeline field.
+func (r *mutationResolver) RunChaosPipeline(ctx context.Context, projectID string, pipelineID string) (*model.PipelineExecution, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"pipelineId": pipelineID,
+	}
+	logrus.WithFields(logFields).Info("request received to run chaos pipeline")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.RunChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	// The experiments of the stages are run on behalf of the user, in the environments of their infras
+	pipeline, err := r.chaosPipelineService.GetPipeline(ctx, projectID, pipelineID)
+	if err != nil {
+		return nil, err
+	}
+	for _, stage := range pipeline.Stages {
+		err := r.validateExperimentRole(ctx, projectID, authorization.ReRunChaosExperiment, stage.ExperimentID, "")
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	execution, err := r.chaosPipelineService.RunPipeline(ctx, projectID, pipelineID, username, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return execution, nil
+}
+
+// StopPipelineExecution is the resolver for the stopPipelineExecution field.
+func (r *mutationResolver) StopPipelineExecution(ctx context.Context, projectID string, pipelineExecutionID string) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId":           projectID,
+		"pipelineExecutionId": pipelineExecutionID,
+	}
+	logrus.WithFields(logFields).Info("request received to stop chaos pipeline execution")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.RunChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return false, err
+	}
+
+	stopped, err := r.chaosPipelineService.StopPipelineExecution(ctx, projectID, pipelineExecutionID, username, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return false, err
+	}
+	return stopped, nil
+}
+
+// GetChaosPipeline is the resolver for the getChaosPipeline field.
+func (r *queryResolver) GetChaosPipeline(ctx context.Context, projectID string, pipelineID string) (*model.ChaosPipeline, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"pipelineId": pipelineID,
+	}
+	logrus.WithFields(logFields).Info("request received to get chaos pipeline")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.chaosPipelineService.GetPipeline(ctx, projectID, pipelineID)
+}
+
+// ListChaosPipelines is the resolver for the listChaosPipelines field.
+func (r *queryResolver) ListChaosPipelines(ctx context.Context, projectID string) ([]*model.ChaosPipeline, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list chaos pipelines")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListChaosPipelines,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.chaosPipelineService.ListPipelines(ctx, projectID)
+}
+
+// GetPipelineExecution is the resolver for the getPipelineExecution field.
+func (r *queryResolver) GetPipelineExecution(ctx context.Context, projectID string, pipelineExecutionID string) (*model.PipelineExecution, error) {
+	logFields := logrus.Fields{
+		"projectId":           projectID,
+		"pipelineExecutionId": pipelineExecutionID,
+	}
+	logrus.WithFields(logFields).Info("request received to get chaos pipeline execution")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.chaosPipelineService.GetPipelineExecution(ctx, projectID, pipelineExecutionID)
+}
+
+// ListPipelineExecutions is the resolver for the listPipelineExecutions field.
+func (r *queryResolver) ListPipelineExecutions(ctx context.Context, projectID string, pipelineID string) ([]*model.PipelineExecution, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"pipelineId": pipelineID,
+	}
+	logrus.WithFields(logFields).Info("request received to list chaos pipeline executions")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetChaosPipeline,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return r.chaosPipelineService.ListPipelineExecutions(ctx, projectID, pipelineID)
+}
@@ -15,7 +15,7 @@ import (
 // AddChaosHub is the resolver for the addChaosHub field.
 func (r *mutationResolver) AddChaosHub(ctx context.Context, projectID string, request model.CreateChaosHubRequest) (*model.ChaosHub, error) {
 	if err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.AddChaosHub],
+		authorization.AddChaosHub,
 		model.InvitationAccepted.String()); err != nil {
 		return nil, err
 	}
@@ -26,7 +26,7 @@ func (r *mutationResolver) AddChaosHub(ctx context.Context, projectID string, re
 // AddRemoteChaosHub is the resolver for the addRemoteChaosHub field.
 func (r *mutationResolver) AddRemoteChaosHub(ctx context.Context, projectID string, request model.CreateRemoteChaosHub) (*model.ChaosHub, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.SaveChaosHub],
+		authorization.SaveChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -38,7 +38,7 @@ func (r *mutationResolver) AddRemoteChaosHub(ctx context.Context, projectID stri
 // SaveChaosHub is the resolver for the saveChaosHub field.
 func (r *mutationResolver) SaveChaosHub(ctx context.Context, projectID string, request model.CreateChaosHubRequest) (*model.ChaosHub, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.SaveChaosHub],
+		authorization.SaveChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -50,7 +50,7 @@ func (r *mutationResolver) SaveChaosHub(ctx context.Context, projectID string, r
 // SyncChaosHub is the resolver for the syncChaosHub field.
 func (r *mutationResolver) SyncChaosHub(ctx context.Context, id string, projectID string) (string, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateChaosExperiment],
+		authorization.UpdateChaosExperiment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -74,7 +74,7 @@ func (r *mutationResolver) GenerateSSHKey(ctx context.Context) (*model.SSHKey, e
 // UpdateChaosHub is the resolver for the updateChaosHub field.
 func (r *mutationResolver) UpdateChaosHub(ctx context.Context, projectID string, request model.UpdateChaosHubRequest) (*model.ChaosHub, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateChaosHub],
+		authorization.UpdateChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -85,7 +85,7 @@ func (r *mutationResolver) UpdateChaosHub(ctx context.Context, projectID string,
 // DeleteChaosHub is the resolver for the deleteChaosHub field.
 func (r *mutationResolver) DeleteChaosHub(ctx context.Context, projectID string, hubID string) (bool, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteChaosHub],
+		authorization.DeleteChaosHub,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -96,7 +96,7 @@ func (r *mutationResolver) DeleteChaosHub(ctx context.Context, projectID string,
 // ListChaosFaults is the resolver for the listChaosFaults field.
 func (r *queryResolver) ListChaosFaults(ctx context.Context, hubID string, projectID string) ([]*model.Chart, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListCharts],
+		authorization.ListCharts,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -19,7 +19,7 @@ func (r *mutationResolver) CreateEnvironment(ctx context.Context, projectID stri
 	}
 	logrus.WithFields(logFields).Info("request received to create new environment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateEnvironment],
+		authorization.CreateEnvironment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -42,7 +42,7 @@ func (r *mutationResolver) UpdateEnvironment(ctx context.Context, projectID stri
 	}
 	logrus.WithFields(logFields).Info("request received to update environment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateEnvironment],
+		authorization.UpdateEnvironment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -65,7 +65,7 @@ func (r *mutationResolver) DeleteEnvironment(ctx context.Context, projectID stri
 	}
 	logrus.WithFields(logFields).Info("request received to delete environment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteEnvironment],
+		authorization.DeleteEnvironment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -88,7 +88,7 @@ func (r *queryResolver) GetEnvironment(ctx context.Context, projectID string, en
 	}
 	logrus.WithFields(logFields).Info("request received to get environment")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetEnvironment],
+		authorization.GetEnvironment,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -103,7 +103,7 @@ func (r *queryResolver) ListEnvironments(ctx context.Context, projectID string,
 	}
 	logrus.WithFields(logFields).Info("request received to list environments")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListEnvironments],
+		authorization.ListEnvironments,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
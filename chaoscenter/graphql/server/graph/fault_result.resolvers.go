@@ -0,0 +1,56 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/sirupsen/logrus"
+)
+
+// ListFaultResults is the resolver for the listFaultResults field.
+func (r *queryResolver) ListFaultResults(ctx context.Context, projectID string, request *model.ListFaultResultsRequest) (*model.ListFaultResultsResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list fault results")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListFaultResults,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.faultResultService.ListFaultResults(ctx, projectID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// GetFaultAnalytics is the resolver for the getFaultAnalytics field.
+func (r *queryResolver) GetFaultAnalytics(ctx context.Context, projectID string, request model.FaultAnalyticsRequest) (*model.FaultAnalyticsResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"groupBy":   request.GroupBy,
+	}
+	logrus.WithFields(logFields).Info("request received to get fault analytics")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetFaultAnalytics,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.faultResultService.GetFaultAnalytics(ctx, projectID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return response, nil
+}
@@ -25,7 +25,7 @@ func (r *mutationResolver) GitopsNotifier(ctx context.Context, clusterInfo model
 // EnableGitOps is the resolver for the enableGitOps field.
 func (r *mutationResolver) EnableGitOps(ctx context.Context, projectID string, configurations model.GitConfig) (bool, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.EnableGitOps],
+		authorization.EnableGitOps,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -36,7 +36,7 @@ func (r *mutationResolver) EnableGitOps(ctx context.Context, projectID string, c
 // DisableGitOps is the resolver for the disableGitOps field.
 func (r *mutationResolver) DisableGitOps(ctx context.Context, projectID string) (bool, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DisableGitOps],
+		authorization.DisableGitOps,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -47,7 +47,7 @@ func (r *mutationResolver) DisableGitOps(ctx context.Context, projectID string)
 // UpdateGitOps is the resolver for the updateGitOps field.
 func (r *mutationResolver) UpdateGitOps(ctx context.Context, projectID string, configurations model.GitConfig) (bool, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateGitOps],
+		authorization.UpdateGitOps,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -58,7 +58,7 @@ func (r *mutationResolver) UpdateGitOps(ctx context.Context, projectID string, c
 // GetGitOpsDetails is the resolver for the getGitOpsDetails field.
 func (r *queryResolver) GetGitOpsDetails(ctx context.Context, projectID string) (*model.GitConfigResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetGitOpsDetails],
+		authorization.GetGitOpsDetails,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -15,7 +15,7 @@ import (
 // CreateImageRegistry is the resolver for the createImageRegistry field.
 func (r *mutationResolver) CreateImageRegistry(ctx context.Context, projectID string, imageRegistryInfo model.ImageRegistryInput) (*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.CreateImageRegistry],
+		authorization.CreateImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -31,7 +31,7 @@ func (r *mutationResolver) CreateImageRegistry(ctx context.Context, projectID st
 // UpdateImageRegistry is the resolver for the updateImageRegistry field.
 func (r *mutationResolver) UpdateImageRegistry(ctx context.Context, imageRegistryID string, projectID string, imageRegistryInfo model.ImageRegistryInput) (*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateImageRegistry],
+		authorization.UpdateImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -48,7 +48,7 @@ func (r *mutationResolver) UpdateImageRegistry(ctx context.Context, imageRegistr
 // DeleteImageRegistry is the resolver for the deleteImageRegistry field.
 func (r *mutationResolver) DeleteImageRegistry(ctx context.Context, imageRegistryID string, projectID string) (string, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteImageRegistry],
+		authorization.DeleteImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -65,7 +65,7 @@ func (r *mutationResolver) DeleteImageRegistry(ctx context.Context, imageRegistr
 // ListImageRegistry is the resolver for the listImageRegistry field.
 func (r *queryResolver) ListImageRegistry(ctx context.Context, projectID string) ([]*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListImageRegistry],
+		authorization.ListImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -82,7 +82,7 @@ func (r *queryResolver) ListImageRegistry(ctx context.Context, projectID string)
 // GetImageRegistry is the resolver for the getImageRegistry field.
 func (r *queryResolver) GetImageRegistry(ctx context.Context, projectID string) (*model.ImageRegistryResponse, error) {
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetImageRegistry],
+		authorization.GetImageRegistry,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -51,6 +51,9 @@ type ActionPayload struct {
 	Namespace    string  `json:"namespace"`
 	ExternalData *string `json:"externalData,omitempty"`
 	Username     *string `json:"username,omitempty"`
+	// W3C trace context of the request encoded as a JSON map of the propagation headers,
+	// the subscriber continues the trace of the request while processing it
+	TraceContext *string `json:"traceContext,omitempty"`
 }
 
 type Annotation struct {
@@ -62,6 +65,69 @@ type Annotation struct {
 	ChartDescription string `json:"chartDescription"`
 }
 
+// Defines an entry of the append-only audit log, an entry is recorded for every GraphQL mutation
+// and every request to the REST endpoints of the authentication server
+type AuditEvent struct {
+	AuditID string `json:"auditID"`
+	// ID of the project the request was made in, empty for the requests which are not scoped to a project
+	ProjectID *string          `json:"projectID,omitempty"`
+	Source    AuditEventSource `json:"source"`
+	// Username of the user who made the request
+	Actor string `json:"actor"`
+	// ID of the user who made the request
+	ActorID *string `json:"actorID,omitempty"`
+	// Name of the mutation or the method and path of the REST endpoint
+	Action string `json:"action"`
+	// IDs of the resources targeted by the request
+	ResourceIDs []string `json:"resourceIDs"`
+	// JSON summary of the fields of the targeted resource changed by the request, before the request
+	Before *string `json:"before,omitempty"`
+	// JSON summary of the fields of the targeted resource changed by the request, after the request
+	After *string `json:"after,omitempty"`
+	// IP address of the client which made the request
+	SourceIP string `json:"sourceIP"`
+	// Bool value indicating if the request was authenticated with an API token
+	IsAPIToken bool `json:"isAPIToken"`
+	// Bool value indicating if the request succeeded
+	Succeeded bool `json:"succeeded"`
+	// Error returned to the client if the request failed
+	Error     *string `json:"error,omitempty"`
+	CreatedAt string  `json:"createdAt"`
+}
+
+// Defines the filters on the audit events, all the events are matched if empty
+type AuditEventFilterInput struct {
+	// Username of the user who made the requests
+	Actor *string `json:"actor,omitempty"`
+	// Name of the mutation or the method and path of the REST endpoint
+	Action *string `json:"action,omitempty"`
+	// ID of a resource targeted by the requests
+	ResourceID *string           `json:"resourceID,omitempty"`
+	Source     *AuditEventSource `json:"source,omitempty"`
+	// Only the events recorded in the date range are matched, the dates are in milliseconds since epoch
+	DateRange *DateRange `json:"dateRange,omitempty"`
+}
+
+// Defines a window in which no chaos experiment runs are started on the infras of an environment
+type BlackoutWindow struct {
+	WindowID string `json:"windowID"`
+	Name     string `json:"name"`
+	// Start time of the window in unix milliseconds, for recurring windows it is the start of the first occurrence
+	StartTime string `json:"startTime"`
+	// End time of the window in unix milliseconds, for recurring windows it is the end of the first occurrence
+	EndTime    string                   `json:"endTime"`
+	Recurrence BlackoutWindowRecurrence `json:"recurrence"`
+}
+
+type BlackoutWindowInput struct {
+	Name string `json:"name"`
+	// Start time of the window in unix milliseconds
+	StartTime string `json:"startTime"`
+	// End time of the window in unix milliseconds
+	EndTime    string                   `json:"endTime"`
+	Recurrence BlackoutWindowRecurrence `json:"recurrence"`
+}
+
 // Defines the input for CMD probe properties
 type CMDProbeRequest struct {
 	// Timeout of the Probe
@@ -112,6 +178,9 @@ type ChaosExperimentRequest struct {
 	InfraID string `json:"infraID"`
 	// Tags of the infra
 	Tags []string `json:"tags,omitempty"`
+	// Method used to calculate the resiliency score of the experiment runs,
+	// the current method is kept if not provided on update
+	ResiliencyScoreStrategy *ResiliencyScoreStrategy `json:"resiliencyScoreStrategy,omitempty"`
 }
 
 // Defines the response received for querying the details of chaos experiment
@@ -281,6 +350,42 @@ func (this ChaosHubStatus) GetCreatedAt() *string      { return &this.CreatedAt
 func (this ChaosHubStatus) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
 func (this ChaosHubStatus) GetCreatedBy() *UserDetails { return this.CreatedBy }
 
+// Defines the details of a chaos pipeline which runs a chain of experiments
+type ChaosPipeline struct {
+	ProjectID   string   `json:"projectID"`
+	PipelineID  string   `json:"pipelineID"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// Stages of the pipeline in the order they are run
+	Stages    []*PipelineStage `json:"stages"`
+	CreatedAt string           `json:"createdAt"`
+	UpdatedAt string           `json:"updatedAt"`
+	CreatedBy *UserDetails     `json:"createdBy,omitempty"`
+	UpdatedBy *UserDetails     `json:"updatedBy,omitempty"`
+	IsRemoved bool             `json:"isRemoved"`
+}
+
+func (ChaosPipeline) IsResourceDetails()           {}
+func (this ChaosPipeline) GetName() string         { return this.Name }
+func (this ChaosPipeline) GetDescription() *string { return this.Description }
+func (this ChaosPipeline) GetTags() []string {
+	if this.Tags == nil {
+		return nil
+	}
+	interfaceSlice := make([]string, 0, len(this.Tags))
+	for _, concrete := range this.Tags {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+func (ChaosPipeline) IsAudit()                        {}
+func (this ChaosPipeline) GetUpdatedAt() *string      { return &this.UpdatedAt }
+func (this ChaosPipeline) GetCreatedAt() *string      { return &this.CreatedAt }
+func (this ChaosPipeline) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
+func (this ChaosPipeline) GetCreatedBy() *UserDetails { return this.CreatedBy }
+
 type Chart struct {
 	APIVersion  string              `json:"apiVersion"`
 	Kind        string              `json:"kind"`
@@ -332,6 +437,24 @@ type ComparatorInput struct {
 	Criteria string `json:"criteria"`
 }
 
+// Defines the side-by-side comparison of two experiment runs
+type CompareExperimentRunsResponse struct {
+	// Summary of the first experiment run
+	RunA *ExperimentRunComparisonSummary `json:"runA"`
+	// Summary of the second experiment run
+	RunB *ExperimentRunComparisonSummary `json:"runB"`
+	// Difference of resiliency score (runB - runA)
+	ResiliencyScoreDifference *float64 `json:"resiliencyScoreDifference,omitempty"`
+	// Difference of duration in seconds (runB - runA)
+	DurationDifference *int `json:"durationDifference,omitempty"`
+	// Per fault differences between the runs
+	Faults []*FaultComparison `json:"faults"`
+	// Bool value indicating if the runs used different revisions of the experiment
+	IsRevisionChanged bool `json:"isRevisionChanged"`
+	// Differences between the manifests of the revisions, empty if both runs used the same revision
+	ManifestDiff []*ManifestDiff `json:"manifestDiff"`
+}
+
 type ConfirmInfraRegistrationResponse struct {
 	IsInfraConfirmed bool    `json:"isInfraConfirmed"`
 	NewAccessKey     *string `json:"newAccessKey,omitempty"`
@@ -368,12 +491,22 @@ type CreateChaosHubRequest struct {
 	SSHPublicKey *string `json:"sshPublicKey,omitempty"`
 }
 
+type CreateChaosPipelineRequest struct {
+	Name        string                `json:"name"`
+	Description *string               `json:"description,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Stages      []*PipelineStageInput `json:"stages"`
+}
+
 type CreateEnvironmentRequest struct {
-	EnvironmentID string          `json:"environmentID"`
-	Name          string          `json:"name"`
-	Type          EnvironmentType `json:"type"`
-	Description   *string         `json:"description,omitempty"`
-	Tags          []string        `json:"tags,omitempty"`
+	EnvironmentID   string                 `json:"environmentID"`
+	Name            string                 `json:"name"`
+	Type            EnvironmentType        `json:"type"`
+	Description     *string                `json:"description,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+	BlackoutWindows []*BlackoutWindowInput `json:"blackoutWindows,omitempty"`
+	// Requires an approval of the project owners for the experiment runs, only allowed for PROD environments
+	RequireRunApproval *bool `json:"requireRunApproval,omitempty"`
 }
 
 type CreateRemoteChaosHub struct {
@@ -389,6 +522,26 @@ type CreateRemoteChaosHub struct {
 	RemoteHub string `json:"remoteHub"`
 }
 
+type CreateWebhookRequest struct {
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	URL         string   `json:"url"`
+	// Secret used to sign the payloads with HMAC-SHA256, the signature is sent in the X-Litmus-Signature header
+	Secret    string              `json:"secret"`
+	Filter    *WebhookFilterInput `json:"filter,omitempty"`
+	IsEnabled *bool               `json:"isEnabled,omitempty"`
+}
+
+// Defines the details for fetching a page with cursor pagination, the items are sorted by their last
+// update time and the cursor is returned with the previous page
+type CursorPagination struct {
+	// Number of items to be fetched, defaults to 15 and can't be more than 100
+	Limit *int `json:"limit,omitempty"`
+	// Cursor of the page to be fetched i.e. the nextCursor of the previous page, the first page is fetched if not provided
+	After *string `json:"after,omitempty"`
+}
+
 // Defines the start date and end date for the filtering the data
 type DateRange struct {
 	// Start date
@@ -398,18 +551,24 @@ type DateRange struct {
 }
 
 type Environment struct {
-	ProjectID     string          `json:"projectID"`
-	EnvironmentID string          `json:"environmentID"`
-	Name          string          `json:"name"`
-	Description   *string         `json:"description,omitempty"`
-	Tags          []string        `json:"tags,omitempty"`
-	Type          EnvironmentType `json:"type"`
-	CreatedAt     string          `json:"createdAt"`
-	CreatedBy     *UserDetails    `json:"createdBy,omitempty"`
-	UpdatedBy     *UserDetails    `json:"updatedBy,omitempty"`
-	UpdatedAt     string          `json:"updatedAt"`
-	IsRemoved     *bool           `json:"isRemoved,omitempty"`
-	InfraIDs      []string        `json:"infraIDs,omitempty"`
+	ProjectID       string            `json:"projectID"`
+	EnvironmentID   string            `json:"environmentID"`
+	Name            string            `json:"name"`
+	Description     *string           `json:"description,omitempty"`
+	Tags            []string          `json:"tags,omitempty"`
+	Type            EnvironmentType   `json:"type"`
+	CreatedAt       string            `json:"createdAt"`
+	CreatedBy       *UserDetails      `json:"createdBy,omitempty"`
+	UpdatedBy       *UserDetails      `json:"updatedBy,omitempty"`
+	UpdatedAt       string            `json:"updatedAt"`
+	IsRemoved       *bool             `json:"isRemoved,omitempty"`
+	InfraIDs        []string          `json:"infraIDs,omitempty"`
+	BlackoutWindows []*BlackoutWindow `json:"blackoutWindows,omitempty"`
+	// Blackout window which is currently active, if any
+	ActiveBlackoutWindow *BlackoutWindow `json:"activeBlackoutWindow,omitempty"`
+	// Bool value indicating if running or enabling the cron schedule of the experiments of the environment
+	// creates an approval request for the project owners, only PROD environments can require approvals
+	RequireRunApproval bool `json:"requireRunApproval"`
 }
 
 func (Environment) IsResourceDetails()           {}
@@ -444,6 +603,14 @@ type EnvironmentFilterInput struct {
 	Tags []string `json:"tags,omitempty"`
 }
 
+// Defines the environment of the project an environment of an experiment bundle is mapped to
+type EnvironmentMappingInput struct {
+	// ID of the environment in the exported bundle
+	SourceEnvironmentID string `json:"sourceEnvironmentID"`
+	// ID of the environment of the project
+	TargetEnvironmentID string `json:"targetEnvironmentID"`
+}
+
 // Defines sorting options for experiment
 type EnvironmentSortInput struct {
 	// Field in which sorting will be done
@@ -511,6 +678,10 @@ type Experiment struct {
 	RecentExperimentRunDetails []*RecentExperimentRun `json:"recentExperimentRunDetails,omitempty"`
 	// Details of the user who updated the experiment
 	UpdatedBy *UserDetails `json:"updatedBy,omitempty"`
+	// Input variables declared by the manifest of the experiment
+	Inputs []*ExperimentInput `json:"inputs,omitempty"`
+	// Method used to calculate the resiliency score of the experiment runs
+	ResiliencyScoreStrategy ResiliencyScoreStrategy `json:"resiliencyScoreStrategy"`
 }
 
 func (Experiment) IsResourceDetails()           {}
@@ -533,6 +704,18 @@ func (this Experiment) GetCreatedAt() *string      { return &this.CreatedAt }
 func (this Experiment) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
 func (this Experiment) GetCreatedBy() *UserDetails { return this.CreatedBy }
 
+// Defines a chaos hub which provides faults used by an experiment bundle
+type ExperimentBundleHubReference struct {
+	// Name of the chaos hub
+	HubName string `json:"hubName"`
+	// URL of the git repository of the chaos hub
+	RepoURL string `json:"repoURL"`
+	// Branch of the git repository of the chaos hub
+	RepoBranch string `json:"repoBranch"`
+	// Faults of the experiment provided by the chaos hub
+	Faults []string `json:"faults"`
+}
+
 type ExperimentDetails struct {
 	// Engine Manifest
 	EngineDetails string `json:"engineDetails"`
@@ -560,6 +743,36 @@ type ExperimentFilterInput struct {
 	InfraTypes []*InfrastructureType `json:"infraTypes,omitempty"`
 }
 
+// Defines an input variable declared by an experiment manifest
+type ExperimentInput struct {
+	// Name of the input, referenced in the manifest as {{experiment.inputs.<name>}}
+	Name string `json:"name"`
+	// Type of the input
+	Type ExperimentInputType `json:"type"`
+	// Default value of the input
+	Default *string `json:"default,omitempty"`
+	// Description of the input
+	Description *string `json:"description,omitempty"`
+	// Bool value indicating if a value must be provided when the input has no default
+	Required bool `json:"required"`
+}
+
+// Defines the value of an experiment input used by an experiment run
+type ExperimentInputValue struct {
+	// Name of the input
+	Name string `json:"name"`
+	// Value of the input
+	Value string `json:"value"`
+}
+
+// Defines the value of an experiment input for an experiment run
+type ExperimentInputValueRequest struct {
+	// Name of the input
+	Name string `json:"name"`
+	// Value of the input
+	Value string `json:"value"`
+}
+
 type ExperimentRequest struct {
 	// Name of the chart being used
 	Category string `json:"category"`
@@ -569,6 +782,22 @@ type ExperimentRequest struct {
 	HubID string `json:"hubID"`
 }
 
+// Defines the details of a revision of an experiment
+type ExperimentRevision struct {
+	// ID of the revision
+	RevisionID string `json:"revisionID"`
+	// Manifest of the experiment in the revision
+	ExperimentManifest string `json:"experimentManifest"`
+	// Array containing weightage and name of each chaos fault in the revision
+	Weightages []*Weightages `json:"weightages"`
+	// Timestamp at which the revision was created
+	UpdatedAt string `json:"updatedAt"`
+	// Details of the user who created the revision
+	UpdatedBy *UserDetails `json:"updatedBy,omitempty"`
+	// Bool value indicating if the revision is the current revision of the experiment
+	IsCurrent bool `json:"isCurrent"`
+}
+
 // Defines the details of a experiment run
 type ExperimentRun struct {
 	ProjectID string `json:"projectID"`
@@ -606,7 +835,8 @@ type ExperimentRun struct {
 	FaultsNa *int `json:"faultsNa,omitempty"`
 	// Total number of faults
 	TotalFaults *int `json:"totalFaults,omitempty"`
-	// Stores all the experiment run details related to the nodes of DAG graph and chaos results of the faults
+	// Stores all the experiment run details related to the nodes of DAG graph and chaos results of the faults,
+	// it is loaded separately from the other fields of the run hence it should only be requested when needed
 	ExecutionData string `json:"executionData"`
 	// Bool value indicating if the experiment run has removed
 	IsRemoved *bool `json:"isRemoved,omitempty"`
@@ -618,6 +848,17 @@ type ExperimentRun struct {
 	NotifyID *string `json:"notifyID,omitempty"`
 	// runSequence is the sequence number of experiment run
 	RunSequence int `json:"runSequence"`
+	// Values of the experiment inputs used by the experiment run
+	Inputs []*ExperimentInputValue `json:"inputs,omitempty"`
+	// Priority of the experiment run, used to order the queued runs of infras with the PRIORITY queue policy
+	Priority *int `json:"priority,omitempty"`
+	// Method used to calculate the resiliency score of the experiment run
+	ResiliencyScoreStrategy *ResiliencyScoreStrategy `json:"resiliencyScoreStrategy,omitempty"`
+	// Timestamp at which the execution data of the experiment run was removed by the retention policy,
+	// the summary of the run is kept for the trends
+	PrunedAt *string `json:"prunedAt,omitempty"`
+	// Location of the archive of the experiment run, if it was archived before its execution data was removed
+	ArchiveLocation *string `json:"archiveLocation,omitempty"`
 }
 
 func (ExperimentRun) IsAudit()                        {}
@@ -626,6 +867,30 @@ func (this ExperimentRun) GetCreatedAt() *string      { return &this.CreatedAt }
 func (this ExperimentRun) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
 func (this ExperimentRun) GetCreatedBy() *UserDetails { return this.CreatedBy }
 
+// Defines the summary of an experiment run used in a comparison
+type ExperimentRunComparisonSummary struct {
+	// ID of the experiment run
+	ExperimentRunID string `json:"experimentRunID"`
+	// ID of the experiment
+	ExperimentID string `json:"experimentID"`
+	// ID of the revision used by the experiment run
+	RevisionID string `json:"revisionID"`
+	// Phase of the experiment run
+	Phase string `json:"phase"`
+	// Resiliency score of the experiment run
+	ResiliencyScore *float64 `json:"resiliencyScore,omitempty"`
+	// Number of faults passed
+	FaultsPassed *int `json:"faultsPassed,omitempty"`
+	// Number of faults failed
+	FaultsFailed *int `json:"faultsFailed,omitempty"`
+	// Duration of the experiment run in seconds
+	Duration *int `json:"duration,omitempty"`
+	// runSequence is the sequence number of experiment run
+	RunSequence int `json:"runSequence"`
+	// Timestamp at which experiment run was created
+	CreatedAt string `json:"createdAt"`
+}
+
 // Defines input type for experiment run filter
 type ExperimentRunFilterInput struct {
 	// Name of the experiment
@@ -686,12 +951,81 @@ type ExperimentSortInput struct {
 	Ascending *bool `json:"ascending,omitempty"`
 }
 
+// Defines an issue found while validating an experiment
+type ExperimentValidationIssue struct {
+	// YAML path in the experiment manifest at which the issue was found, issues
+	// with other fields of the request are reported with the name of the field
+	Path string `json:"path"`
+	// Description of the issue
+	Message string `json:"message"`
+}
+
 type Experiments struct {
 	Name string `json:"name"`
 	CSV  string `json:"CSV"`
 	Desc string `json:"desc"`
 }
 
+// Defines the aggregated results of a group of fault results
+type FaultAnalytics struct {
+	// Name of the fault, set if the results are grouped by fault
+	FaultName *string `json:"faultName,omitempty"`
+	// Name of the target, set if the results are grouped by target
+	Target *string `json:"target,omitempty"`
+	// ID and name of the experiment, set if the results are grouped by experiment
+	ExperimentID   *string `json:"experimentID,omitempty"`
+	ExperimentName *string `json:"experimentName,omitempty"`
+	TotalRuns      int     `json:"totalRuns"`
+	Passed         int     `json:"passed"`
+	Failed         int     `json:"failed"`
+	Stopped        int     `json:"stopped"`
+	// Share of the runs with a failed verdict, between 0 and 1
+	FailureRate float64 `json:"failureRate"`
+	// Average score of the probes in percentage, null if the probes were not evaluated
+	AverageProbeSuccessPercentage *float64 `json:"averageProbeSuccessPercentage,omitempty"`
+	// Completion time of the latest failed run in unix milliseconds
+	LastFailedAt *string `json:"lastFailedAt,omitempty"`
+	// Reason of the failure of the latest failed run
+	LastFailureReason *string `json:"lastFailureReason,omitempty"`
+}
+
+type FaultAnalyticsRequest struct {
+	GroupBy FaultAnalyticsGroupBy   `json:"groupBy"`
+	Filter  *FaultResultFilterInput `json:"filter,omitempty"`
+	// Maximum number of groups returned, defaults to 20
+	Limit *int `json:"limit,omitempty"`
+}
+
+type FaultAnalyticsResponse struct {
+	GroupBy FaultAnalyticsGroupBy `json:"groupBy"`
+	// Groups of the results, the group with the most failures first
+	Analytics []*FaultAnalytics `json:"analytics"`
+}
+
+// Defines the difference in result of a fault between two experiment runs
+type FaultComparison struct {
+	// Name of the workflow step running the fault
+	StepName string `json:"stepName"`
+	// Name of the fault
+	FaultName string `json:"faultName"`
+	// Verdict of the fault in the first run
+	VerdictA *string `json:"verdictA,omitempty"`
+	// Verdict of the fault in the second run
+	VerdictB *string `json:"verdictB,omitempty"`
+	// Probe success percentage of the fault in the first run
+	ProbeSuccessPercentageA *string `json:"probeSuccessPercentageA,omitempty"`
+	// Probe success percentage of the fault in the second run
+	ProbeSuccessPercentageB *string `json:"probeSuccessPercentageB,omitempty"`
+	// Duration of the fault in the first run in seconds
+	DurationA *int `json:"durationA,omitempty"`
+	// Duration of the fault in the second run in seconds
+	DurationB *int `json:"durationB,omitempty"`
+	// Bool value indicating if the verdict or any probe status has changed between the runs
+	IsChanged bool `json:"isChanged"`
+	// Probe status differences of the fault
+	Probes []*ProbeComparison `json:"probes"`
+}
+
 // Fault Detail consists of all the fault related details
 type FaultDetails struct {
 	// fault consists of fault.yaml
@@ -709,6 +1043,64 @@ type FaultList struct {
 	Plan        []string `json:"plan,omitempty"`
 }
 
+// Defines the result of a probe of a fault
+type FaultProbeResult struct {
+	Name        string       `json:"name"`
+	Type        *string      `json:"type,omitempty"`
+	Mode        *string      `json:"mode,omitempty"`
+	Verdict     ProbeVerdict `json:"verdict"`
+	Description *string      `json:"description,omitempty"`
+}
+
+// Defines the result of a fault in a completed experiment run, it is extracted from the execution data of the run
+type FaultResult struct {
+	ProjectID       string `json:"projectID"`
+	ExperimentID    string `json:"experimentID"`
+	ExperimentName  string `json:"experimentName"`
+	ExperimentRunID string `json:"experimentRunID"`
+	InfraID         string `json:"infraID"`
+	// Name of the fault, e.g. pod-network-latency
+	FaultName string `json:"faultName"`
+	// Name of the step of the experiment which ran the fault
+	StepName string `json:"stepName"`
+	// Namespace of the chaos engine of the fault
+	Namespace *string `json:"namespace,omitempty"`
+	// Resources targeted by the fault
+	Targets []*FaultTarget `json:"targets"`
+	Verdict FaultVerdict   `json:"verdict"`
+	// Score of the probes of the fault in percentage
+	ProbeSuccessPercentage *float64            `json:"probeSuccessPercentage,omitempty"`
+	Probes                 []*FaultProbeResult `json:"probes"`
+	// Timestamps of the execution of the fault in unix seconds
+	StartedAt  *string `json:"startedAt,omitempty"`
+	FinishedAt *string `json:"finishedAt,omitempty"`
+	// Reason of the failure of the fault, empty if the fault didn't fail
+	FailureReason *string `json:"failureReason,omitempty"`
+	// Timestamp of the completion of the experiment run in unix milliseconds
+	CreatedAt string `json:"createdAt"`
+}
+
+// Defines the filters on the fault results, all the results are matched if empty
+type FaultResultFilterInput struct {
+	ExperimentIDs []string `json:"experimentIDs,omitempty"`
+	InfraIDs      []string `json:"infraIDs,omitempty"`
+	// Names of the faults, e.g. pod-network-latency
+	FaultNames []string `json:"faultNames,omitempty"`
+	// Names of the targets, a result is matched if the fault targeted any of them
+	Targets  []string       `json:"targets,omitempty"`
+	Verdicts []FaultVerdict `json:"verdicts,omitempty"`
+	// Completion time range of the experiment runs in unix milliseconds
+	DateRange *DateRange `json:"dateRange,omitempty"`
+}
+
+// Defines the resource targeted by a fault
+type FaultTarget struct {
+	// Name of the target, e.g. the name of the deployment or of the node
+	Name string `json:"name"`
+	// Kind of the target, e.g. deployment or node
+	Kind *string `json:"kind,omitempty"`
+}
+
 // Details of GET request
 type Get struct {
 	// Criteria of the request
@@ -933,6 +1325,47 @@ func (this ImageRegistryResponse) GetCreatedAt() *string      { return this.Crea
 func (this ImageRegistryResponse) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
 func (this ImageRegistryResponse) GetCreatedBy() *UserDetails { return this.CreatedBy }
 
+// Defines the details for importing an experiment bundle
+type ImportExperimentBundleRequest struct {
+	// Base64 encoded experiment bundle archive generated by exportExperimentBundle
+	Bundle string `json:"bundle"`
+	// ID of the target infra in which the experiment will run
+	InfraID string `json:"infraID"`
+	// ID of the environment of the target infra, used to validate the infra selection.
+	// It takes precedence over the environment mapped by environmentMappings
+	EnvironmentID *string `json:"environmentID,omitempty"`
+	// Mappings of the environments of the exported installation to the environments of the
+	// project, the environment of the bundle's experiment is mapped to the environment the
+	// target infra has to belong to
+	EnvironmentMappings []*EnvironmentMappingInput `json:"environmentMappings,omitempty"`
+	// Name of the imported experiment, defaults to the name in the bundle
+	ExperimentName *string `json:"experimentName,omitempty"`
+	// Resolution of probe name conflicts, defaults to RENAME
+	ProbeConflictResolution *ProbeConflictResolution `json:"probeConflictResolution,omitempty"`
+}
+
+// Defines the response of importing an experiment bundle
+type ImportExperimentBundleResponse struct {
+	// Details of the imported experiment
+	Experiment *ChaosExperimentResponse `json:"experiment"`
+	// ID of the environment of the project the experiment is imported in
+	EnvironmentID string `json:"environmentID"`
+	// Probes created or reused for the experiment
+	Probes []*ImportedProbe `json:"probes"`
+	// Chaos hubs referenced by the bundle which are not connected to the project
+	MissingHubReferences []*ExperimentBundleHubReference `json:"missingHubReferences"`
+}
+
+// Defines the details of a probe created or reused while importing an experiment bundle
+type ImportedProbe struct {
+	// Name of the probe in the bundle
+	SourceName string `json:"sourceName"`
+	// Name of the probe in the project
+	Name string `json:"name"`
+	// Bool value indicating if an existing probe was reused
+	IsReused bool `json:"isReused"`
+}
+
 // Defines the details for a infra
 type Infra struct {
 	ProjectID string `json:"projectID"`
@@ -988,6 +1421,10 @@ type Infra struct {
 	InfraType *InfrastructureType `json:"infraType,omitempty"`
 	// update status of infra
 	UpdateStatus UpdateStatus `json:"updateStatus"`
+	// Maximum number of experiment runs which can run concurrently in the infra, no limit is applied if not set
+	MaxConcurrentRuns *int `json:"maxConcurrentRuns,omitempty"`
+	// Order in which the queued experiment runs of the infra are started
+	RunQueuePolicy *RunQueuePolicy `json:"runQueuePolicy,omitempty"`
 }
 
 func (Infra) IsResourceDetails()           {}
@@ -1047,6 +1484,14 @@ type InfraIdentity struct {
 	Version   string `json:"version"`
 }
 
+// Defines the concurrency limit of an infra
+type InfraRunLimitRequest struct {
+	// Maximum number of experiment runs which can run concurrently in the infra, 0 removes the limit
+	MaxConcurrentRuns int `json:"maxConcurrentRuns"`
+	// Order in which the queued experiment runs of the infra are started, defaults to FIFO
+	RunQueuePolicy *RunQueuePolicy `json:"runQueuePolicy,omitempty"`
+}
+
 // InfraVersionDetails returns the details of compatible infra versions and the latest infra version supported
 type InfraVersionDetails struct {
 	// Latest infra version supported
@@ -1388,6 +1833,19 @@ type Link struct {
 	URL  string `json:"url"`
 }
 
+type ListAuditEventsRequest struct {
+	Filter *AuditEventFilterInput `json:"filter,omitempty"`
+	// Defaults to the first page of 15 events
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+type ListAuditEventsResponse struct {
+	// Total number of events matching the filter
+	TotalNumberOfEvents int `json:"totalNumberOfEvents"`
+	// Events of the page, the latest event first
+	Events []*AuditEvent `json:"events"`
+}
+
 type ListChaosHubRequest struct {
 	// Array of ChaosHub IDs for which details will be fetched
 	ChaosHubIDs []string `json:"chaosHubIDs,omitempty"`
@@ -1418,6 +1876,9 @@ type ListExperimentRequest struct {
 	ExperimentIDs []*string `json:"experimentIDs,omitempty"`
 	// Details for fetching paginated data
 	Pagination *Pagination `json:"pagination,omitempty"`
+	// Details for fetching paginated data with cursors, it can't be used together with pagination.
+	// The experiments are sorted by their last update time, only the order of the TIME sort can be changed
+	Cursor *CursorPagination `json:"cursor,omitempty"`
 	// Details for fetching sorted data
 	Sort *ExperimentSortInput `json:"sort,omitempty"`
 	// Details for fetching filtered data
@@ -1430,6 +1891,8 @@ type ListExperimentResponse struct {
 	TotalNoOfExperiments int `json:"totalNoOfExperiments"`
 	// Details related to the experiments
 	Experiments []*Experiment `json:"experiments"`
+	// Cursor of the next page, only set with cursor pagination when there are more experiments
+	NextCursor *string `json:"nextCursor,omitempty"`
 }
 
 // Defines the details for experiment runs
@@ -1440,6 +1903,9 @@ type ListExperimentRunRequest struct {
 	ExperimentIDs []*string `json:"experimentIDs,omitempty"`
 	// Details for fetching paginated data
 	Pagination *Pagination `json:"pagination,omitempty"`
+	// Details for fetching paginated data with cursors, it can't be used together with pagination.
+	// The runs are sorted by their last update time, only the order of the TIME sort can be changed
+	Cursor *CursorPagination `json:"cursor,omitempty"`
 	// Details for fetching sorted data
 	Sort *ExperimentRunSortInput `json:"sort,omitempty"`
 	// Details for fetching filtered data
@@ -1452,6 +1918,21 @@ type ListExperimentRunResponse struct {
 	TotalNoOfExperimentRuns int `json:"totalNoOfExperimentRuns"`
 	// Defines details of experiment runs
 	ExperimentRuns []*ExperimentRun `json:"experimentRuns"`
+	// Cursor of the next page, only set with cursor pagination when there are more experiment runs
+	NextCursor *string `json:"nextCursor,omitempty"`
+}
+
+type ListFaultResultsRequest struct {
+	Filter *FaultResultFilterInput `json:"filter,omitempty"`
+	// Defaults to the first page of 15 results
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+type ListFaultResultsResponse struct {
+	// Total number of fault results matching the filter
+	TotalNoOfFaultResults int `json:"totalNoOfFaultResults"`
+	// Fault results of the page, the latest result first
+	FaultResults []*FaultResult `json:"faultResults"`
 }
 
 // Defines the details for a infra
@@ -1474,6 +1955,17 @@ type ListInfraResponse struct {
 	Infras []*Infra `json:"infras"`
 }
 
+type ListRunApprovalsRequest struct {
+	Filter *RunApprovalFilterInput `json:"filter,omitempty"`
+	// Details for fetching paginated data
+	Pagination *Pagination `json:"pagination,omitempty"`
+}
+
+type ListRunApprovalsResponse struct {
+	TotalNoOfRequests int                   `json:"totalNoOfRequests"`
+	Requests          []*RunApprovalRequest `json:"requests"`
+}
+
 // Defines the details of the maintainer
 type Maintainer struct {
 	// Name of the maintainer
@@ -1482,6 +1974,18 @@ type Maintainer struct {
 	Email string `json:"email"`
 }
 
+// Defines a single change between two manifests
+type ManifestDiff struct {
+	// Path of the changed field in the manifest, embedded manifests are expanded
+	Path string `json:"path"`
+	// Type of the change
+	ChangeType DiffChangeType `json:"changeType"`
+	// Value of the field in the old manifest
+	OldValue *string `json:"oldValue,omitempty"`
+	// Value of the field in the new manifest
+	NewValue *string `json:"newValue,omitempty"`
+}
+
 type Metadata struct {
 	Name        string      `json:"name"`
 	Version     string      `json:"version"`
@@ -1644,6 +2148,71 @@ type Pagination struct {
 	Limit int `json:"limit"`
 }
 
+// Defines a condition on the result of the experiment run of the previous stage of a pipeline
+type PipelineCondition struct {
+	Field    PipelineConditionField    `json:"field"`
+	Operator PipelineConditionOperator `json:"operator"`
+	Value    float64                   `json:"value"`
+}
+
+type PipelineConditionInput struct {
+	Field    PipelineConditionField    `json:"field"`
+	Operator PipelineConditionOperator `json:"operator"`
+	Value    float64                   `json:"value"`
+}
+
+// Defines an execution of a pipeline
+type PipelineExecution struct {
+	ProjectID           string                  `json:"projectID"`
+	PipelineExecutionID string                  `json:"pipelineExecutionID"`
+	PipelineID          string                  `json:"pipelineID"`
+	PipelineName        string                  `json:"pipelineName"`
+	Status              PipelineExecutionStatus `json:"status"`
+	// Index of the stage which is running or was run last
+	CurrentStage int                       `json:"currentStage"`
+	Stages       []*PipelineStageExecution `json:"stages"`
+	StartedAt    string                    `json:"startedAt"`
+	CompletedAt  *string                   `json:"completedAt,omitempty"`
+	StartedBy    *UserDetails              `json:"startedBy,omitempty"`
+}
+
+// Defines a stage of a pipeline which runs an experiment
+type PipelineStage struct {
+	Name string `json:"name"`
+	// ID of the experiment run by the stage
+	ExperimentID string `json:"experimentID"`
+	// Conditions on the experiment run of the previous stage which must all be met to run the stage,
+	// they are ignored for the first stage
+	Conditions []*PipelineCondition `json:"conditions,omitempty"`
+}
+
+// Defines the execution of a stage of a pipeline
+type PipelineStageExecution struct {
+	Name         string              `json:"name"`
+	ExperimentID string              `json:"experimentID"`
+	Status       PipelineStageStatus `json:"status"`
+	// Notify ID of the experiment run of the stage
+	NotifyID        *string `json:"notifyID,omitempty"`
+	ExperimentRunID *string `json:"experimentRunID,omitempty"`
+	// Phase of the experiment run of the stage
+	Phase           *string  `json:"phase,omitempty"`
+	ResiliencyScore *float64 `json:"resiliencyScore,omitempty"`
+	FaultsPassed    *int     `json:"faultsPassed,omitempty"`
+	FaultsFailed    *int     `json:"faultsFailed,omitempty"`
+	// Reason why the stage is skipped or failed
+	Message     *string `json:"message,omitempty"`
+	StartedAt   *string `json:"startedAt,omitempty"`
+	CompletedAt *string `json:"completedAt,omitempty"`
+}
+
+type PipelineStageInput struct {
+	Name string `json:"name"`
+	// ID of the experiment run by the stage, cron experiments are not allowed
+	ExperimentID string `json:"experimentID"`
+	// Conditions on the experiment run of the previous stage which must all be met to run the stage
+	Conditions []*PipelineConditionInput `json:"conditions,omitempty"`
+}
+
 // Response received for querying pod logs
 type PodLog struct {
 	// ID of the cluster
@@ -1757,6 +2326,20 @@ func (this Probe) GetCreatedAt() *string      { return &this.CreatedAt }
 func (this Probe) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
 func (this Probe) GetCreatedBy() *UserDetails { return this.CreatedBy }
 
+// Defines the difference in status of a probe between two experiment runs
+type ProbeComparison struct {
+	// Name of the probe
+	ProbeName string `json:"probeName"`
+	// Mode of the probe
+	Mode *string `json:"mode,omitempty"`
+	// Verdict of the probe in the first run
+	VerdictA *string `json:"verdictA,omitempty"`
+	// Verdict of the probe in the second run
+	VerdictB *string `json:"verdictB,omitempty"`
+	// Bool value indicating if the verdict has changed between the runs
+	IsChanged bool `json:"isChanged"`
+}
+
 // Defines the input for Probe filter
 type ProbeFilterInput struct {
 	// Name of the Probe
@@ -1841,6 +2424,14 @@ func (this RecentExperimentRun) GetCreatedAt() *string      { return &this.Creat
 func (this RecentExperimentRun) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
 func (this RecentExperimentRun) GetCreatedBy() *UserDetails { return this.CreatedBy }
 
+// Defines the experiment runs known by an infra, sent by the infra once it is connected
+type ReconcileExperimentRunsRequest struct {
+	// Identity of the infra
+	InfraID *InfraIdentity `json:"infraID"`
+	// IDs of the experiment runs whose workflow exists on the infra
+	ExperimentRunIDs []string `json:"experimentRunIDs"`
+}
+
 // Defines the details for the new infra being connected
 type RegisterInfraRequest struct {
 	// Name of the infra
@@ -1892,8 +2483,118 @@ type ResilienceScoreCategory struct {
 	Count int `json:"count"`
 }
 
+// Defines the aggregated details of the experiment runs completed in a time bucket
+type ResiliencyTrendBucket struct {
+	// Start time of the bucket in unix milliseconds
+	StartTime string `json:"startTime"`
+	// Average resiliency score of the experiment runs, null if the bucket has no runs with a score
+	AverageResiliencyScore *float64 `json:"averageResiliencyScore,omitempty"`
+	// Total number of experiment runs
+	TotalRuns int `json:"totalRuns"`
+	// Number of experiment runs completed without any error
+	PassedRuns int `json:"passedRuns"`
+	// Number of experiment runs which completed with errors, errored out, timed out or were terminated
+	FailedRuns int `json:"failedRuns"`
+	// Total number of passed faults
+	FaultsPassed int `json:"faultsPassed"`
+	// Total number of failed faults
+	FaultsFailed int `json:"faultsFailed"`
+}
+
+// Defines the filters used to slice the resiliency trends
+type ResiliencyTrendFilterInput struct {
+	// IDs of the experiments whose runs are included
+	ExperimentIDs []string `json:"experimentIDs,omitempty"`
+	// IDs of the environments whose infras' runs are included
+	EnvironmentIDs []string `json:"environmentIDs,omitempty"`
+	// IDs of the infras whose runs are included
+	InfraIDs []string `json:"infraIDs,omitempty"`
+	// Tags of the experiments whose runs are included, a run is included if its experiment has any of the tags
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Defines the request to get the resiliency trends of a project
+type ResiliencyTrendRequest struct {
+	// Size of the time buckets
+	Granularity TrendGranularity `json:"granularity"`
+	// Time range of the trends in unix milliseconds, end date defaults to the current time
+	DateRange *DateRange `json:"dateRange"`
+	// Filters used to slice the trends
+	Filter *ResiliencyTrendFilterInput `json:"filter,omitempty"`
+}
+
+// Defines the resiliency trends of a project
+type ResiliencyTrendResponse struct {
+	// Size of the time buckets
+	Granularity TrendGranularity `json:"granularity"`
+	// Time buckets in ascending order, buckets without any runs are included with zero counts
+	Buckets []*ResiliencyTrendBucket `json:"buckets"`
+}
+
+// Defines the decision of a project owner on an approval request
+type RunApprovalDecision struct {
+	Status    RunApprovalStatus `json:"status"`
+	DecidedBy *UserDetails      `json:"decidedBy"`
+	Comment   *string           `json:"comment,omitempty"`
+	DecidedAt string            `json:"decidedAt"`
+}
+
+// Defines filter options for the approval requests
+type RunApprovalFilterInput struct {
+	Status       *RunApprovalStatus `json:"status,omitempty"`
+	ExperimentID *string            `json:"experimentID,omitempty"`
+}
+
+// Defines an approval request created on running, or enabling the cron schedule of, an experiment
+// of a PROD environment which requires run approvals
+type RunApprovalRequest struct {
+	ApprovalID     string            `json:"approvalID"`
+	ProjectID      string            `json:"projectID"`
+	ExperimentID   string            `json:"experimentID"`
+	ExperimentName string            `json:"experimentName"`
+	InfraID        string            `json:"infraID"`
+	EnvironmentID  string            `json:"environmentID"`
+	Action         RunApprovalAction `json:"action"`
+	Status         RunApprovalStatus `json:"status"`
+	// Inputs of the experiment run requested, they are used when the run is dispatched
+	Inputs      []*ExperimentInputValue `json:"inputs,omitempty"`
+	Priority    *int                    `json:"priority,omitempty"`
+	RequestedBy *UserDetails            `json:"requestedBy"`
+	CreatedAt   string                  `json:"createdAt"`
+	Decision    *RunApprovalDecision    `json:"decision,omitempty"`
+	// Notify ID of the experiment run dispatched on approval
+	NotifyID *string `json:"notifyID,omitempty"`
+	// Error of the dispatch of the approved action, if it failed
+	DispatchError *string `json:"dispatchError,omitempty"`
+}
+
 type RunChaosExperimentResponse struct {
+	// Notify ID of the experiment run, it is empty if the run is waiting for an approval
 	NotifyID string `json:"notifyID"`
+	// ID of the approval request created if the environment of the experiment requires run approvals
+	ApprovalID *string `json:"approvalID,omitempty"`
+}
+
+// Defines the retention policy of the experiment runs of a project, the execution data of the completed runs
+// beyond any of the limits is removed while the summary of the runs (resiliency score, phase and fault counts)
+// is kept so that the trends are not affected
+type RunRetentionPolicy struct {
+	ProjectID string `json:"projectID"`
+	// Maximum age in days of the runs, the age is not limited if empty
+	MaxAgeDays *int `json:"maxAgeDays,omitempty"`
+	// Maximum number of runs kept per experiment, the latest runs are kept, the number is not limited if empty
+	MaxRunsPerExperiment *int             `json:"maxRunsPerExperiment,omitempty"`
+	Mode                 RunRetentionMode `json:"mode"`
+	// Bool value indicating if the project uses the default policy of the server
+	IsServerDefault bool         `json:"isServerDefault"`
+	UpdatedAt       *string      `json:"updatedAt,omitempty"`
+	UpdatedBy       *UserDetails `json:"updatedBy,omitempty"`
+}
+
+type RunRetentionPolicyRequest struct {
+	MaxAgeDays           *int             `json:"maxAgeDays,omitempty"`
+	MaxRunsPerExperiment *int             `json:"maxRunsPerExperiment,omitempty"`
+	Mode                 RunRetentionMode `json:"mode"`
 }
 
 // Defines the SSHKey details
@@ -1920,6 +2621,8 @@ type SaveChaosExperimentRequest struct {
 	InfraID string `json:"infraID"`
 	// Tags of the infrastructure
 	Tags []string `json:"tags,omitempty"`
+	// Method used to calculate the resiliency score of the experiment runs
+	ResiliencyScoreStrategy *ResiliencyScoreStrategy `json:"resiliencyScoreStrategy,omitempty"`
 }
 
 // Response received for fetching GQL server version
@@ -2005,12 +2708,37 @@ type UpdateChaosHubRequest struct {
 	SSHPublicKey *string `json:"sshPublicKey,omitempty"`
 }
 
+type UpdateChaosPipelineRequest struct {
+	PipelineID  string   `json:"pipelineID"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// Replaces the stages of the pipeline, running executions keep their stages
+	Stages []*PipelineStageInput `json:"stages"`
+}
+
 type UpdateEnvironmentRequest struct {
 	EnvironmentID string           `json:"environmentID"`
 	Name          *string          `json:"name,omitempty"`
 	Description   *string          `json:"description,omitempty"`
 	Tags          []*string        `json:"tags,omitempty"`
 	Type          *EnvironmentType `json:"type,omitempty"`
+	// Replaces the blackout windows of the environment
+	BlackoutWindows []*BlackoutWindowInput `json:"blackoutWindows,omitempty"`
+	// Requires an approval of the project owners for the experiment runs, only allowed for PROD environments
+	RequireRunApproval *bool `json:"requireRunApproval,omitempty"`
+}
+
+type UpdateWebhookRequest struct {
+	WebhookID   string   `json:"webhookID"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	URL         string   `json:"url"`
+	// New secret of the webhook, the current secret is kept if not provided
+	Secret    *string             `json:"secret,omitempty"`
+	Filter    *WebhookFilterInput `json:"filter,omitempty"`
+	IsEnabled bool                `json:"isEnabled"`
 }
 
 type UserDetails struct {
@@ -2019,6 +2747,93 @@ type UserDetails struct {
 	Email    string `json:"email"`
 }
 
+// Defines the result of validating an experiment without saving it
+type ValidateChaosExperimentResponse struct {
+	// Bool value indicating if the experiment can be saved and run
+	IsValid bool `json:"isValid"`
+	// Issues which prevent the experiment from being saved or run
+	Errors []*ExperimentValidationIssue `json:"errors"`
+	// Issues which might make the experiment fail at run time
+	Warnings []*ExperimentValidationIssue `json:"warnings"`
+}
+
+// Defines the details of a webhook which receives the lifecycle events of the experiment runs of a project
+type Webhook struct {
+	ProjectID   string   `json:"projectID"`
+	WebhookID   string   `json:"webhookID"`
+	Name        string   `json:"name"`
+	Description *string  `json:"description,omitempty"`
+	Tags        []string `json:"tags,omitempty"`
+	// Endpoint to which the events are posted
+	URL    string         `json:"url"`
+	Filter *WebhookFilter `json:"filter,omitempty"`
+	// Bool value indicating if the events are posted to the webhook
+	IsEnabled bool         `json:"isEnabled"`
+	CreatedAt string       `json:"createdAt"`
+	UpdatedAt string       `json:"updatedAt"`
+	CreatedBy *UserDetails `json:"createdBy,omitempty"`
+	UpdatedBy *UserDetails `json:"updatedBy,omitempty"`
+	IsRemoved bool         `json:"isRemoved"`
+}
+
+func (Webhook) IsResourceDetails()           {}
+func (this Webhook) GetName() string         { return this.Name }
+func (this Webhook) GetDescription() *string { return this.Description }
+func (this Webhook) GetTags() []string {
+	if this.Tags == nil {
+		return nil
+	}
+	interfaceSlice := make([]string, 0, len(this.Tags))
+	for _, concrete := range this.Tags {
+		interfaceSlice = append(interfaceSlice, concrete)
+	}
+	return interfaceSlice
+}
+
+func (Webhook) IsAudit()                        {}
+func (this Webhook) GetUpdatedAt() *string      { return &this.UpdatedAt }
+func (this Webhook) GetCreatedAt() *string      { return &this.CreatedAt }
+func (this Webhook) GetUpdatedBy() *UserDetails { return this.UpdatedBy }
+func (this Webhook) GetCreatedBy() *UserDetails { return this.CreatedBy }
+
+// Defines a delivery of an event to a webhook
+type WebhookDelivery struct {
+	DeliveryID      string                `json:"deliveryID"`
+	WebhookID       string                `json:"webhookID"`
+	Event           WebhookEventType      `json:"event"`
+	ExperimentID    string                `json:"experimentID"`
+	ExperimentRunID *string               `json:"experimentRunID,omitempty"`
+	NotifyID        *string               `json:"notifyID,omitempty"`
+	Status          WebhookDeliveryStatus `json:"status"`
+	// Number of attempts made to deliver the event
+	Attempts int `json:"attempts"`
+	// Status code of the response to the last attempt
+	ResponseCode *int `json:"responseCode,omitempty"`
+	// Error of the last failed attempt
+	Error *string `json:"error,omitempty"`
+	// JSON payload posted to the webhook
+	Payload   string `json:"payload"`
+	CreatedAt string `json:"createdAt"`
+	UpdatedAt string `json:"updatedAt"`
+}
+
+// Defines the filters on the experiment runs whose events are posted to a webhook,
+// an empty filter matches all the experiment runs
+type WebhookFilter struct {
+	// Events which are posted, all the events are posted if empty
+	Events []WebhookEventType `json:"events,omitempty"`
+	// IDs of the environments of the infras whose runs are posted
+	EnvironmentIDs []string `json:"environmentIDs,omitempty"`
+	// Tags of the experiments whose runs are posted, a run is posted if its experiment has any of the tags
+	Tags []string `json:"tags,omitempty"`
+}
+
+type WebhookFilterInput struct {
+	Events         []WebhookEventType `json:"events,omitempty"`
+	EnvironmentIDs []string           `json:"environmentIDs,omitempty"`
+	Tags           []string           `json:"tags,omitempty"`
+}
+
 // Defines the details of the weightages of each chaos fault in the experiment
 type Weightages struct {
 	// Name of the fault
@@ -2041,6 +2856,50 @@ type Workload struct {
 	Namespace string `json:"namespace"`
 }
 
+// Service through which the audited request was made
+type AuditEventSource string
+
+const (
+	// GraphQL mutation of the server
+	AuditEventSourceGraphql AuditEventSource = "GRAPHQL"
+	// REST endpoint of the authentication server
+	AuditEventSourceAuth AuditEventSource = "AUTH"
+)
+
+var AllAuditEventSource = []AuditEventSource{
+	AuditEventSourceGraphql,
+	AuditEventSourceAuth,
+}
+
+func (e AuditEventSource) IsValid() bool {
+	switch e {
+	case AuditEventSourceGraphql, AuditEventSourceAuth:
+		return true
+	}
+	return false
+}
+
+func (e AuditEventSource) String() string {
+	return string(e)
+}
+
+func (e *AuditEventSource) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = AuditEventSource(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid AuditEventSource", str)
+	}
+	return nil
+}
+
+func (e AuditEventSource) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type AuthType string
 
 const (
@@ -2086,92 +2945,222 @@ func (e AuthType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type EnvironmentSortingField string
+type BlackoutWindowRecurrence string
 
 const (
-	EnvironmentSortingFieldName EnvironmentSortingField = "NAME"
-	EnvironmentSortingFieldTime EnvironmentSortingField = "TIME"
+	BlackoutWindowRecurrenceNone   BlackoutWindowRecurrence = "NONE"
+	BlackoutWindowRecurrenceDaily  BlackoutWindowRecurrence = "DAILY"
+	BlackoutWindowRecurrenceWeekly BlackoutWindowRecurrence = "WEEKLY"
 )
 
-var AllEnvironmentSortingField = []EnvironmentSortingField{
-	EnvironmentSortingFieldName,
-	EnvironmentSortingFieldTime,
+var AllBlackoutWindowRecurrence = []BlackoutWindowRecurrence{
+	BlackoutWindowRecurrenceNone,
+	BlackoutWindowRecurrenceDaily,
+	BlackoutWindowRecurrenceWeekly,
 }
 
-func (e EnvironmentSortingField) IsValid() bool {
+func (e BlackoutWindowRecurrence) IsValid() bool {
 	switch e {
-	case EnvironmentSortingFieldName, EnvironmentSortingFieldTime:
+	case BlackoutWindowRecurrenceNone, BlackoutWindowRecurrenceDaily, BlackoutWindowRecurrenceWeekly:
 		return true
 	}
 	return false
 }
 
-func (e EnvironmentSortingField) String() string {
+func (e BlackoutWindowRecurrence) String() string {
 	return string(e)
 }
 
-func (e *EnvironmentSortingField) UnmarshalGQL(v interface{}) error {
+func (e *BlackoutWindowRecurrence) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EnvironmentSortingField(str)
+	*e = BlackoutWindowRecurrence(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EnvironmentSortingField", str)
+		return fmt.Errorf("%s is not a valid BlackoutWindowRecurrence", str)
 	}
 	return nil
 }
 
-func (e EnvironmentSortingField) MarshalGQL(w io.Writer) {
+func (e BlackoutWindowRecurrence) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type EnvironmentType string
+type DiffChangeType string
 
 const (
-	EnvironmentTypeProd    EnvironmentType = "PROD"
-	EnvironmentTypeNonProd EnvironmentType = "NON_PROD"
+	DiffChangeTypeAdded    DiffChangeType = "ADDED"
+	DiffChangeTypeRemoved  DiffChangeType = "REMOVED"
+	DiffChangeTypeModified DiffChangeType = "MODIFIED"
 )
 
-var AllEnvironmentType = []EnvironmentType{
-	EnvironmentTypeProd,
-	EnvironmentTypeNonProd,
+var AllDiffChangeType = []DiffChangeType{
+	DiffChangeTypeAdded,
+	DiffChangeTypeRemoved,
+	DiffChangeTypeModified,
 }
 
-func (e EnvironmentType) IsValid() bool {
+func (e DiffChangeType) IsValid() bool {
 	switch e {
-	case EnvironmentTypeProd, EnvironmentTypeNonProd:
+	case DiffChangeTypeAdded, DiffChangeTypeRemoved, DiffChangeTypeModified:
 		return true
 	}
 	return false
 }
 
-func (e EnvironmentType) String() string {
+func (e DiffChangeType) String() string {
 	return string(e)
 }
 
-func (e *EnvironmentType) UnmarshalGQL(v interface{}) error {
+func (e *DiffChangeType) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = EnvironmentType(str)
+	*e = DiffChangeType(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid EnvironmentType", str)
+		return fmt.Errorf("%s is not a valid DiffChangeType", str)
 	}
 	return nil
 }
 
-func (e EnvironmentType) MarshalGQL(w io.Writer) {
+func (e DiffChangeType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type ExperimentRunStatus string
+type EnvironmentSortingField string
 
 const (
-	ExperimentRunStatusAll                ExperimentRunStatus = "All"
+	EnvironmentSortingFieldName EnvironmentSortingField = "NAME"
+	EnvironmentSortingFieldTime EnvironmentSortingField = "TIME"
+)
+
+var AllEnvironmentSortingField = []EnvironmentSortingField{
+	EnvironmentSortingFieldName,
+	EnvironmentSortingFieldTime,
+}
+
+func (e EnvironmentSortingField) IsValid() bool {
+	switch e {
+	case EnvironmentSortingFieldName, EnvironmentSortingFieldTime:
+		return true
+	}
+	return false
+}
+
+func (e EnvironmentSortingField) String() string {
+	return string(e)
+}
+
+func (e *EnvironmentSortingField) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EnvironmentSortingField(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EnvironmentSortingField", str)
+	}
+	return nil
+}
+
+func (e EnvironmentSortingField) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type EnvironmentType string
+
+const (
+	EnvironmentTypeProd    EnvironmentType = "PROD"
+	EnvironmentTypeNonProd EnvironmentType = "NON_PROD"
+)
+
+var AllEnvironmentType = []EnvironmentType{
+	EnvironmentTypeProd,
+	EnvironmentTypeNonProd,
+}
+
+func (e EnvironmentType) IsValid() bool {
+	switch e {
+	case EnvironmentTypeProd, EnvironmentTypeNonProd:
+		return true
+	}
+	return false
+}
+
+func (e EnvironmentType) String() string {
+	return string(e)
+}
+
+func (e *EnvironmentType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = EnvironmentType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid EnvironmentType", str)
+	}
+	return nil
+}
+
+func (e EnvironmentType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the type of an experiment input
+type ExperimentInputType string
+
+const (
+	ExperimentInputTypeString  ExperimentInputType = "STRING"
+	ExperimentInputTypeInteger ExperimentInputType = "INTEGER"
+	ExperimentInputTypeBoolean ExperimentInputType = "BOOLEAN"
+)
+
+var AllExperimentInputType = []ExperimentInputType{
+	ExperimentInputTypeString,
+	ExperimentInputTypeInteger,
+	ExperimentInputTypeBoolean,
+}
+
+func (e ExperimentInputType) IsValid() bool {
+	switch e {
+	case ExperimentInputTypeString, ExperimentInputTypeInteger, ExperimentInputTypeBoolean:
+		return true
+	}
+	return false
+}
+
+func (e ExperimentInputType) String() string {
+	return string(e)
+}
+
+func (e *ExperimentInputType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ExperimentInputType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ExperimentInputType", str)
+	}
+	return nil
+}
+
+func (e ExperimentInputType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ExperimentRunStatus string
+
+const (
+	ExperimentRunStatusAll                ExperimentRunStatus = "All"
 	ExperimentRunStatusRunning            ExperimentRunStatus = "Running"
 	ExperimentRunStatusCompleted          ExperimentRunStatus = "Completed"
 	ExperimentRunStatusCompletedWithError ExperimentRunStatus = "Completed_With_Error"
@@ -2315,6 +3304,105 @@ func (e ExperimentType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
+// Defines how the fault results are grouped in the analytics
+type FaultAnalyticsGroupBy string
+
+const (
+	// Results are grouped by the name of the fault
+	FaultAnalyticsGroupByFault FaultAnalyticsGroupBy = "FAULT"
+	// Results are grouped by the name of the target, a result is counted once for each of its targets
+	FaultAnalyticsGroupByTarget FaultAnalyticsGroupBy = "TARGET"
+	// Results are grouped by the name of the fault and the name of the target
+	FaultAnalyticsGroupByFaultAndTarget FaultAnalyticsGroupBy = "FAULT_AND_TARGET"
+	// Results are grouped by the experiment
+	FaultAnalyticsGroupByExperiment FaultAnalyticsGroupBy = "EXPERIMENT"
+)
+
+var AllFaultAnalyticsGroupBy = []FaultAnalyticsGroupBy{
+	FaultAnalyticsGroupByFault,
+	FaultAnalyticsGroupByTarget,
+	FaultAnalyticsGroupByFaultAndTarget,
+	FaultAnalyticsGroupByExperiment,
+}
+
+func (e FaultAnalyticsGroupBy) IsValid() bool {
+	switch e {
+	case FaultAnalyticsGroupByFault, FaultAnalyticsGroupByTarget, FaultAnalyticsGroupByFaultAndTarget, FaultAnalyticsGroupByExperiment:
+		return true
+	}
+	return false
+}
+
+func (e FaultAnalyticsGroupBy) String() string {
+	return string(e)
+}
+
+func (e *FaultAnalyticsGroupBy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FaultAnalyticsGroupBy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FaultAnalyticsGroupBy", str)
+	}
+	return nil
+}
+
+func (e FaultAnalyticsGroupBy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Verdict of a fault in an experiment run
+type FaultVerdict string
+
+const (
+	FaultVerdictPass    FaultVerdict = "PASS"
+	FaultVerdictFail    FaultVerdict = "FAIL"
+	FaultVerdictStopped FaultVerdict = "STOPPED"
+	FaultVerdictAwaited FaultVerdict = "AWAITED"
+	// The fault has no verdict, e.g. it could not be started
+	FaultVerdictNa FaultVerdict = "NA"
+)
+
+var AllFaultVerdict = []FaultVerdict{
+	FaultVerdictPass,
+	FaultVerdictFail,
+	FaultVerdictStopped,
+	FaultVerdictAwaited,
+	FaultVerdictNa,
+}
+
+func (e FaultVerdict) IsValid() bool {
+	switch e {
+	case FaultVerdictPass, FaultVerdictFail, FaultVerdictStopped, FaultVerdictAwaited, FaultVerdictNa:
+		return true
+	}
+	return false
+}
+
+func (e FaultVerdict) String() string {
+	return string(e)
+}
+
+func (e *FaultVerdict) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = FaultVerdict(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid FaultVerdict", str)
+	}
+	return nil
+}
+
+func (e FaultVerdict) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
 type FileType string
 
 const (
@@ -2657,231 +3745,839 @@ func (e Mode) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-// Defines the different statuses of Probes
-type ProbeStatus string
+// Result of an experiment run which is compared by a pipeline condition
+type PipelineConditionField string
 
 const (
-	ProbeStatusRunning   ProbeStatus = "Running"
-	ProbeStatusCompleted ProbeStatus = "Completed"
-	ProbeStatusStopped   ProbeStatus = "Stopped"
-	ProbeStatusError     ProbeStatus = "Error"
-	ProbeStatusQueued    ProbeStatus = "Queued"
-	ProbeStatusNa        ProbeStatus = "NA"
+	PipelineConditionFieldResiliencyScore PipelineConditionField = "RESILIENCY_SCORE"
+	PipelineConditionFieldFaultsPassed    PipelineConditionField = "FAULTS_PASSED"
+	PipelineConditionFieldFaultsFailed    PipelineConditionField = "FAULTS_FAILED"
 )
 
-var AllProbeStatus = []ProbeStatus{
-	ProbeStatusRunning,
-	ProbeStatusCompleted,
-	ProbeStatusStopped,
-	ProbeStatusError,
-	ProbeStatusQueued,
-	ProbeStatusNa,
+var AllPipelineConditionField = []PipelineConditionField{
+	PipelineConditionFieldResiliencyScore,
+	PipelineConditionFieldFaultsPassed,
+	PipelineConditionFieldFaultsFailed,
 }
 
-func (e ProbeStatus) IsValid() bool {
+func (e PipelineConditionField) IsValid() bool {
 	switch e {
-	case ProbeStatusRunning, ProbeStatusCompleted, ProbeStatusStopped, ProbeStatusError, ProbeStatusQueued, ProbeStatusNa:
+	case PipelineConditionFieldResiliencyScore, PipelineConditionFieldFaultsPassed, PipelineConditionFieldFaultsFailed:
 		return true
 	}
 	return false
 }
 
-func (e ProbeStatus) String() string {
+func (e PipelineConditionField) String() string {
 	return string(e)
 }
 
-func (e *ProbeStatus) UnmarshalGQL(v interface{}) error {
+func (e *PipelineConditionField) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ProbeStatus(str)
+	*e = PipelineConditionField(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ProbeStatus", str)
+		return fmt.Errorf("%s is not a valid PipelineConditionField", str)
 	}
 	return nil
 }
 
-func (e ProbeStatus) MarshalGQL(w io.Writer) {
+func (e PipelineConditionField) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-// Defines the different types of Probes
-type ProbeType string
+type PipelineConditionOperator string
 
 const (
-	ProbeTypeHTTPProbe ProbeType = "httpProbe"
-	ProbeTypeCmdProbe  ProbeType = "cmdProbe"
-	ProbeTypePromProbe ProbeType = "promProbe"
-	ProbeTypeK8sProbe  ProbeType = "k8sProbe"
+	PipelineConditionOperatorEqual              PipelineConditionOperator = "EQUAL"
+	PipelineConditionOperatorNotEqual           PipelineConditionOperator = "NOT_EQUAL"
+	PipelineConditionOperatorGreaterThan        PipelineConditionOperator = "GREATER_THAN"
+	PipelineConditionOperatorGreaterThanOrEqual PipelineConditionOperator = "GREATER_THAN_OR_EQUAL"
+	PipelineConditionOperatorLessThan           PipelineConditionOperator = "LESS_THAN"
+	PipelineConditionOperatorLessThanOrEqual    PipelineConditionOperator = "LESS_THAN_OR_EQUAL"
 )
 
-var AllProbeType = []ProbeType{
-	ProbeTypeHTTPProbe,
-	ProbeTypeCmdProbe,
-	ProbeTypePromProbe,
-	ProbeTypeK8sProbe,
+var AllPipelineConditionOperator = []PipelineConditionOperator{
+	PipelineConditionOperatorEqual,
+	PipelineConditionOperatorNotEqual,
+	PipelineConditionOperatorGreaterThan,
+	PipelineConditionOperatorGreaterThanOrEqual,
+	PipelineConditionOperatorLessThan,
+	PipelineConditionOperatorLessThanOrEqual,
 }
 
-func (e ProbeType) IsValid() bool {
+func (e PipelineConditionOperator) IsValid() bool {
 	switch e {
-	case ProbeTypeHTTPProbe, ProbeTypeCmdProbe, ProbeTypePromProbe, ProbeTypeK8sProbe:
+	case PipelineConditionOperatorEqual, PipelineConditionOperatorNotEqual, PipelineConditionOperatorGreaterThan, PipelineConditionOperatorGreaterThanOrEqual, PipelineConditionOperatorLessThan, PipelineConditionOperatorLessThanOrEqual:
 		return true
 	}
 	return false
 }
 
-func (e ProbeType) String() string {
+func (e PipelineConditionOperator) String() string {
 	return string(e)
 }
 
-func (e *ProbeType) UnmarshalGQL(v interface{}) error {
+func (e *PipelineConditionOperator) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ProbeType(str)
+	*e = PipelineConditionOperator(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ProbeType", str)
+		return fmt.Errorf("%s is not a valid PipelineConditionOperator", str)
 	}
 	return nil
 }
 
-func (e ProbeType) MarshalGQL(w io.Writer) {
+func (e PipelineConditionOperator) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-// Defines the older different statuses of Probes
-type ProbeVerdict string
+type PipelineExecutionStatus string
 
 const (
-	ProbeVerdictPassed  ProbeVerdict = "Passed"
-	ProbeVerdictFailed  ProbeVerdict = "Failed"
-	ProbeVerdictNa      ProbeVerdict = "NA"
-	ProbeVerdictAwaited ProbeVerdict = "Awaited"
+	// A stage of the pipeline is running
+	PipelineExecutionStatusRunning PipelineExecutionStatus = "RUNNING"
+	// All the stages of the pipeline are completed
+	PipelineExecutionStatusCompleted PipelineExecutionStatus = "COMPLETED"
+	// A condition of a stage is not met, hence the remaining stages are skipped
+	PipelineExecutionStatusConditionNotMet PipelineExecutionStatus = "CONDITION_NOT_MET"
+	// A stage could not be started or its experiment run did not complete successfully
+	PipelineExecutionStatusFailed PipelineExecutionStatus = "FAILED"
+	// The execution is stopped by a user
+	PipelineExecutionStatusStopped PipelineExecutionStatus = "STOPPED"
 )
 
-var AllProbeVerdict = []ProbeVerdict{
-	ProbeVerdictPassed,
-	ProbeVerdictFailed,
-	ProbeVerdictNa,
-	ProbeVerdictAwaited,
+var AllPipelineExecutionStatus = []PipelineExecutionStatus{
+	PipelineExecutionStatusRunning,
+	PipelineExecutionStatusCompleted,
+	PipelineExecutionStatusConditionNotMet,
+	PipelineExecutionStatusFailed,
+	PipelineExecutionStatusStopped,
 }
 
-func (e ProbeVerdict) IsValid() bool {
+func (e PipelineExecutionStatus) IsValid() bool {
 	switch e {
-	case ProbeVerdictPassed, ProbeVerdictFailed, ProbeVerdictNa, ProbeVerdictAwaited:
+	case PipelineExecutionStatusRunning, PipelineExecutionStatusCompleted, PipelineExecutionStatusConditionNotMet, PipelineExecutionStatusFailed, PipelineExecutionStatusStopped:
 		return true
 	}
 	return false
 }
 
-func (e ProbeVerdict) String() string {
+func (e PipelineExecutionStatus) String() string {
 	return string(e)
 }
 
-func (e *ProbeVerdict) UnmarshalGQL(v interface{}) error {
+func (e *PipelineExecutionStatus) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ProbeVerdict(str)
+	*e = PipelineExecutionStatus(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ProbeVerdict", str)
+		return fmt.Errorf("%s is not a valid PipelineExecutionStatus", str)
 	}
 	return nil
 }
 
-func (e ProbeVerdict) MarshalGQL(w io.Writer) {
+func (e PipelineExecutionStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-type ScheduleType string
+type PipelineStageStatus string
 
 const (
-	ScheduleTypeCron    ScheduleType = "CRON"
-	ScheduleTypeNonCron ScheduleType = "NON_CRON"
-	ScheduleTypeAll     ScheduleType = "ALL"
+	PipelineStageStatusPending   PipelineStageStatus = "PENDING"
+	PipelineStageStatusRunning   PipelineStageStatus = "RUNNING"
+	PipelineStageStatusCompleted PipelineStageStatus = "COMPLETED"
+	PipelineStageStatusSkipped   PipelineStageStatus = "SKIPPED"
+	PipelineStageStatusFailed    PipelineStageStatus = "FAILED"
 )
 
-var AllScheduleType = []ScheduleType{
-	ScheduleTypeCron,
-	ScheduleTypeNonCron,
-	ScheduleTypeAll,
+var AllPipelineStageStatus = []PipelineStageStatus{
+	PipelineStageStatusPending,
+	PipelineStageStatusRunning,
+	PipelineStageStatusCompleted,
+	PipelineStageStatusSkipped,
+	PipelineStageStatusFailed,
 }
 
-func (e ScheduleType) IsValid() bool {
+func (e PipelineStageStatus) IsValid() bool {
 	switch e {
-	case ScheduleTypeCron, ScheduleTypeNonCron, ScheduleTypeAll:
+	case PipelineStageStatusPending, PipelineStageStatusRunning, PipelineStageStatusCompleted, PipelineStageStatusSkipped, PipelineStageStatusFailed:
 		return true
 	}
 	return false
 }
 
-func (e ScheduleType) String() string {
+func (e PipelineStageStatus) String() string {
 	return string(e)
 }
 
-func (e *ScheduleType) UnmarshalGQL(v interface{}) error {
+func (e *PipelineStageStatus) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = ScheduleType(str)
+	*e = PipelineStageStatus(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid ScheduleType", str)
+		return fmt.Errorf("%s is not a valid PipelineStageStatus", str)
 	}
 	return nil
 }
 
-func (e ScheduleType) MarshalGQL(w io.Writer) {
+func (e PipelineStageStatus) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
 
-// UpdateStatus represents if infra needs to be updated
-type UpdateStatus string
+// Defines how a probe of an imported experiment bundle is handled if a probe with the same name already exists
+type ProbeConflictResolution string
 
 const (
-	UpdateStatusAvailable   UpdateStatus = "AVAILABLE"
-	UpdateStatusMandatory   UpdateStatus = "MANDATORY"
-	UpdateStatusNotRequired UpdateStatus = "NOT_REQUIRED"
+	// Creates the probe with a new unique name and updates the references in the experiment
+	ProbeConflictResolutionRename ProbeConflictResolution = "RENAME"
+	// Uses the existing probe, the type of the existing probe must match
+	ProbeConflictResolutionReuse ProbeConflictResolution = "REUSE"
+	// Fails the import
+	ProbeConflictResolutionFail ProbeConflictResolution = "FAIL"
 )
 
-var AllUpdateStatus = []UpdateStatus{
-	UpdateStatusAvailable,
-	UpdateStatusMandatory,
-	UpdateStatusNotRequired,
+var AllProbeConflictResolution = []ProbeConflictResolution{
+	ProbeConflictResolutionRename,
+	ProbeConflictResolutionReuse,
+	ProbeConflictResolutionFail,
 }
 
-func (e UpdateStatus) IsValid() bool {
+func (e ProbeConflictResolution) IsValid() bool {
 	switch e {
-	case UpdateStatusAvailable, UpdateStatusMandatory, UpdateStatusNotRequired:
+	case ProbeConflictResolutionRename, ProbeConflictResolutionReuse, ProbeConflictResolutionFail:
 		return true
 	}
 	return false
 }
 
-func (e UpdateStatus) String() string {
+func (e ProbeConflictResolution) String() string {
 	return string(e)
 }
 
-func (e *UpdateStatus) UnmarshalGQL(v interface{}) error {
+func (e *ProbeConflictResolution) UnmarshalGQL(v interface{}) error {
 	str, ok := v.(string)
 	if !ok {
 		return fmt.Errorf("enums must be strings")
 	}
 
-	*e = UpdateStatus(str)
+	*e = ProbeConflictResolution(str)
 	if !e.IsValid() {
-		return fmt.Errorf("%s is not a valid UpdateStatus", str)
+		return fmt.Errorf("%s is not a valid ProbeConflictResolution", str)
 	}
 	return nil
 }
 
-func (e UpdateStatus) MarshalGQL(w io.Writer) {
+func (e ProbeConflictResolution) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the different statuses of Probes
+type ProbeStatus string
+
+const (
+	ProbeStatusRunning   ProbeStatus = "Running"
+	ProbeStatusCompleted ProbeStatus = "Completed"
+	ProbeStatusStopped   ProbeStatus = "Stopped"
+	ProbeStatusError     ProbeStatus = "Error"
+	ProbeStatusQueued    ProbeStatus = "Queued"
+	ProbeStatusNa        ProbeStatus = "NA"
+)
+
+var AllProbeStatus = []ProbeStatus{
+	ProbeStatusRunning,
+	ProbeStatusCompleted,
+	ProbeStatusStopped,
+	ProbeStatusError,
+	ProbeStatusQueued,
+	ProbeStatusNa,
+}
+
+func (e ProbeStatus) IsValid() bool {
+	switch e {
+	case ProbeStatusRunning, ProbeStatusCompleted, ProbeStatusStopped, ProbeStatusError, ProbeStatusQueued, ProbeStatusNa:
+		return true
+	}
+	return false
+}
+
+func (e ProbeStatus) String() string {
+	return string(e)
+}
+
+func (e *ProbeStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProbeStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProbeStatus", str)
+	}
+	return nil
+}
+
+func (e ProbeStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the different types of Probes
+type ProbeType string
+
+const (
+	ProbeTypeHTTPProbe ProbeType = "httpProbe"
+	ProbeTypeCmdProbe  ProbeType = "cmdProbe"
+	ProbeTypePromProbe ProbeType = "promProbe"
+	ProbeTypeK8sProbe  ProbeType = "k8sProbe"
+)
+
+var AllProbeType = []ProbeType{
+	ProbeTypeHTTPProbe,
+	ProbeTypeCmdProbe,
+	ProbeTypePromProbe,
+	ProbeTypeK8sProbe,
+}
+
+func (e ProbeType) IsValid() bool {
+	switch e {
+	case ProbeTypeHTTPProbe, ProbeTypeCmdProbe, ProbeTypePromProbe, ProbeTypeK8sProbe:
+		return true
+	}
+	return false
+}
+
+func (e ProbeType) String() string {
+	return string(e)
+}
+
+func (e *ProbeType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProbeType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProbeType", str)
+	}
+	return nil
+}
+
+func (e ProbeType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the older different statuses of Probes
+type ProbeVerdict string
+
+const (
+	ProbeVerdictPassed  ProbeVerdict = "Passed"
+	ProbeVerdictFailed  ProbeVerdict = "Failed"
+	ProbeVerdictNa      ProbeVerdict = "NA"
+	ProbeVerdictAwaited ProbeVerdict = "Awaited"
+)
+
+var AllProbeVerdict = []ProbeVerdict{
+	ProbeVerdictPassed,
+	ProbeVerdictFailed,
+	ProbeVerdictNa,
+	ProbeVerdictAwaited,
+}
+
+func (e ProbeVerdict) IsValid() bool {
+	switch e {
+	case ProbeVerdictPassed, ProbeVerdictFailed, ProbeVerdictNa, ProbeVerdictAwaited:
+		return true
+	}
+	return false
+}
+
+func (e ProbeVerdict) String() string {
+	return string(e)
+}
+
+func (e *ProbeVerdict) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ProbeVerdict(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ProbeVerdict", str)
+	}
+	return nil
+}
+
+func (e ProbeVerdict) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the method used to calculate the resiliency score of the experiment runs
+type ResiliencyScoreStrategy string
+
+const (
+	// Average of the probe success percentages of the faults weighted by their weightages
+	ResiliencyScoreStrategyWeightedProbeSuccess ResiliencyScoreStrategy = "WEIGHTED_PROBE_SUCCESS"
+	// Weighted share of the faults with a passed verdict, the probes are ignored
+	ResiliencyScoreStrategyVerdict ResiliencyScoreStrategy = "VERDICT"
+	// Same as WEIGHTED_PROBE_SUCCESS but the score is 0 if any fault fails
+	ResiliencyScoreStrategyCriticalFault ResiliencyScoreStrategy = "CRITICAL_FAULT"
+	// Average of the probe success of the faults weighted by their weightages,
+	// where the probes running throughout the chaos weigh more than the others
+	ResiliencyScoreStrategyProbeModeWeighted ResiliencyScoreStrategy = "PROBE_MODE_WEIGHTED"
+)
+
+var AllResiliencyScoreStrategy = []ResiliencyScoreStrategy{
+	ResiliencyScoreStrategyWeightedProbeSuccess,
+	ResiliencyScoreStrategyVerdict,
+	ResiliencyScoreStrategyCriticalFault,
+	ResiliencyScoreStrategyProbeModeWeighted,
+}
+
+func (e ResiliencyScoreStrategy) IsValid() bool {
+	switch e {
+	case ResiliencyScoreStrategyWeightedProbeSuccess, ResiliencyScoreStrategyVerdict, ResiliencyScoreStrategyCriticalFault, ResiliencyScoreStrategyProbeModeWeighted:
+		return true
+	}
+	return false
+}
+
+func (e ResiliencyScoreStrategy) String() string {
+	return string(e)
+}
+
+func (e *ResiliencyScoreStrategy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ResiliencyScoreStrategy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ResiliencyScoreStrategy", str)
+	}
+	return nil
+}
+
+func (e ResiliencyScoreStrategy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the action of an experiment which waits for an approval
+type RunApprovalAction string
+
+const (
+	// The experiment is run once it is approved
+	RunApprovalActionRun RunApprovalAction = "RUN"
+	// The cron schedule of the experiment is enabled once it is approved
+	RunApprovalActionEnableCron RunApprovalAction = "ENABLE_CRON"
+)
+
+var AllRunApprovalAction = []RunApprovalAction{
+	RunApprovalActionRun,
+	RunApprovalActionEnableCron,
+}
+
+func (e RunApprovalAction) IsValid() bool {
+	switch e {
+	case RunApprovalActionRun, RunApprovalActionEnableCron:
+		return true
+	}
+	return false
+}
+
+func (e RunApprovalAction) String() string {
+	return string(e)
+}
+
+func (e *RunApprovalAction) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RunApprovalAction(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RunApprovalAction", str)
+	}
+	return nil
+}
+
+func (e RunApprovalAction) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type RunApprovalStatus string
+
+const (
+	RunApprovalStatusPending  RunApprovalStatus = "PENDING"
+	RunApprovalStatusApproved RunApprovalStatus = "APPROVED"
+	RunApprovalStatusRejected RunApprovalStatus = "REJECTED"
+)
+
+var AllRunApprovalStatus = []RunApprovalStatus{
+	RunApprovalStatusPending,
+	RunApprovalStatusApproved,
+	RunApprovalStatusRejected,
+}
+
+func (e RunApprovalStatus) IsValid() bool {
+	switch e {
+	case RunApprovalStatusPending, RunApprovalStatusApproved, RunApprovalStatusRejected:
+		return true
+	}
+	return false
+}
+
+func (e RunApprovalStatus) String() string {
+	return string(e)
+}
+
+func (e *RunApprovalStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RunApprovalStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RunApprovalStatus", str)
+	}
+	return nil
+}
+
+func (e RunApprovalStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the order in which the queued experiment runs of an infra are started
+type RunQueuePolicy string
+
+const (
+	// Runs are started in the order they were queued
+	RunQueuePolicyFifo RunQueuePolicy = "FIFO"
+	// Runs with a higher priority are started first, runs with the same priority are started in the order they were queued
+	RunQueuePolicyPriority RunQueuePolicy = "PRIORITY"
+)
+
+var AllRunQueuePolicy = []RunQueuePolicy{
+	RunQueuePolicyFifo,
+	RunQueuePolicyPriority,
+}
+
+func (e RunQueuePolicy) IsValid() bool {
+	switch e {
+	case RunQueuePolicyFifo, RunQueuePolicyPriority:
+		return true
+	}
+	return false
+}
+
+func (e RunQueuePolicy) String() string {
+	return string(e)
+}
+
+func (e *RunQueuePolicy) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RunQueuePolicy(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RunQueuePolicy", str)
+	}
+	return nil
+}
+
+func (e RunQueuePolicy) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines what happens to the experiment runs which are beyond the retention policy
+type RunRetentionMode string
+
+const (
+	// The execution data of the runs is deleted
+	RunRetentionModeDelete RunRetentionMode = "DELETE"
+	// The runs are written to the archive sink of the server before their execution data is deleted
+	RunRetentionModeArchive RunRetentionMode = "ARCHIVE"
+)
+
+var AllRunRetentionMode = []RunRetentionMode{
+	RunRetentionModeDelete,
+	RunRetentionModeArchive,
+}
+
+func (e RunRetentionMode) IsValid() bool {
+	switch e {
+	case RunRetentionModeDelete, RunRetentionModeArchive:
+		return true
+	}
+	return false
+}
+
+func (e RunRetentionMode) String() string {
+	return string(e)
+}
+
+func (e *RunRetentionMode) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = RunRetentionMode(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid RunRetentionMode", str)
+	}
+	return nil
+}
+
+func (e RunRetentionMode) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type ScheduleType string
+
+const (
+	ScheduleTypeCron    ScheduleType = "CRON"
+	ScheduleTypeNonCron ScheduleType = "NON_CRON"
+	ScheduleTypeAll     ScheduleType = "ALL"
+)
+
+var AllScheduleType = []ScheduleType{
+	ScheduleTypeCron,
+	ScheduleTypeNonCron,
+	ScheduleTypeAll,
+}
+
+func (e ScheduleType) IsValid() bool {
+	switch e {
+	case ScheduleTypeCron, ScheduleTypeNonCron, ScheduleTypeAll:
+		return true
+	}
+	return false
+}
+
+func (e ScheduleType) String() string {
+	return string(e)
+}
+
+func (e *ScheduleType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = ScheduleType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid ScheduleType", str)
+	}
+	return nil
+}
+
+func (e ScheduleType) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Defines the size of the time buckets of the resiliency trends
+type TrendGranularity string
+
+const (
+	// Buckets of a day starting at midnight UTC
+	TrendGranularityDay TrendGranularity = "DAY"
+	// Buckets of a week starting on Monday at midnight UTC
+	TrendGranularityWeek TrendGranularity = "WEEK"
+)
+
+var AllTrendGranularity = []TrendGranularity{
+	TrendGranularityDay,
+	TrendGranularityWeek,
+}
+
+func (e TrendGranularity) IsValid() bool {
+	switch e {
+	case TrendGranularityDay, TrendGranularityWeek:
+		return true
+	}
+	return false
+}
+
+func (e TrendGranularity) String() string {
+	return string(e)
+}
+
+func (e *TrendGranularity) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = TrendGranularity(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid TrendGranularity", str)
+	}
+	return nil
+}
+
+func (e TrendGranularity) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// UpdateStatus represents if infra needs to be updated
+type UpdateStatus string
+
+const (
+	UpdateStatusAvailable   UpdateStatus = "AVAILABLE"
+	UpdateStatusMandatory   UpdateStatus = "MANDATORY"
+	UpdateStatusNotRequired UpdateStatus = "NOT_REQUIRED"
+)
+
+var AllUpdateStatus = []UpdateStatus{
+	UpdateStatusAvailable,
+	UpdateStatusMandatory,
+	UpdateStatusNotRequired,
+}
+
+func (e UpdateStatus) IsValid() bool {
+	switch e {
+	case UpdateStatusAvailable, UpdateStatusMandatory, UpdateStatusNotRequired:
+		return true
+	}
+	return false
+}
+
+func (e UpdateStatus) String() string {
+	return string(e)
+}
+
+func (e *UpdateStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = UpdateStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid UpdateStatus", str)
+	}
+	return nil
+}
+
+func (e UpdateStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+type WebhookDeliveryStatus string
+
+const (
+	// The delivery is being sent or retried
+	WebhookDeliveryStatusPending WebhookDeliveryStatus = "PENDING"
+	// The endpoint responded with a 2xx status code
+	WebhookDeliveryStatusSucceeded WebhookDeliveryStatus = "SUCCEEDED"
+	// The endpoint could not be reached or didn't respond with a 2xx status code after all the attempts
+	WebhookDeliveryStatusFailed WebhookDeliveryStatus = "FAILED"
+)
+
+var AllWebhookDeliveryStatus = []WebhookDeliveryStatus{
+	WebhookDeliveryStatusPending,
+	WebhookDeliveryStatusSucceeded,
+	WebhookDeliveryStatusFailed,
+}
+
+func (e WebhookDeliveryStatus) IsValid() bool {
+	switch e {
+	case WebhookDeliveryStatusPending, WebhookDeliveryStatusSucceeded, WebhookDeliveryStatusFailed:
+		return true
+	}
+	return false
+}
+
+func (e WebhookDeliveryStatus) String() string {
+	return string(e)
+}
+
+func (e *WebhookDeliveryStatus) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookDeliveryStatus(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookDeliveryStatus", str)
+	}
+	return nil
+}
+
+func (e WebhookDeliveryStatus) MarshalGQL(w io.Writer) {
+	fmt.Fprint(w, strconv.Quote(e.String()))
+}
+
+// Lifecycle events of the experiment runs which are posted to the webhooks
+type WebhookEventType string
+
+const (
+	// The experiment run is started on the infra
+	WebhookEventTypeRunStarted WebhookEventType = "RUN_STARTED"
+	// The experiment run is completed successfully
+	WebhookEventTypeRunCompleted WebhookEventType = "RUN_COMPLETED"
+	// The experiment run is completed with errors, errored out or was terminated
+	WebhookEventTypeRunFailed WebhookEventType = "RUN_FAILED"
+	// The experiment run has timed out
+	WebhookEventTypeRunTimedOut WebhookEventType = "RUN_TIMED_OUT"
+	// The experiment run is stopped
+	WebhookEventTypeRunStopped WebhookEventType = "RUN_STOPPED"
+)
+
+var AllWebhookEventType = []WebhookEventType{
+	WebhookEventTypeRunStarted,
+	WebhookEventTypeRunCompleted,
+	WebhookEventTypeRunFailed,
+	WebhookEventTypeRunTimedOut,
+	WebhookEventTypeRunStopped,
+}
+
+func (e WebhookEventType) IsValid() bool {
+	switch e {
+	case WebhookEventTypeRunStarted, WebhookEventTypeRunCompleted, WebhookEventTypeRunFailed, WebhookEventTypeRunTimedOut, WebhookEventTypeRunStopped:
+		return true
+	}
+	return false
+}
+
+func (e WebhookEventType) String() string {
+	return string(e)
+}
+
+func (e *WebhookEventType) UnmarshalGQL(v interface{}) error {
+	str, ok := v.(string)
+	if !ok {
+		return fmt.Errorf("enums must be strings")
+	}
+
+	*e = WebhookEventType(str)
+	if !e.IsValid() {
+		return fmt.Errorf("%s is not a valid WebhookEventType", str)
+	}
+	return nil
+}
+
+func (e WebhookEventType) MarshalGQL(w io.Writer) {
 	fmt.Fprint(w, strconv.Quote(e.String()))
 }
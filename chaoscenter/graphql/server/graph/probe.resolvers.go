@@ -22,7 +22,7 @@ func (r *mutationResolver) AddProbe(ctx context.Context, request model.ProbeRequ
 	logrus.WithFields(logFields).Info("request received to create a probe")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.AddProbe],
+		authorization.AddProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -73,7 +73,7 @@ func (r *mutationResolver) UpdateProbe(ctx context.Context, request model.ProbeR
 	logrus.WithFields(logFields).WithField("probeID", request.Name).Info("request received to update probe")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.UpdateProbe],
+		authorization.UpdateProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -98,7 +98,7 @@ func (r *mutationResolver) DeleteProbe(ctx context.Context, probeName string, pr
 	logrus.WithFields(logFields).WithField("probeID", probeName).Info("request received to delete a probe")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.DeleteProbe],
+		authorization.DeleteProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -122,7 +122,7 @@ func (r *queryResolver) ListProbes(ctx context.Context, projectID string, infras
 
 	logrus.WithFields(logFields).Info("request received to get probes")
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.ListProbes],
+		authorization.ListProbes,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -147,7 +147,7 @@ func (r *queryResolver) GetProbe(ctx context.Context, projectID string, probeNam
 	logrus.WithFields(logFields).Info("request received to get probe")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetProbe],
+		authorization.GetProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -172,7 +172,7 @@ func (r *queryResolver) GetProbeYaml(ctx context.Context, projectID string, requ
 	logrus.WithFields(logFields).Info("request received to get probe YAML")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetProbe],
+		authorization.GetProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return "", err
@@ -197,7 +197,7 @@ func (r *queryResolver) GetProbeReference(ctx context.Context, projectID string,
 	logrus.WithFields(logFields).Info("request received to get probe references")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetProbe],
+		authorization.GetProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -222,7 +222,7 @@ func (r *queryResolver) GetProbesInExperimentRun(ctx context.Context, projectID
 	logrus.WithFields(logFields).Info("request received to get probes of the experiment run")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetProbe],
+		authorization.GetProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return nil, err
@@ -247,7 +247,7 @@ func (r *queryResolver) ValidateUniqueProbe(ctx context.Context, projectID strin
 	logrus.WithFields(logFields).Info("request received to validate probe uniqueness")
 
 	err := authorization.ValidateRole(ctx, projectID,
-		authorization.MutationRbacRules[authorization.GetProbe],
+		authorization.GetProbe,
 		model.InvitationAccepted.String())
 	if err != nil {
 		return false, err
@@ -2,6 +2,7 @@ package graph
 
 import (
 	"context"
+	"errors"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/authConfig"
 
@@ -9,25 +10,42 @@ import (
 
 	"github.com/99designs/gqlgen/graphql"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment/handler"
 	chaos_experiment_run2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run"
 	runHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run/handler"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_pipeline"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/cloudevents"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
 	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbChaosPipeline "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_pipeline"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
+	dbFaultResult "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/fault_result"
 	gitops2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
 	image_registry2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/image_registry"
 	dbSchemaProbe "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/probe"
+	dbRetention "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/retention"
+	dbRunApproval "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/run_approval"
+	dbWebhook "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/webhook"
 	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/fault_result"
 	gitops3 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/image_registry"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
 	probe "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/probe/handler"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/retention"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/run_approval"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/webhook"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
 )
 
 // This file will not be regenerated automatically.
@@ -45,6 +63,13 @@ type Resolver struct {
 	chaosExperimentRunHandler  runHandler.ChaosExperimentRunHandler
 	environmentService         envHandler.EnvironmentHandler
 	probeService               probe.Service
+	runDispatcher              *chaos_experiment_run2.RunDispatcher
+	chaosPipelineService       chaos_pipeline.Service
+	webhookService             webhook.Service
+	auditService               audit.Service
+	retentionService           retention.Service
+	faultResultService         fault_result.Service
+	runApprovalService         run_approval.Service
 }
 
 func NewConfig(mongodbOperator mongodb.MongoOperator) generated.Config {
@@ -64,13 +89,27 @@ func NewConfig(mongodbOperator mongodb.MongoOperator) generated.Config {
 	chaosInfrastructureService := chaos_infrastructure.NewChaosInfrastructureService(chaosInfraOperator, EnvironmentOperator)
 	chaosExperimentService := chaos_experiment2.NewChaosExperimentService(chaosExperimentOperator, chaosInfraOperator, chaosExperimentRunOperator, probeService)
 	chaosExperimentRunService := chaos_experiment_run2.NewChaosExperimentRunService(chaosExperimentOperator, chaosInfraOperator, chaosExperimentRunOperator)
-	gitOpsService := gitops3.NewGitOpsService(gitopsOperator, chaosExperimentService, *chaosExperimentOperator)
+	gitOpsService := gitops3.NewGitOpsService(gitopsOperator, chaosExperimentService, *chaosExperimentOperator, mongodbOperator)
 	imageRegistryService := image_registry.NewImageRegistryService(imageRegistryOperator)
 	environmentService := envHandler.NewEnvironmentService(EnvironmentOperator)
 
 	//handler
 	chaosExperimentHandler := handler.NewChaosExperimentHandler(chaosExperimentService, chaosExperimentRunService, chaosInfrastructureService, gitOpsService, chaosExperimentOperator, chaosExperimentRunOperator, probeService, mongodbOperator)
 	choasExperimentRunHandler := runHandler.NewChaosExperimentRunHandler(chaosExperimentRunService, chaosInfrastructureService, gitOpsService, chaosExperimentOperator, chaosExperimentRunOperator, probeService, mongodbOperator)
+	gitOpsService.SetExperimentRunner(choasExperimentRunHandler)
+	chaosPipelineService := chaos_pipeline.NewChaosPipelineService(dbChaosPipeline.NewChaosPipelineOperator(mongodbOperator), chaosExperimentOperator, choasExperimentRunHandler, chaosExperimentHandler, mongodbOperator)
+	choasExperimentRunHandler.AddExperimentRunListener(chaosPipelineService)
+	chaosExperimentHandler.AddExperimentRunListener(chaosPipelineService)
+	webhookService := webhook.NewWebhookService(dbWebhook.NewWebhookOperator(mongodbOperator), chaosExperimentOperator, chaosInfraOperator)
+	choasExperimentRunHandler.AddExperimentRunListener(webhookService)
+	chaosExperimentHandler.AddExperimentRunListener(webhookService)
+	choasExperimentRunHandler.AddExperimentRunListener(cloudevents.ExperimentRunListener{})
+	chaosExperimentHandler.AddExperimentRunListener(cloudevents.ExperimentRunListener{})
+	choasExperimentRunHandler.AddExperimentRunListener(metrics.ExperimentRunListener{})
+	chaosExperimentHandler.AddExperimentRunListener(metrics.ExperimentRunListener{})
+	runApprovalService := run_approval.NewRunApprovalService(dbRunApproval.NewRunApprovalOperator(mongodbOperator), chaosExperimentOperator, mongodbOperator, choasExperimentRunHandler, chaosExperimentHandler)
+	chaosExperimentHandler.SetApprovalRequester(runApprovalService)
+	gitOpsService.SetApprovalRequester(runApprovalService)
 
 	config := generated.Config{
 		Resolvers: &Resolver{
@@ -84,9 +123,16 @@ func NewConfig(mongodbOperator mongodb.MongoOperator) generated.Config {
 			chaosExperimentHandler:     *chaosExperimentHandler,
 			chaosExperimentRunHandler:  *choasExperimentRunHandler,
 			probeService:               probeService,
+			runDispatcher:              chaos_experiment_run2.NewRunDispatcher(mongodbOperator),
+			chaosPipelineService:       chaosPipelineService,
+			webhookService:             webhookService,
+			auditService:               audit.NewAuditService(dbAudit.NewAuditOperator(mongodbOperator), mongodbOperator),
+			retentionService:           retention.NewRetentionService(dbRetention.NewRetentionOperator(mongodbOperator)),
+			faultResultService:         fault_result.NewFaultResultService(dbFaultResult.NewFaultResultOperator(mongodbOperator)),
+			runApprovalService:         runApprovalService,
 		}}
 
-	config.Directives.Authorized = func(ctx context.Context, obj interface{}, next graphql.Resolver) (interface{}, error) {
+	config.Directives.Authorized = func(ctx context.Context, obj interface{}, next graphql.Resolver, action *string) (interface{}, error) {
 		token := ctx.Value(authorization.AuthKey).(string)
 		salt, err := authConfig.NewAuthConfigOperator(mongodb.Operator).GetAuthConfig(context.Background())
 		if err != nil {
@@ -99,7 +145,75 @@ func NewConfig(mongodbOperator mongodb.MongoOperator) generated.Config {
 
 		newCtx := context.WithValue(ctx, authorization.UserClaim, user)
 
+		// The role of the user is validated for the action in the project the field is resolved for
+		if action != nil {
+			projectID, ok := graphql.GetFieldContext(ctx).Args["projectID"].(string)
+			if !ok {
+				return nil, errors.New("permission_denied: projectID is required to authorize " + *action)
+			}
+			err = authorization.ValidateRole(newCtx, projectID, authorization.RoleQuery(*action),
+				model.InvitationAccepted.String())
+			if err != nil {
+				return nil, err
+			}
+		}
+
 		return next(newCtx)
 	}
 	return config
 }
+
+// ExperimentRunHandler returns the experiment run handler of the resolvers along with its experiment run listeners
+func (r *Resolver) ExperimentRunHandler() *runHandler.ChaosExperimentRunHandler {
+	return &r.chaosExperimentRunHandler
+}
+
+// validateExperimentRole validates the role of the user for an action on an experiment, the action is scoped to the
+// environment of the target infra and, when the experiment already runs on another infra, to the environment of that
+// infra as well so that an experiment can't be moved out of an environment the user isn't allowed to act in
+func (r *Resolver) validateExperimentRole(ctx context.Context, projectID string, action authorization.RoleQuery, experimentID string, infraID string) error {
+	var infraIDs []string
+	if infraID != "" {
+		infraIDs = append(infraIDs, infraID)
+	}
+	if experimentID != "" {
+		experiment, err := r.chaosExperimentHandler.GetDBExperiment(bson.D{
+			{"experiment_id", experimentID},
+			{"project_id", projectID},
+			{"is_removed", false},
+		})
+		if err == nil && experiment.InfraID != "" && experiment.InfraID != infraID {
+			infraIDs = append(infraIDs, experiment.InfraID)
+		}
+	}
+	if len(infraIDs) == 0 {
+		return authorization.ValidateRole(ctx, projectID, action, model.InvitationAccepted.String())
+	}
+
+	for _, id := range infraIDs {
+		err := authorization.ValidateEnvironmentRole(ctx, projectID, action,
+			r.infraEnvironmentScope(projectID, id),
+			model.InvitationAccepted.String())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// infraEnvironmentScope returns the environment of the infra as the scope of the actions performed on it,
+// the scope is left empty if the infra or its environment can't be found in the project
+func (r *Resolver) infraEnvironmentScope(projectID string, infraID string) authorization.EnvironmentScope {
+	infra, err := r.chaosInfrastructureService.GetDBInfra(infraID)
+	if err != nil || infra.ProjectID != projectID {
+		return authorization.EnvironmentScope{}
+	}
+	scope := authorization.EnvironmentScope{EnvironmentID: infra.EnvironmentID}
+	env, err := r.environmentService.GetEnvironment(projectID, infra.EnvironmentID)
+	if err != nil {
+		logrus.WithField("environmentId", infra.EnvironmentID).Warnf("failed to get the environment of the infra, error: %v", err)
+		return scope
+	}
+	scope.EnvironmentType = string(env.Type)
+	return scope
+}
@@ -0,0 +1,82 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/sirupsen/logrus"
+)
+
+// UpdateRunRetentionPolicy is the resolver for the updateRunRetentionPolicy field.
+func (r *mutationResolver) UpdateRunRetentionPolicy(ctx context.Context, projectID string, request model.RunRetentionPolicyRequest) (*model.RunRetentionPolicy, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to update run retention policy")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateRunRetentionPolicy,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := r.retentionService.UpdateRunRetentionPolicy(ctx, projectID, request, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return policy, nil
+}
+
+// ResetRunRetentionPolicy is the resolver for the resetRunRetentionPolicy field.
+func (r *mutationResolver) ResetRunRetentionPolicy(ctx context.Context, projectID string) (*model.RunRetentionPolicy, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to reset run retention policy")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ResetRunRetentionPolicy,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := r.retentionService.ResetRunRetentionPolicy(ctx, projectID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return policy, nil
+}
+
+// GetRunRetentionPolicy is the resolver for the getRunRetentionPolicy field.
+func (r *queryResolver) GetRunRetentionPolicy(ctx context.Context, projectID string) (*model.RunRetentionPolicy, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to get run retention policy")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetRunRetentionPolicy,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := r.retentionService.GetRunRetentionPolicy(ctx, projectID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return policy, nil
+}
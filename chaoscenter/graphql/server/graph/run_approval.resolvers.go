@@ -0,0 +1,91 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	data_store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/sirupsen/logrus"
+)
+
+// ApproveRunApproval is the resolver for the approveRunApproval field.
+func (r *mutationResolver) ApproveRunApproval(ctx context.Context, projectID string, approvalID string, comment *string) (*model.RunApprovalRequest, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"approvalId": approvalID,
+	}
+	logrus.WithFields(logFields).Info("request received to approve run approval request")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.DecideRunApproval,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.runApprovalService.ApproveRunApproval(ctx, projectID, approvalID, comment, username, data_store.Store)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// RejectRunApproval is the resolver for the rejectRunApproval field.
+func (r *mutationResolver) RejectRunApproval(ctx context.Context, projectID string, approvalID string, comment *string) (*model.RunApprovalRequest, error) {
+	logFields := logrus.Fields{
+		"projectId":  projectID,
+		"approvalId": approvalID,
+	}
+	logrus.WithFields(logFields).Info("request received to reject run approval request")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.DecideRunApproval,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.runApprovalService.RejectRunApproval(ctx, projectID, approvalID, comment, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return response, nil
+}
+
+// ListRunApprovals is the resolver for the listRunApprovals field.
+func (r *queryResolver) ListRunApprovals(ctx context.Context, projectID string, request *model.ListRunApprovalsRequest) (*model.ListRunApprovalsResponse, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list run approval requests")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListRunApprovals,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := r.runApprovalService.ListRunApprovals(ctx, projectID, request)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return response, nil
+}
@@ -0,0 +1,161 @@
+package graph
+
+// This file will be automatically regenerated based on the schema, any resolver implementations
+// will be copied through when generating and any unknown code will be moved to the end.
+// Code generated by github.com/99designs/gqlgen version v0.17.49
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/sirupsen/logrus"
+)
+
+// CreateWebhook is the resolver for the createWebhook field.
+func (r *mutationResolver) CreateWebhook(ctx context.Context, projectID string, request model.CreateWebhookRequest) (*model.Webhook, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to create webhook")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.CreateWebhook,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := r.webhookService.CreateWebhook(ctx, projectID, request, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// UpdateWebhook is the resolver for the updateWebhook field.
+func (r *mutationResolver) UpdateWebhook(ctx context.Context, projectID string, request model.UpdateWebhookRequest) (*model.Webhook, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"webhookId": request.WebhookID,
+	}
+	logrus.WithFields(logFields).Info("request received to update webhook")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.UpdateWebhook,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := r.webhookService.UpdateWebhook(ctx, projectID, request, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// DeleteWebhook is the resolver for the deleteWebhook field.
+func (r *mutationResolver) DeleteWebhook(ctx context.Context, projectID string, webhookID string) (bool, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"webhookId": webhookID,
+	}
+	logrus.WithFields(logFields).Info("request received to delete webhook")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.DeleteWebhook,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return false, err
+	}
+
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return false, err
+	}
+
+	deleted, err := r.webhookService.DeleteWebhook(ctx, projectID, webhookID, username)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return false, err
+	}
+	return deleted, nil
+}
+
+// GetWebhook is the resolver for the getWebhook field.
+func (r *queryResolver) GetWebhook(ctx context.Context, projectID string, webhookID string) (*model.Webhook, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"webhookId": webhookID,
+	}
+	logrus.WithFields(logFields).Info("request received to get webhook")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetWebhook,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	webhook, err := r.webhookService.GetWebhook(ctx, projectID, webhookID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return webhook, nil
+}
+
+// ListWebhooks is the resolver for the listWebhooks field.
+func (r *queryResolver) ListWebhooks(ctx context.Context, projectID string) ([]*model.Webhook, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+	}
+	logrus.WithFields(logFields).Info("request received to list webhooks")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.ListWebhooks,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	webhooks, err := r.webhookService.ListWebhooks(ctx, projectID)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return webhooks, nil
+}
+
+// ListWebhookDeliveries is the resolver for the listWebhookDeliveries field.
+func (r *queryResolver) ListWebhookDeliveries(ctx context.Context, projectID string, webhookID string, limit *int) ([]*model.WebhookDelivery, error) {
+	logFields := logrus.Fields{
+		"projectId": projectID,
+		"webhookId": webhookID,
+	}
+	logrus.WithFields(logFields).Info("request received to list webhook deliveries")
+	err := authorization.ValidateRole(ctx, projectID,
+		authorization.GetWebhook,
+		model.InvitationAccepted.String())
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries, err := r.webhookService.ListWebhookDeliveries(ctx, projectID, webhookID, limit)
+	if err != nil {
+		logrus.WithFields(logFields).Error(err)
+		return nil, err
+	}
+	return deliveries, nil
+}
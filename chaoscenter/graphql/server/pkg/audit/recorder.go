@@ -0,0 +1,99 @@
+package audit
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/authConfig"
+)
+
+// Recorder is a gqlgen extension recording an audit event for every mutation
+type Recorder struct {
+	Service Service
+}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = Recorder{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (Recorder) ExtensionName() string {
+	return "AuditRecorder"
+}
+
+// Validate implements graphql.HandlerExtension
+func (Recorder) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptField records the mutations along with the summary of the changes of their target resource, the
+// mutations sent by the subscribers of the infras are recorded without the summary to keep their streams cheap
+func (r Recorder) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver || fc.Object != "Mutation" {
+		return next(ctx)
+	}
+
+	arguments := getResourceArguments(fc.Args)
+	event := dbAudit.AuditEvent{
+		AuditID:     uuid.NewString(),
+		ProjectID:   getProjectID(fc.Args),
+		Source:      model.AuditEventSourceGraphql,
+		Action:      fc.Field.Name,
+		ResourceIDs: getResourceIDs(arguments),
+		CreatedAt:   time.Now().UnixMilli(),
+	}
+	if sourceIP, ok := ctx.Value(authorization.ClientIP).(string); ok {
+		event.SourceIP = sourceIP
+	}
+
+	var resource *Resource
+	if token, ok := ctx.Value(authorization.AuthKey).(string); ok && token != "" {
+		event.Actor, event.ActorID = getUser(token)
+		event.IsAPIToken = mongodb.MgoClient != nil && authorization.IsApiToken(token, mongodb.MgoClient)
+	}
+	if event.Actor != "" {
+		resource = getTargetResource(event.ProjectID, arguments)
+	} else {
+		for _, argument := range arguments {
+			if argument.name == "infraID" {
+				event.Actor = "infra:" + argument.value
+				break
+			}
+		}
+	}
+
+	before := r.Service.GetResourceSummary(ctx, resource)
+	res, err := next(ctx)
+	event.Succeeded = err == nil
+	if err != nil {
+		event.Error = err.Error()
+	} else {
+		event.Before, event.After = diffSummaries(before, r.Service.GetResourceSummary(ctx, resource))
+	}
+
+	r.Service.RecordAuditEvent(event)
+	return res, err
+}
+
+// getUser returns the username and the ID of the user of the token, they are empty if the token is invalid
+func getUser(token string) (string, string) {
+	salt, err := authConfig.NewAuthConfigOperator(mongodb.Operator).GetAuthConfig(context.Background())
+	if err != nil {
+		return "", ""
+	}
+	claims, err := authorization.UserValidateJWT(token, salt.Value)
+	if err != nil {
+		return "", ""
+	}
+	username, _ := claims["username"].(string)
+	uid, _ := claims["uid"].(string)
+	return username, uid
+}
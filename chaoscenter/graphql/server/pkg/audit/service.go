@@ -0,0 +1,224 @@
+package audit
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultEventsLimit = 15
+	maxEventsLimit     = 100
+)
+
+// Service is the interface for the audit service
+type Service interface {
+	RecordAuditEvent(event dbAudit.AuditEvent)
+	GetResourceSummary(ctx context.Context, resource *Resource) map[string]interface{}
+	ListAuditEvents(ctx context.Context, projectID string, request *model.ListAuditEventsRequest) (*model.ListAuditEventsResponse, error)
+	ExportAuditEvents(ctx context.Context, projectID string, filter *model.AuditEventFilterInput) (string, error)
+}
+
+// auditService is the implementation of the audit service
+type auditService struct {
+	auditOperator   *dbAudit.Operator
+	mongodbOperator mongodb.MongoOperator
+}
+
+// NewAuditService returns a new instance of the audit service
+func NewAuditService(auditOperator *dbAudit.Operator, mongodbOperator mongodb.MongoOperator) Service {
+	return &auditService{
+		auditOperator:   auditOperator,
+		mongodbOperator: mongodbOperator,
+	}
+}
+
+// RecordAuditEvent appends the event to the audit log, the event is written even if the request
+// of the client is cancelled meanwhile
+func (a *auditService) RecordAuditEvent(event dbAudit.AuditEvent) {
+	if err := a.auditOperator.InsertAuditEvent(context.Background(), event); err != nil {
+		logrus.WithFields(logrus.Fields{
+			"auditId": event.AuditID,
+			"action":  event.Action,
+		}).WithError(err).Error("failed to record audit event")
+	}
+}
+
+// GetResourceSummary returns the summary of the current state of the resource, it is nil if the resource doesn't exist
+func (a *auditService) GetResourceSummary(ctx context.Context, resource *Resource) map[string]interface{} {
+	if resource == nil {
+		return nil
+	}
+
+	result, err := a.mongodbOperator.Get(ctx, resource.CollectionType, resource.Query)
+	if err != nil {
+		return nil
+	}
+	var document bson.M
+	if err = result.Decode(&document); err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			logrus.WithField("collection", mongodb.Collections[resource.CollectionType]).WithError(err).Error("failed to get the resource of the audit event")
+		}
+		return nil
+	}
+
+	return summarize(document)
+}
+
+// ListAuditEvents returns a page of the audit events of the project matching the filter
+func (a *auditService) ListAuditEvents(ctx context.Context, projectID string, request *model.ListAuditEventsRequest) (*model.ListAuditEventsResponse, error) {
+	var (
+		filter *model.AuditEventFilterInput
+		page   = 0
+		limit  = defaultEventsLimit
+	)
+	if request != nil {
+		filter = request.Filter
+		if request.Pagination != nil {
+			page = request.Pagination.Page
+			limit = request.Pagination.Limit
+		}
+	}
+	if page < 0 || limit <= 0 || limit > maxEventsLimit {
+		return nil, errors.New("invalid pagination, the limit should be between 1 and " + strconv.Itoa(maxEventsLimit))
+	}
+
+	query, err := buildFilterQuery(projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+
+	events, total, err := a.auditOperator.ListAuditEvents(ctx, query, int64(page*limit), int64(limit))
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.ListAuditEventsResponse{
+		TotalNumberOfEvents: int(total),
+		Events:              []*model.AuditEvent{},
+	}
+	for _, event := range events {
+		response.Events = append(response.Events, toModel(event))
+	}
+	return response, nil
+}
+
+// ExportAuditEvents returns all the audit events of the project matching the filter as JSON lines
+func (a *auditService) ExportAuditEvents(ctx context.Context, projectID string, filter *model.AuditEventFilterInput) (string, error) {
+	query, err := buildFilterQuery(projectID, filter)
+	if err != nil {
+		return "", err
+	}
+
+	cursor, err := a.auditOperator.GetAuditEventsCursor(ctx, query)
+	if err != nil {
+		return "", err
+	}
+	defer cursor.Close(ctx)
+
+	var builder strings.Builder
+	for cursor.Next(ctx) {
+		var event dbAudit.AuditEvent
+		if err = cursor.Decode(&event); err != nil {
+			return "", err
+		}
+		line, err := json.Marshal(toModel(event))
+		if err != nil {
+			return "", err
+		}
+		builder.Write(line)
+		builder.WriteByte('\n')
+	}
+	if err = cursor.Err(); err != nil {
+		return "", err
+	}
+
+	return builder.String(), nil
+}
+
+// buildFilterQuery returns the query matching the audit events of the project with the filter
+func buildFilterQuery(projectID string, filter *model.AuditEventFilterInput) (bson.D, error) {
+	query := bson.D{{"project_id", projectID}}
+	if filter == nil {
+		return query, nil
+	}
+
+	if filter.Actor != nil && *filter.Actor != "" {
+		query = append(query, bson.E{Key: "actor", Value: *filter.Actor})
+	}
+	if filter.Action != nil && *filter.Action != "" {
+		query = append(query, bson.E{Key: "action", Value: *filter.Action})
+	}
+	if filter.ResourceID != nil && *filter.ResourceID != "" {
+		query = append(query, bson.E{Key: "resource_ids", Value: *filter.ResourceID})
+	}
+	if filter.Source != nil {
+		query = append(query, bson.E{Key: "source", Value: *filter.Source})
+	}
+	if filter.DateRange != nil {
+		startDate, err := strconv.ParseInt(filter.DateRange.StartDate, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid start date: " + err.Error())
+		}
+		createdAt := bson.D{{"$gte", startDate}}
+		if filter.DateRange.EndDate != nil {
+			endDate, err := strconv.ParseInt(*filter.DateRange.EndDate, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid end date: " + err.Error())
+			}
+			createdAt = append(createdAt, bson.E{Key: "$lte", Value: endDate})
+		}
+		query = append(query, bson.E{Key: "created_at", Value: createdAt})
+	}
+
+	return query, nil
+}
+
+// toModel converts the audit event of the database to the GraphQL model
+func toModel(event dbAudit.AuditEvent) *model.AuditEvent {
+	auditEvent := &model.AuditEvent{
+		AuditID:     event.AuditID,
+		Source:      event.Source,
+		Actor:       event.Actor,
+		Action:      event.Action,
+		ResourceIDs: event.ResourceIDs,
+		SourceIP:    event.SourceIP,
+		IsAPIToken:  event.IsAPIToken,
+		Succeeded:   event.Succeeded,
+		CreatedAt:   strconv.FormatInt(event.CreatedAt, 10),
+	}
+	if auditEvent.ResourceIDs == nil {
+		auditEvent.ResourceIDs = []string{}
+	}
+	if event.ProjectID != "" {
+		auditEvent.ProjectID = &event.ProjectID
+	}
+	if event.ActorID != "" {
+		auditEvent.ActorID = &event.ActorID
+	}
+	if event.Error != "" {
+		auditEvent.Error = &event.Error
+	}
+	if event.Before != nil {
+		if before, err := json.Marshal(event.Before); err == nil {
+			summary := string(before)
+			auditEvent.Before = &summary
+		}
+	}
+	if event.After != nil {
+		if after, err := json.Marshal(event.After); err == nil {
+			summary := string(after)
+			auditEvent.After = &summary
+		}
+	}
+	return auditEvent
+}
@@ -0,0 +1,247 @@
+package audit
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// maxSummaryValueLength is the length above which the values of the resources, like the manifests of the experiments,
+// are replaced by their hash in the summaries so that the changes are still visible without copying the whole value
+const maxSummaryValueLength = 256
+
+// redactedValue replaces the passwords, tokens, keys and secrets of the resources in the summaries
+const redactedValue = "[REDACTED]"
+
+// sensitiveKeyParts are the parts of the field names whose values are redacted from the summaries
+var sensitiveKeyParts = []string{"password", "token", "secret", "access_key", "private_key"}
+
+// Resource is the resource targeted by a mutation whose state is summarized before and after the mutation
+type Resource struct {
+	CollectionType int
+	Query          bson.D
+}
+
+// snapshotResources maps the arguments identifying the resources to their collection and field, the first
+// argument found in the mutation is used as the target of the mutation
+var snapshotResources = []struct {
+	argument       string
+	collectionType int
+	field          string
+}{
+	{"experimentID", mongodb.ChaosExperimentCollection, "experiment_id"},
+	{"infraID", mongodb.ChaosInfraCollection, "infra_id"},
+	{"environmentID", mongodb.EnvironmentCollection, "environment_id"},
+	{"hubID", mongodb.ChaosHubCollection, "hub_id"},
+	{"probeName", mongodb.ChaosProbeCollection, "name"},
+	{"pipelineID", mongodb.ChaosPipelineCollection, "pipeline_id"},
+	{"webhookID", mongodb.WebhookCollection, "webhook_id"},
+	{"imageRegistryID", mongodb.ImageRegistryCollection, "image_registry_id"},
+}
+
+// resourceArgument is an argument of a mutation identifying a resource
+type resourceArgument struct {
+	name  string
+	value string
+}
+
+// getResourceArguments returns the arguments of the mutation, including the fields of its inputs, which identify
+// resources: the IDs other than the project ID and the probe names
+func getResourceArguments(args map[string]interface{}) []resourceArgument {
+	data, err := json.Marshal(args)
+	if err != nil {
+		return nil
+	}
+	var values interface{}
+	if err = json.Unmarshal(data, &values); err != nil {
+		return nil
+	}
+
+	var arguments []resourceArgument
+	var walk func(name string, value interface{})
+	walk = func(name string, value interface{}) {
+		switch v := value.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(v))
+			for key := range v {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				walk(key, v[key])
+			}
+		case []interface{}:
+			for _, item := range v {
+				walk(name, item)
+			}
+		case string:
+			if v != "" && name != "projectID" && (strings.HasSuffix(name, "ID") || strings.HasSuffix(name, "IDs") || name == "probeName") {
+				arguments = append(arguments, resourceArgument{name: name, value: v})
+			}
+		}
+	}
+	walk("", values)
+	return arguments
+}
+
+// getProjectID returns the project ID argument of the mutation, if any
+func getProjectID(args map[string]interface{}) string {
+	if projectID, ok := args["projectID"].(string); ok {
+		return projectID
+	}
+	return ""
+}
+
+// getResourceIDs returns the distinct IDs of the resources targeted by the mutation
+func getResourceIDs(arguments []resourceArgument) []string {
+	resourceIDs := []string{}
+	for _, argument := range arguments {
+		found := false
+		for _, id := range resourceIDs {
+			if id == argument.value {
+				found = true
+				break
+			}
+		}
+		if !found {
+			resourceIDs = append(resourceIDs, argument.value)
+		}
+	}
+	return resourceIDs
+}
+
+// getTargetResource returns the resource whose state is summarized for the mutation, it is nil if the
+// mutation doesn't target a single known resource
+func getTargetResource(projectID string, arguments []resourceArgument) *Resource {
+	for _, resource := range snapshotResources {
+		var values []string
+		for _, argument := range arguments {
+			if argument.name == resource.argument {
+				values = append(values, argument.value)
+			}
+		}
+		if len(values) == 0 {
+			continue
+		}
+		if len(values) > 1 {
+			return nil
+		}
+		return &Resource{
+			CollectionType: resource.collectionType,
+			Query:          bson.D{{"project_id", projectID}, {resource.field, values[0]}},
+		}
+	}
+	return nil
+}
+
+// summarize returns the summary of a document, the sensitive values are redacted and the long and nested values
+// are replaced by their hash
+func summarize(document bson.M) map[string]interface{} {
+	summary := make(map[string]interface{}, len(document))
+	for key, value := range document {
+		if key == "_id" {
+			continue
+		}
+		if isSensitiveKey(key) {
+			summary[key] = redactedValue
+			continue
+		}
+		switch v := redact(value).(type) {
+		case nil, bool, int32, int64, float64:
+			summary[key] = v
+		case string:
+			summary[key] = summarizeValue(v)
+		default:
+			data, err := bson.MarshalExtJSON(bson.M{"value": v}, false, false)
+			if err != nil {
+				continue
+			}
+			summary[key] = summarizeValue(string(data))
+		}
+	}
+	return summary
+}
+
+// isSensitiveKey checks if the values of the field are passwords, tokens, keys or secrets
+func isSensitiveKey(key string) bool {
+	lowerKey := strings.ToLower(key)
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(lowerKey, part) {
+			return true
+		}
+	}
+	return false
+}
+
+// redact returns the value with the sensitive fields of its nested documents redacted
+func redact(value interface{}) interface{} {
+	switch v := value.(type) {
+	case bson.M:
+		redacted := make(bson.M, len(v))
+		for key, item := range v {
+			if isSensitiveKey(key) {
+				redacted[key] = redactedValue
+				continue
+			}
+			redacted[key] = redact(item)
+		}
+		return redacted
+	case bson.D:
+		redacted := make(bson.D, 0, len(v))
+		for _, item := range v {
+			if isSensitiveKey(item.Key) {
+				redacted = append(redacted, bson.E{Key: item.Key, Value: redactedValue})
+				continue
+			}
+			redacted = append(redacted, bson.E{Key: item.Key, Value: redact(item.Value)})
+		}
+		return redacted
+	case bson.A:
+		redacted := make(bson.A, 0, len(v))
+		for _, item := range v {
+			redacted = append(redacted, redact(item))
+		}
+		return redacted
+	default:
+		return value
+	}
+}
+
+// summarizeValue returns the value if it is short or its hash and length otherwise
+func summarizeValue(value string) string {
+	if len(value) <= maxSummaryValueLength {
+		return value
+	}
+	return fmt.Sprintf("sha256:%x (%d bytes)", sha256.Sum256([]byte(value)), len(value))
+}
+
+// diffSummaries returns the fields of the summaries which differ, the whole summaries are returned if the resource
+// was created or removed by the mutation
+func diffSummaries(before, after map[string]interface{}) (map[string]interface{}, map[string]interface{}) {
+	if before == nil || after == nil {
+		return before, after
+	}
+
+	changedBefore := map[string]interface{}{}
+	changedAfter := map[string]interface{}{}
+	for key, value := range before {
+		if afterValue, ok := after[key]; !ok || !reflect.DeepEqual(value, afterValue) {
+			changedBefore[key] = value
+		}
+	}
+	for key, value := range after {
+		if beforeValue, ok := before[key]; !ok || !reflect.DeepEqual(value, beforeValue) {
+			changedAfter[key] = value
+		}
+	}
+	if len(changedBefore) == 0 && len(changedAfter) == 0 {
+		return nil, nil
+	}
+	return changedBefore, changedAfter
+}
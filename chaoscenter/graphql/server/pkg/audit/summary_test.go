@@ -0,0 +1,120 @@
+package audit
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"github.com/stretchr/testify/assert"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestGetResourceArguments(t *testing.T) {
+	args := map[string]interface{}{
+		"projectID": "project",
+		"request": model.UpdateWebhookRequest{
+			WebhookID: "webhook",
+			Name:      "name",
+			URL:       "https://example.com",
+			Filter: &model.WebhookFilterInput{
+				EnvironmentIDs: []string{"env-1", "env-2"},
+			},
+		},
+	}
+
+	arguments := getResourceArguments(args)
+	assert.Equal(t, "project", getProjectID(args))
+	assert.Equal(t, []string{"env-1", "env-2", "webhook"}, getResourceIDs(arguments))
+
+	resource := getTargetResource("project", arguments)
+	if assert.NotNil(t, resource) {
+		assert.Equal(t, mongodb.WebhookCollection, resource.CollectionType)
+		assert.Equal(t, bson.D{{"project_id", "project"}, {"webhook_id", "webhook"}}, resource.Query)
+	}
+
+	assert.Nil(t, getTargetResource("project", getResourceArguments(map[string]interface{}{
+		"projectID":     "project",
+		"experimentIDs": []string{"experiment-1", "experiment-2"},
+	})))
+}
+
+func TestSummarize(t *testing.T) {
+	summary := summarize(bson.M{
+		"_id":           "object-id",
+		"name":          "experiment",
+		"is_removed":    false,
+		"updated_at":    int64(1700000000000),
+		"manifest":      strings.Repeat("a", maxSummaryValueLength+1),
+		"experiment_id": "experiment",
+	})
+
+	assert.NotContains(t, summary, "_id")
+	assert.Equal(t, "experiment", summary["name"])
+	assert.Equal(t, false, summary["is_removed"])
+	assert.Equal(t, int64(1700000000000), summary["updated_at"])
+	assert.True(t, strings.HasPrefix(summary["manifest"].(string), "sha256:"))
+	assert.True(t, strings.HasSuffix(summary["manifest"].(string), "(257 bytes)"))
+}
+
+func TestSummarize_RedactsSecrets(t *testing.T) {
+	summary := summarize(bson.M{
+		"name":            "hub",
+		"secret":          "webhook-secret",
+		"access_key":      "infra-access-key",
+		"token":           "infra-token",
+		"password":        "hub-password",
+		"ssh_private_key": "hub-private-key",
+		"auth":            bson.M{"token": "nested-token", "user_name": "admin"},
+		"headers":         bson.A{bson.D{{"key", "Authorization"}, {"secret_value", "header-secret"}}},
+	})
+
+	assert.Equal(t, "hub", summary["name"])
+	for _, key := range []string{"secret", "access_key", "token", "password", "ssh_private_key"} {
+		assert.Equal(t, redactedValue, summary[key], key)
+	}
+	for _, value := range []string{"nested-token", "header-secret"} {
+		assert.NotContains(t, summary["auth"], value)
+		assert.NotContains(t, summary["headers"], value)
+	}
+	assert.Contains(t, summary["auth"], "admin")
+}
+
+func TestDiffSummaries(t *testing.T) {
+	before := map[string]interface{}{"name": "experiment", "cron_syntax": "* * * * *", "is_removed": false}
+	after := map[string]interface{}{"name": "experiment", "cron_syntax": "", "is_removed": false, "updated_by": "admin"}
+
+	changedBefore, changedAfter := diffSummaries(before, after)
+	assert.Equal(t, map[string]interface{}{"cron_syntax": "* * * * *"}, changedBefore)
+	assert.Equal(t, map[string]interface{}{"cron_syntax": "", "updated_by": "admin"}, changedAfter)
+
+	changedBefore, changedAfter = diffSummaries(before, before)
+	assert.Nil(t, changedBefore)
+	assert.Nil(t, changedAfter)
+
+	changedBefore, changedAfter = diffSummaries(nil, after)
+	assert.Nil(t, changedBefore)
+	assert.Equal(t, after, changedAfter)
+}
+
+func TestBuildFilterQuery(t *testing.T) {
+	actor, resourceID, endDate := "admin", "experiment", "2000"
+	source := model.AuditEventSourceGraphql
+	query, err := buildFilterQuery("project", &model.AuditEventFilterInput{
+		Actor:      &actor,
+		ResourceID: &resourceID,
+		Source:     &source,
+		DateRange:  &model.DateRange{StartDate: "1000", EndDate: &endDate},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, bson.D{
+		{"project_id", "project"},
+		{"actor", "admin"},
+		{"resource_ids", "experiment"},
+		{"source", model.AuditEventSourceGraphql},
+		{"created_at", bson.D{{"$gte", int64(1000)}, {"$lte", int64(2000)}}},
+	}, query)
+
+	_, err = buildFilterQuery("project", &model.AuditEventFilterInput{DateRange: &model.DateRange{StartDate: "yesterday"}})
+	assert.Error(t, err)
+}
@@ -15,6 +15,7 @@ type contextKey string
 const (
 	AuthKey      = contextKey("authorization")
 	UserClaim    = contextKey("user-claims")
+	ClientIP     = contextKey("client-ip")
 	BearerSchema = "Bearer "
 	CookieName   = "token"
 )
@@ -36,6 +37,7 @@ func Middleware(handler http.Handler, mongoClient *mongo.Client) gin.HandlerFunc
 		}
 
 		ctx := context.WithValue(c.Request.Context(), AuthKey, jwt)
+		ctx = context.WithValue(ctx, ClientIP, c.ClientIP())
 		ctx1 := context.WithValue(ctx, "request-header", c.Request.Header)
 		c.Request = c.Request.WithContext(ctx1)
 		handler.ServeHTTP(c.Writer, c.Request)
@@ -52,3 +54,14 @@ func IsRevokedToken(tokenString string, mongoClient *mongo.Client) bool {
 	}
 	return true
 }
+
+// IsApiToken checks if the given JWT Token is an API token of a user
+func IsApiToken(tokenString string, mongoClient *mongo.Client) bool {
+	collection := mongoClient.Database("auth").Collection("api-token")
+	if err := collection.FindOne(context.Background(), bson.M{
+		"token": tokenString,
+	}).Err(); err != nil {
+		return false
+	}
+	return true
+}
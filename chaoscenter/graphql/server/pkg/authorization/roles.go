@@ -15,6 +15,7 @@ const (
 	DeleteInfrastructures RoleQuery = "DeleteInfrastructures"
 	GetManifest           RoleQuery = "GetManifest"
 	GetInfraDetails       RoleQuery = "GetInfraDetails"
+	UpdateInfraRunLimit   RoleQuery = "UpdateInfraRunLimit"
 
 	// Chaos_Experiment
 	CreateChaosExperiment RoleQuery = "CreateChaosExperiment"
@@ -55,6 +56,38 @@ const (
 	GetEnvironment    RoleQuery = "GetEnvironment"
 	ListEnvironments  RoleQuery = "ListEnvironments"
 
+	// Chaos_Pipeline
+	CreateChaosPipeline RoleQuery = "CreateChaosPipeline"
+	UpdateChaosPipeline RoleQuery = "UpdateChaosPipeline"
+	DeleteChaosPipeline RoleQuery = "DeleteChaosPipeline"
+	RunChaosPipeline    RoleQuery = "RunChaosPipeline"
+	GetChaosPipeline    RoleQuery = "GetChaosPipeline"
+	ListChaosPipelines  RoleQuery = "ListChaosPipelines"
+
+	// Webhook
+	CreateWebhook RoleQuery = "CreateWebhook"
+	UpdateWebhook RoleQuery = "UpdateWebhook"
+	DeleteWebhook RoleQuery = "DeleteWebhook"
+	GetWebhook    RoleQuery = "GetWebhook"
+	ListWebhooks  RoleQuery = "ListWebhooks"
+
+	// Audit
+	ListAuditEvents   RoleQuery = "ListAuditEvents"
+	ExportAuditEvents RoleQuery = "ExportAuditEvents"
+
+	// Run retention
+	GetRunRetentionPolicy    RoleQuery = "GetRunRetentionPolicy"
+	UpdateRunRetentionPolicy RoleQuery = "UpdateRunRetentionPolicy"
+	ResetRunRetentionPolicy  RoleQuery = "ResetRunRetentionPolicy"
+
+	// Fault results
+	ListFaultResults  RoleQuery = "ListFaultResults"
+	GetFaultAnalytics RoleQuery = "GetFaultAnalytics"
+
+	// Run approval
+	ListRunApprovals  RoleQuery = "ListRunApprovals"
+	DecideRunApproval RoleQuery = "DecideRunApproval"
+
 	// Probe
 	AddProbe                 RoleQuery = "AddProbe"
 	DeleteProbe              RoleQuery = "DeleteProbe"
@@ -75,6 +108,7 @@ var MutationRbacRules = map[RoleQuery][]string{
 	AddChaosHub:           {MemberRoleOwnerString},
 	UpdateChaosExperiment: {MemberRoleOwnerString},
 	DeleteInfrastructures: {MemberRoleOwnerString},
+	UpdateInfraRunLimit:   {MemberRoleOwnerString},
 	UpdateChaosHub:        {MemberRoleOwnerString},
 	DeleteChaosHub:        {MemberRoleOwnerString},
 	EnableGitOps:          {MemberRoleOwnerString},
@@ -105,4 +139,27 @@ var MutationRbacRules = map[RoleQuery][]string{
 	GetProbe:              {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
 	ListProbes:            {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
 	DeleteProbe:           {MemberRoleOwnerString},
+	CreateChaosPipeline:   {MemberRoleOwnerString},
+	UpdateChaosPipeline:   {MemberRoleOwnerString},
+	DeleteChaosPipeline:   {MemberRoleOwnerString},
+	RunChaosPipeline:      {MemberRoleOwnerString, MemberRoleExecutorString},
+	GetChaosPipeline:      {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
+	ListChaosPipelines:    {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
+	CreateWebhook:         {MemberRoleOwnerString},
+	UpdateWebhook:         {MemberRoleOwnerString},
+	DeleteWebhook:         {MemberRoleOwnerString},
+	GetWebhook:            {MemberRoleOwnerString},
+	ListWebhooks:          {MemberRoleOwnerString},
+	ListAuditEvents:       {MemberRoleOwnerString},
+	ExportAuditEvents:     {MemberRoleOwnerString},
+
+	GetRunRetentionPolicy:    {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
+	UpdateRunRetentionPolicy: {MemberRoleOwnerString},
+	ResetRunRetentionPolicy:  {MemberRoleOwnerString},
+
+	ListFaultResults:  {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
+	GetFaultAnalytics: {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
+
+	ListRunApprovals:  {MemberRoleOwnerString, MemberRoleExecutorString, MemberRoleViewerString},
+	DecideRunApproval: {MemberRoleOwnerString},
 }
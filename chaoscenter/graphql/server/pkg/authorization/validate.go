@@ -10,16 +10,31 @@ import (
 	grpc2 "google.golang.org/grpc"
 )
 
-// ValidateRole Validates the role of a user in a given project
+// EnvironmentScope is the chaos environment an action is performed in, the custom roles
+// scoped to environments only grant their actions within the matching environments
+type EnvironmentScope struct {
+	EnvironmentID   string
+	EnvironmentType string
+}
+
+// ValidateRole Validates the role of a user in a given project, the action is granted either
+// by the built-in role of the user or by one of the custom roles of the user in the project
 func ValidateRole(ctx context.Context, projectID string,
-	requiredRoles []string, invitation string) error {
+	action RoleQuery, invitation string) error {
+	return ValidateEnvironmentRole(ctx, projectID, action, EnvironmentScope{}, invitation)
+}
+
+// ValidateEnvironmentRole Validates the role of a user in a given project for an action
+// performed in the chaos environment of the scope
+func ValidateEnvironmentRole(ctx context.Context, projectID string,
+	action RoleQuery, scope EnvironmentScope, invitation string) error {
 	jwt := ctx.Value(AuthKey).(string)
 	var conn *grpc2.ClientConn
 	client, conn := grpc.GetAuthGRPCSvcClient(conn)
 	defer conn.Close()
-	err := grpc.ValidatorGRPCRequest(client, jwt, projectID,
-		requiredRoles,
-		invitation)
+	_, err := grpc.ValidatorGRPCRequest(ctx, client, jwt, projectID,
+		MutationRbacRules[action],
+		invitation, string(action), scope.EnvironmentID, scope.EnvironmentType)
 	if err != nil {
 		logrus.Error(err)
 		return errors.New("permission_denied: " + err.Error())
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	"github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// ApprovalRequester requests the approval of an action on an experiment if the environment of its infra requires one
+type ApprovalRequester interface {
+	RequestApproval(ctx context.Context, projectID string, experiment dbChaosExperiment.ChaosExperimentRequest, action model.RunApprovalAction, inputs []*model.ExperimentInputValueRequest, priority *int, username string) (*model.RunApprovalRequest, error)
+}
+
+// SetApprovalRequester sets the requester of the approvals needed to enable the schedule of cron experiments
+func (c *ChaosExperimentHandler) SetApprovalRequester(requester ApprovalRequester) {
+	c.approvalRequester = requester
+}
+
+// suspendGatedCronSchedule suspends the schedule in the manifest of a cron experiment if the request would enable it
+// while the environment of the infra requires an approval to do so, a schedule which is already enabled is left as
+// is. It returns whether the schedule was suspended
+func (c *ChaosExperimentHandler) suspendGatedCronSchedule(ctx context.Context, projectID string, request *model.ChaosExperimentRequest, wfType *dbChaosExperiment.ChaosExperimentType) (bool, error) {
+	if wfType == nil || *wfType != dbChaosExperiment.CronExperiment || gjson.Get(request.ExperimentManifest, "spec.suspend").Bool() {
+		return false, nil
+	}
+
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", request.ExperimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil && err != mongo.ErrNoDocuments {
+		return false, err
+	}
+	if err == nil && experiment.ExperimentType == dbChaosExperiment.CronExperiment && len(experiment.Revision) > 0 &&
+		!gjson.Get(experiment.Revision[len(experiment.Revision)-1].ExperimentManifest, "spec.suspend").Bool() {
+		return false, nil
+	}
+
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(request.InfraID)
+	if err != nil {
+		return false, fmt.Errorf("failed to get infra for infraID: %s, error: %v", request.InfraID, err)
+	}
+	required, err := envHandler.InfraRequiresRunApproval(ctx, c.mongodbOperator, infra)
+	if err != nil || !required {
+		return false, err
+	}
+
+	request.ExperimentManifest, err = sjson.Set(request.ExperimentManifest, "spec.suspend", true)
+	if err != nil {
+		return false, fmt.Errorf("failed to suspend the schedule in manifest, error: %v", err)
+	}
+	return true, nil
+}
+
+// requestCronScheduleApproval requests the approval to enable the suspended schedule of the cron experiment
+func (c *ChaosExperimentHandler) requestCronScheduleApproval(ctx context.Context, projectID string, experimentID string, username string) error {
+	if c.approvalRequester == nil {
+		return errors.New("no approval requester is set to enable the schedule of the cron experiment")
+	}
+
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return fmt.Errorf("could not get experiment %s, error: %v", experimentID, err)
+	}
+
+	approval, err := c.approvalRequester.RequestApproval(ctx, projectID, experiment, model.RunApprovalActionEnableCron, nil, nil, username)
+	if err != nil {
+		return err
+	}
+	if approval != nil {
+		logrus.WithFields(logrus.Fields{
+			"projectId":         projectID,
+			"chaosExperimentId": experimentID,
+			"approvalId":        approval.ApprovalID,
+		}).Info("schedule of the cron experiment is suspended until it is approved")
+	}
+	return nil
+}
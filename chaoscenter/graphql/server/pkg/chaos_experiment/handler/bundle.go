@@ -0,0 +1,708 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	types "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
+	dbSchemaProbe "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/probe"
+	probeUtils "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/probe/utils"
+	"github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	"github.com/tidwall/sjson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// maxProbeRenameAttempts is the number of suffixes tried while looking for a unique probe name during import
+	maxProbeRenameAttempts = 100
+
+	// maxBundleFileSize is the maximum size of a file extracted from an experiment bundle archive
+	maxBundleFileSize = 10 << 20
+
+	// The experiment bundle archive contains the details of the bundle, the manifest of the experiment
+	// and a file per probe referenced by the manifest
+	bundleDetailsFile  = "bundle.json"
+	bundleManifestFile = "experiment.yaml"
+	bundleProbesDir    = "probes/"
+)
+
+// ExportExperimentBundle returns a portable bundle of the experiment as a base64 encoded zip archive containing the manifest
+// of the current revision, the weightages, the probes referenced by the manifest and the chaos hubs providing its faults
+func (c *ChaosExperimentHandler) ExportExperimentBundle(ctx context.Context, projectID string, experimentID string) (string, error) {
+	experiment, err := c.getExperimentWithRevisions(ctx, projectID, experimentID)
+	if err != nil {
+		return "", err
+	}
+	revision := experiment.Revision[len(experiment.Revision)-1]
+
+	engines, err := getChaosEngines(revision.ExperimentManifest)
+	if err != nil {
+		return "", err
+	}
+
+	var (
+		probeNames []string
+		faultNames []string
+		seenProbes = make(map[string]bool)
+		seenFaults = make(map[string]bool)
+	)
+	for _, engine := range engines {
+		probeRefs, err := getProbeReferences(engine.Annotations["probeRef"])
+		if err != nil {
+			return "", err
+		}
+		for _, probeRef := range probeRefs {
+			if !seenProbes[probeRef.Name] {
+				seenProbes[probeRef.Name] = true
+				probeNames = append(probeNames, probeRef.Name)
+			}
+		}
+		for _, fault := range engine.Spec.Experiments {
+			if !seenFaults[fault.Name] {
+				seenFaults[fault.Name] = true
+				faultNames = append(faultNames, fault.Name)
+			}
+		}
+	}
+
+	probes := []model.ProbeRequest{}
+	for _, probeName := range probeNames {
+		probe, err := c.probeService.GetProbe(ctx, probeName, projectID)
+		if err != nil {
+			return "", fmt.Errorf("failed to get probe %s, error: %v", probeName, err)
+		}
+		probeRequest, err := probeToProbeRequest(probe)
+		if err != nil {
+			return "", err
+		}
+		probes = append(probes, probeRequest)
+	}
+
+	hubReferences, err := c.getHubReferences(ctx, projectID, faultNames)
+	if err != nil {
+		return "", err
+	}
+
+	var weightages []*model.WeightagesInput
+	for _, v := range revision.Weightages {
+		weightages = append(weightages, &model.WeightagesInput{
+			FaultName: v.FaultName,
+			Weightage: v.Weightage,
+		})
+	}
+
+	bundle := types.ExperimentBundle{
+		Version:    types.ExperimentBundleVersion,
+		ExportedAt: time.Now().UnixMilli(),
+		Experiment: types.ExperimentBundleDetails{
+			Name:               experiment.Name,
+			Description:        experiment.Description,
+			Tags:               experiment.Tags,
+			CronSyntax:         experiment.CronSyntax,
+			ExperimentType:     string(experiment.ExperimentType),
+			IsCustomExperiment: experiment.IsCustomExperiment,
+			Manifest:           revision.ExperimentManifest,
+			Weightages:         weightages,
+			InfraID:            experiment.InfraID,
+		},
+		Probes:        probes,
+		HubReferences: hubReferences,
+	}
+
+	if infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(experiment.InfraID); err == nil {
+		bundle.Experiment.EnvironmentID = infra.EnvironmentID
+	}
+
+	return marshalExperimentBundle(bundle)
+}
+
+// ImportExperimentBundle creates the experiment and the probes of an experiment bundle in the project.
+// The experiment is scheduled on the given infra and probe name conflicts are resolved as requested.
+// The whole bundle is validated before anything is created, and the created probes are removed if the import fails
+func (c *ChaosExperimentHandler) ImportExperimentBundle(ctx context.Context, projectID string, request model.ImportExperimentBundleRequest, username string) (*model.ImportExperimentBundleResponse, error) {
+	bundle, err := unmarshalExperimentBundle(request.Bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(request.InfraID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get infra for infraID: %s, error: %v", request.InfraID, err)
+	}
+	if infra.ProjectID != projectID {
+		return nil, errors.New("ProjectID doesn't match with the chaos_infra identifiers")
+	}
+	environmentID, err := c.getBundleTargetEnvironment(ctx, projectID, bundle, request)
+	if err != nil {
+		return nil, err
+	}
+	if environmentID != "" && infra.EnvironmentID != environmentID {
+		return nil, fmt.Errorf("infra %s does not belong to environment %s", request.InfraID, environmentID)
+	}
+
+	experimentName := bundle.Experiment.Name
+	if request.ExperimentName != nil && *request.ExperimentName != "" {
+		experimentName = *request.ExperimentName
+	}
+	if err := c.validateDuplicateExperimentName(ctx, projectID, experimentName); err != nil {
+		return nil, err
+	}
+
+	resolution := model.ProbeConflictResolutionRename
+	if request.ProbeConflictResolution != nil {
+		resolution = *request.ProbeConflictResolution
+	}
+
+	// Resolve the names of all the probes before creating anything, so that a conflict does not leave a partial import
+	var (
+		importedProbes = []*model.ImportedProbe{}
+		renamedProbes  = make(map[string]string)
+		probesToCreate []model.ProbeRequest
+		reservedNames  = make(map[string]bool)
+	)
+	for _, probe := range bundle.Probes {
+		isUnique, err := c.probeService.ValidateUniqueProbe(ctx, probe.Name, projectID)
+		if err != nil {
+			return nil, err
+		}
+		if isUnique && !reservedNames[probe.Name] {
+			reservedNames[probe.Name] = true
+			probesToCreate = append(probesToCreate, probe)
+			importedProbes = append(importedProbes, &model.ImportedProbe{SourceName: probe.Name, Name: probe.Name})
+			continue
+		}
+
+		switch resolution {
+		case model.ProbeConflictResolutionFail:
+			return nil, fmt.Errorf("probe with name %s already exists in the project", probe.Name)
+		case model.ProbeConflictResolutionReuse:
+			existingProbe, err := c.probeService.GetProbe(ctx, probe.Name, projectID)
+			if err != nil {
+				return nil, fmt.Errorf("failed to get probe %s, error: %v", probe.Name, err)
+			}
+			if existingProbe.Type != probe.Type {
+				return nil, fmt.Errorf("existing probe %s is of type %s, bundle probe is of type %s", probe.Name, existingProbe.Type, probe.Type)
+			}
+			importedProbes = append(importedProbes, &model.ImportedProbe{SourceName: probe.Name, Name: probe.Name, IsReused: true})
+		default:
+			newName, err := c.getUniqueProbeName(ctx, probe.Name, projectID, reservedNames)
+			if err != nil {
+				return nil, err
+			}
+			reservedNames[newName] = true
+			renamedProbes[probe.Name] = newName
+
+			sourceName := probe.Name
+			probe.Name = newName
+			probesToCreate = append(probesToCreate, probe)
+			importedProbes = append(importedProbes, &model.ImportedProbe{SourceName: sourceName, Name: newName})
+		}
+	}
+
+	manifest, err := remapBundleManifest(bundle.Experiment.Manifest, experimentName, infra, renamedProbes)
+	if err != nil {
+		return nil, err
+	}
+	experimentRequest := &model.ChaosExperimentRequest{
+		ExperimentManifest:    manifest,
+		CronSyntax:            bundle.Experiment.CronSyntax,
+		ExperimentName:        experimentName,
+		ExperimentDescription: bundle.Experiment.Description,
+		Weightages:            bundle.Experiment.Weightages,
+		IsCustomExperiment:    bundle.Experiment.IsCustomExperiment,
+		InfraID:               request.InfraID,
+		Tags:                  bundle.Experiment.Tags,
+	}
+
+	// The experiment is validated with the probes of the bundle before they are created
+	validation := &experimentValidation{pendingProbes: make(map[string]bool)}
+	for _, probe := range probesToCreate {
+		validation.pendingProbes[probe.Name] = true
+	}
+	if validationResponse := c.validateExperiment(ctx, projectID, *experimentRequest, nil, validation); !validationResponse.IsValid {
+		var issues []string
+		for _, issue := range validationResponse.Errors {
+			issues = append(issues, issue.Message)
+		}
+		return nil, fmt.Errorf("invalid experiment in bundle: %s", strings.Join(issues, "; "))
+	}
+
+	missingHubReferences, err := c.getMissingHubReferences(ctx, projectID, bundle.HubReferences)
+	if err != nil {
+		return nil, err
+	}
+
+	// The probes created so far are removed if the import fails, so that a failed import can be retried as is
+	var createdProbes []string
+	for _, probe := range probesToCreate {
+		if _, err := c.probeService.AddProbe(ctx, probe, projectID); err != nil {
+			c.removeImportedProbes(ctx, projectID, createdProbes)
+			return nil, fmt.Errorf("failed to create probe %s, error: %v", probe.Name, err)
+		}
+		createdProbes = append(createdProbes, probe.Name)
+	}
+
+	experimentResponse, err := c.CreateChaosExperiment(ctx, experimentRequest, projectID, username)
+	if err != nil {
+		c.removeImportedProbes(ctx, projectID, createdProbes)
+		return nil, err
+	}
+
+	return &model.ImportExperimentBundleResponse{
+		Experiment:           experimentResponse,
+		EnvironmentID:        infra.EnvironmentID,
+		Probes:               importedProbes,
+		MissingHubReferences: missingHubReferences,
+	}, nil
+}
+
+// marshalExperimentBundle returns the experiment bundle as a base64 encoded zip archive
+func marshalExperimentBundle(bundle types.ExperimentBundle) (string, error) {
+	var buffer bytes.Buffer
+	archive := zip.NewWriter(&buffer)
+
+	addFile := func(name string, data []byte) error {
+		file, err := archive.Create(name)
+		if err != nil {
+			return err
+		}
+		_, err = file.Write(data)
+		return err
+	}
+
+	manifest, err := yaml.JSONToYAML([]byte(bundle.Experiment.Manifest))
+	if err != nil {
+		return "", fmt.Errorf("failed to convert experiment manifest to yaml, error: %v", err)
+	}
+	if err := addFile(bundleManifestFile, manifest); err != nil {
+		return "", fmt.Errorf("failed to add experiment manifest to bundle, error: %v", err)
+	}
+
+	for _, probe := range bundle.Probes {
+		probeBytes, err := json.MarshalIndent(probe, "", "  ")
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal probe %s, error: %v", probe.Name, err)
+		}
+		if err := addFile(bundleProbesDir+probe.Name+".json", probeBytes); err != nil {
+			return "", fmt.Errorf("failed to add probe %s to bundle, error: %v", probe.Name, err)
+		}
+	}
+
+	// The manifest and the probes are stored in their own files
+	bundle.Experiment.Manifest = ""
+	bundle.Probes = nil
+	details, err := json.MarshalIndent(bundle, "", "  ")
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal experiment bundle, error: %v", err)
+	}
+	if err := addFile(bundleDetailsFile, details); err != nil {
+		return "", fmt.Errorf("failed to add bundle details to bundle, error: %v", err)
+	}
+
+	if err := archive.Close(); err != nil {
+		return "", fmt.Errorf("failed to create experiment bundle, error: %v", err)
+	}
+	return base64.StdEncoding.EncodeToString(buffer.Bytes()), nil
+}
+
+// unmarshalExperimentBundle reads the experiment bundle from a base64 encoded zip archive generated by marshalExperimentBundle
+func unmarshalExperimentBundle(encodedBundle string) (types.ExperimentBundle, error) {
+	var bundle types.ExperimentBundle
+
+	data, err := base64.StdEncoding.DecodeString(encodedBundle)
+	if err != nil {
+		return bundle, fmt.Errorf("failed to decode experiment bundle, error: %v", err)
+	}
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return bundle, fmt.Errorf("failed to read experiment bundle archive, error: %v", err)
+	}
+
+	var (
+		details  []byte
+		manifest []byte
+		probes   []model.ProbeRequest
+	)
+	for _, file := range archive.File {
+		if file.FileInfo().IsDir() {
+			continue
+		}
+		content, err := readBundleFile(file)
+		if err != nil {
+			return bundle, err
+		}
+
+		switch {
+		case file.Name == bundleDetailsFile:
+			details = content
+		case file.Name == bundleManifestFile:
+			manifest = content
+		case strings.HasPrefix(file.Name, bundleProbesDir):
+			var probe model.ProbeRequest
+			if err := json.Unmarshal(content, &probe); err != nil {
+				return bundle, fmt.Errorf("failed to unmarshal probe file %s, error: %v", file.Name, err)
+			}
+			probes = append(probes, probe)
+		}
+	}
+	if details == nil || manifest == nil {
+		return bundle, fmt.Errorf("experiment bundle must contain %s and %s", bundleDetailsFile, bundleManifestFile)
+	}
+
+	if err := json.Unmarshal(details, &bundle); err != nil {
+		return bundle, fmt.Errorf("failed to unmarshal experiment bundle, error: %v", err)
+	}
+	if bundle.Version != types.ExperimentBundleVersion {
+		return bundle, fmt.Errorf("unsupported experiment bundle version: %s", bundle.Version)
+	}
+
+	manifestJSON, err := yaml.YAMLToJSON(manifest)
+	if err != nil {
+		return bundle, fmt.Errorf("failed to unmarshal experiment manifest, error: %v", err)
+	}
+	bundle.Experiment.Manifest = string(manifestJSON)
+	bundle.Probes = probes
+
+	return bundle, nil
+}
+
+// readBundleFile returns the content of a file of the experiment bundle archive
+func readBundleFile(file *zip.File) ([]byte, error) {
+	if file.UncompressedSize64 > maxBundleFileSize {
+		return nil, fmt.Errorf("file %s of the experiment bundle exceeds the maximum size", file.Name)
+	}
+	reader, err := file.Open()
+	if err != nil {
+		return nil, fmt.Errorf("failed to open file %s of the experiment bundle, error: %v", file.Name, err)
+	}
+	defer reader.Close()
+
+	content, err := io.ReadAll(io.LimitReader(reader, maxBundleFileSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file %s of the experiment bundle, error: %v", file.Name, err)
+	}
+	if len(content) > maxBundleFileSize {
+		return nil, fmt.Errorf("file %s of the experiment bundle exceeds the maximum size", file.Name)
+	}
+	return content, nil
+}
+
+// getBundleTargetEnvironment returns the environment of the project the experiment of the bundle is imported in,
+// either the requested one or the one the environment of the bundle is mapped to. It is empty if neither is given
+func (c *ChaosExperimentHandler) getBundleTargetEnvironment(ctx context.Context, projectID string, bundle types.ExperimentBundle, request model.ImportExperimentBundleRequest) (string, error) {
+	var environmentID string
+	if request.EnvironmentID != nil && *request.EnvironmentID != "" {
+		environmentID = *request.EnvironmentID
+	} else if bundle.Experiment.EnvironmentID != "" {
+		for _, mapping := range request.EnvironmentMappings {
+			if mapping.SourceEnvironmentID == bundle.Experiment.EnvironmentID {
+				environmentID = mapping.TargetEnvironmentID
+				break
+			}
+		}
+	}
+	if environmentID == "" {
+		return "", nil
+	}
+
+	env, err := environments.NewEnvironmentOperator(c.mongodbOperator).GetEnvironmentDetails(ctx, environmentID, projectID)
+	if err != nil || env.IsRemoved {
+		return "", fmt.Errorf("environment %s not found in the project", environmentID)
+	}
+	return environmentID, nil
+}
+
+// removeImportedProbes deletes the probes created by a failed import
+func (c *ChaosExperimentHandler) removeImportedProbes(ctx context.Context, projectID string, probeNames []string) {
+	if len(probeNames) == 0 {
+		return
+	}
+	err := dbSchemaProbe.NewChaosProbeOperator(c.mongodbOperator).DeleteProbes(ctx, bson.D{
+		{"project_id", projectID},
+		{"name", bson.D{{"$in", probeNames}}},
+	})
+	if err != nil {
+		logrus.WithField("projectId", projectID).Errorf("failed to remove the probes of a failed bundle import, error: %v", err)
+	}
+}
+
+// getUniqueProbeName returns the first available name of the form <name>-<n> in the project
+func (c *ChaosExperimentHandler) getUniqueProbeName(ctx context.Context, name, projectID string, reservedNames map[string]bool) (string, error) {
+	for i := 1; i <= maxProbeRenameAttempts; i++ {
+		candidate := fmt.Sprintf("%s-%d", name, i)
+		if reservedNames[candidate] {
+			continue
+		}
+		isUnique, err := c.probeService.ValidateUniqueProbe(ctx, candidate, projectID)
+		if err != nil {
+			return "", err
+		}
+		if isUnique {
+			return candidate, nil
+		}
+	}
+	return "", fmt.Errorf("failed to find a unique name for probe %s", name)
+}
+
+// getHubReferences returns the chaos hubs of the project which provide the given faults. Faults which are not found
+// in any of the connected hubs are expected to be provided by the default chaos hub
+func (c *ChaosExperimentHandler) getHubReferences(ctx context.Context, projectID string, faultNames []string) ([]types.ExperimentBundleHubReference, error) {
+	hubReferences := []types.ExperimentBundleHubReference{}
+	if len(faultNames) == 0 {
+		return hubReferences, nil
+	}
+
+	hubs, err := dbSchemaChaosHub.NewChaosHubOperator(c.mongodbOperator).GetChaosHubByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hub := range hubs {
+		var faults []string
+		for _, faultName := range faultNames {
+			matches, _ := filepath.Glob(chaoshub.DefaultPath + projectID + "/" + hub.Name + "/faults/*/" + faultName)
+			if len(matches) > 0 {
+				faults = append(faults, faultName)
+			}
+		}
+		if len(faults) > 0 {
+			hubReferences = append(hubReferences, types.ExperimentBundleHubReference{
+				HubName:    hub.Name,
+				RepoURL:    hub.RepoURL,
+				RepoBranch: hub.RepoBranch,
+				Faults:     faults,
+			})
+		}
+	}
+
+	return hubReferences, nil
+}
+
+// getMissingHubReferences returns the hub references for which no chaos hub with the same repository is connected to the project
+func (c *ChaosExperimentHandler) getMissingHubReferences(ctx context.Context, projectID string, hubReferences []types.ExperimentBundleHubReference) ([]*model.ExperimentBundleHubReference, error) {
+	missingHubReferences := []*model.ExperimentBundleHubReference{}
+	if len(hubReferences) == 0 {
+		return missingHubReferences, nil
+	}
+
+	hubs, err := dbSchemaChaosHub.NewChaosHubOperator(c.mongodbOperator).GetChaosHubByProjectID(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, hubReference := range hubReferences {
+		found := false
+		for _, hub := range hubs {
+			if hub.RepoURL == hubReference.RepoURL && hub.RepoBranch == hubReference.RepoBranch {
+				found = true
+				break
+			}
+		}
+		if !found {
+			missingHubReferences = append(missingHubReferences, &model.ExperimentBundleHubReference{
+				HubName:    hubReference.HubName,
+				RepoURL:    hubReference.RepoURL,
+				RepoBranch: hubReference.RepoBranch,
+				Faults:     hubReference.Faults,
+			})
+		}
+	}
+
+	return missingHubReferences, nil
+}
+
+// probeToProbeRequest converts a probe into the request required to create it again
+func probeToProbeRequest(probe *model.Probe) (model.ProbeRequest, error) {
+	var probeRequest model.ProbeRequest
+
+	probeBytes, err := json.Marshal(probe)
+	if err != nil {
+		return model.ProbeRequest{}, fmt.Errorf("failed to marshal probe %s, error: %v", probe.Name, err)
+	}
+	if err := json.Unmarshal(probeBytes, &probeRequest); err != nil {
+		return model.ProbeRequest{}, fmt.Errorf("failed to convert probe %s, error: %v", probe.Name, err)
+	}
+
+	return probeRequest, nil
+}
+
+// getProbeReferences parses the value of the probeRef annotation of a chaos engine
+func getProbeReferences(probeRef string) ([]dbChaosExperiment.ProbeAnnotations, error) {
+	var probeRefs []dbChaosExperiment.ProbeAnnotations
+	if probeRef == "" {
+		return probeRefs, nil
+	}
+	if err := json.Unmarshal([]byte(probeRef), &probeRefs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal probeRef annotation, error: %v", err)
+	}
+	return probeRefs, nil
+}
+
+// renameProbeReferences updates the names of the renamed probes in the value of a probeRef annotation,
+// false is returned if none of the referenced probes were renamed
+func renameProbeReferences(probeRef string, renamedProbes map[string]string) (string, bool, error) {
+	probeRefs, err := getProbeReferences(probeRef)
+	if err != nil {
+		return "", false, err
+	}
+
+	isRenamed := false
+	for i, ref := range probeRefs {
+		if newName, ok := renamedProbes[ref.Name]; ok {
+			probeRefs[i].Name = newName
+			isRenamed = true
+		}
+	}
+	if !isRenamed {
+		return probeRef, false, nil
+	}
+
+	probeRefBytes, err := json.Marshal(probeRefs)
+	if err != nil {
+		return "", false, err
+	}
+	return string(probeRefBytes), true, nil
+}
+
+// parseChaosEngine parses the raw chaos engine artifact of a workflow template
+func parseChaosEngine(data string) (*chaosTypes.ChaosEngine, bool) {
+	// This replacement is required because chaos engine yaml have a syntax template. example:{{ workflow.parameters.adminModeNamespace }}
+	data = strings.ReplaceAll(data, "{{", "")
+	data = strings.ReplaceAll(data, "}}", "")
+
+	var engine chaosTypes.ChaosEngine
+	if err := yaml.Unmarshal([]byte(data), &engine); err != nil {
+		return nil, false
+	}
+	if strings.ToLower(engine.Kind) != "chaosengine" {
+		return nil, false
+	}
+	return &engine, true
+}
+
+// getTemplatesPath returns the path of the workflow templates in the experiment manifest
+func getTemplatesPath(manifest string) string {
+	if strings.ToLower(gjson.Get(manifest, "kind").String()) == "cronworkflow" {
+		return "spec.workflowSpec.templates"
+	}
+	return "spec.templates"
+}
+
+// getChaosEngines returns the chaos engines of the experiment manifest
+func getChaosEngines(manifest string) ([]chaosTypes.ChaosEngine, error) {
+	var engines []chaosTypes.ChaosEngine
+
+	switch strings.ToLower(gjson.Get(manifest, "kind").String()) {
+	case "workflow", "cronworkflow":
+		for _, template := range gjson.Get(manifest, getTemplatesPath(manifest)).Array() {
+			for _, artifact := range template.Get("inputs.artifacts").Array() {
+				if engine, ok := parseChaosEngine(artifact.Get("raw.data").String()); ok {
+					engines = append(engines, *engine)
+				}
+			}
+		}
+	case "chaosengine":
+		var engine chaosTypes.ChaosEngine
+		if err := json.Unmarshal([]byte(manifest), &engine); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal chaosengine, error: %v", err)
+		}
+		engines = append(engines, engine)
+	}
+
+	return engines, nil
+}
+
+// remapBundleManifest updates the manifest of an experiment bundle for the target project. The experiment is renamed,
+// the namespace is set to the namespace of the target infra and the references of the renamed probes are updated
+func remapBundleManifest(manifest string, experimentName string, infra dbChaosInfra.ChaosInfra, renamedProbes map[string]string) (string, error) {
+	manifest, err := sjson.Set(manifest, "metadata.name", experimentName)
+	if err != nil {
+		return "", fmt.Errorf("failed to update experiment name in manifest, error: %v", err)
+	}
+
+	kind := strings.ToLower(gjson.Get(manifest, "kind").String())
+
+	if infra.InfraNamespace != nil && *infra.InfraNamespace != "" {
+		manifest, err = sjson.Set(manifest, "metadata.namespace", *infra.InfraNamespace)
+		if err != nil {
+			return "", fmt.Errorf("failed to update namespace in manifest, error: %v", err)
+		}
+
+		argumentsPath := "spec.arguments.parameters"
+		if kind == "cronworkflow" {
+			argumentsPath = "spec.workflowSpec.arguments.parameters"
+		}
+		for i, parameter := range gjson.Get(manifest, argumentsPath).Array() {
+			if parameter.Get("name").String() == "adminModeNamespace" {
+				manifest, err = sjson.Set(manifest, fmt.Sprintf("%s.%d.value", argumentsPath, i), *infra.InfraNamespace)
+				if err != nil {
+					return "", fmt.Errorf("failed to update namespace parameter in manifest, error: %v", err)
+				}
+			}
+		}
+	}
+
+	if len(renamedProbes) == 0 {
+		return manifest, nil
+	}
+
+	switch kind {
+	case "workflow", "cronworkflow":
+		templatesPath := getTemplatesPath(manifest)
+		for i, template := range gjson.Get(manifest, templatesPath).Array() {
+			for j, artifact := range template.Get("inputs.artifacts").Array() {
+				data := artifact.Get("raw.data").String()
+				engine, ok := parseChaosEngine(data)
+				if !ok {
+					continue
+				}
+				probeRef, isRenamed, err := renameProbeReferences(engine.Annotations["probeRef"], renamedProbes)
+				if err != nil {
+					return "", err
+				}
+				if !isRenamed {
+					continue
+				}
+				data, err = probeUtils.InsertProbeRefAnnotation(data, probeRef)
+				if err != nil {
+					return "", fmt.Errorf("failed to update probeRef annotation, error: %v", err)
+				}
+				manifest, err = sjson.Set(manifest, fmt.Sprintf("%s.%d.inputs.artifacts.%d.raw.data", templatesPath, i, j), data)
+				if err != nil {
+					return "", fmt.Errorf("failed to update chaosengine in manifest, error: %v", err)
+				}
+			}
+		}
+	case "chaosengine":
+		probeRef, isRenamed, err := renameProbeReferences(gjson.Get(manifest, "metadata.annotations.probeRef").String(), renamedProbes)
+		if err != nil {
+			return "", err
+		}
+		if isRenamed {
+			manifest, err = sjson.Set(manifest, "metadata.annotations.probeRef", probeRef)
+			if err != nil {
+				return "", fmt.Errorf("failed to update probeRef annotation, error: %v", err)
+			}
+		}
+	}
+
+	return manifest, nil
+}
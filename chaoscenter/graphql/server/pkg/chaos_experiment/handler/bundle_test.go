@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	types "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/tidwall/gjson"
+)
+
+func TestRemapBundleManifest(t *testing.T) {
+	namespace := "chaos"
+	engine := "apiVersion: litmuschaos.io/v1alpha1\nkind: ChaosEngine\nmetadata:\n  generateName: pod-delete\n  annotations:\n    probeRef: '[{\"name\":\"http-probe\",\"mode\":\"SOT\"},{\"name\":\"cmd-probe\",\"mode\":\"EOT\"}]'\nspec:\n  experiments:\n    - name: pod-delete\n"
+	engineData, _ := json.Marshal(engine)
+	manifest := `{"kind":"Workflow","metadata":{"name":"exp","namespace":"litmus"},"spec":{"arguments":{"parameters":[{"name":"adminModeNamespace","value":"litmus"}]},"templates":[{"name":"pod-delete","inputs":{"artifacts":[{"name":"pod-delete","raw":{"data":` + string(engineData) + `}}]}}]}}`
+
+	tests := []struct {
+		name          string
+		renamedProbes map[string]string
+		wantProbeRef  []string
+	}{
+		{
+			name:          "success: renamed probes are updated in the chaos engine",
+			renamedProbes: map[string]string{"http-probe": "http-probe-1"},
+			wantProbeRef:  []string{"http-probe-1", "cmd-probe"},
+		},
+		{
+			name:          "success: probe references are kept if no probe was renamed",
+			renamedProbes: map[string]string{},
+			wantProbeRef:  []string{"http-probe", "cmd-probe"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := remapBundleManifest(manifest, "imported-exp", dbChaosInfra.ChaosInfra{InfraNamespace: &namespace}, tc.renamedProbes)
+			if err != nil {
+				t.Fatalf("remapBundleManifest() error = %v", err)
+			}
+			if name := gjson.Get(got, "metadata.name").String(); name != "imported-exp" {
+				t.Errorf("remapBundleManifest() name = %v, want imported-exp", name)
+			}
+			if ns := gjson.Get(got, "metadata.namespace").String(); ns != namespace {
+				t.Errorf("remapBundleManifest() namespace = %v, want %v", ns, namespace)
+			}
+			if ns := gjson.Get(got, "spec.arguments.parameters.0.value").String(); ns != namespace {
+				t.Errorf("remapBundleManifest() adminModeNamespace = %v, want %v", ns, namespace)
+			}
+
+			engines, err := getChaosEngines(got)
+			if err != nil || len(engines) != 1 {
+				t.Fatalf("getChaosEngines() engines = %v, error = %v", len(engines), err)
+			}
+			probeRefs, err := getProbeReferences(engines[0].Annotations["probeRef"])
+			if err != nil {
+				t.Fatalf("getProbeReferences() error = %v", err)
+			}
+			if len(probeRefs) != len(tc.wantProbeRef) {
+				t.Fatalf("remapBundleManifest() probeRefs = %v, want %v", probeRefs, tc.wantProbeRef)
+			}
+			for i, probeRef := range probeRefs {
+				if probeRef.Name != tc.wantProbeRef[i] {
+					t.Errorf("remapBundleManifest() probeRef[%d] = %v, want %v", i, probeRef.Name, tc.wantProbeRef[i])
+				}
+			}
+		})
+	}
+}
+
+func TestExperimentBundleArchive(t *testing.T) {
+	bundle := types.ExperimentBundle{
+		Version: types.ExperimentBundleVersion,
+		Experiment: types.ExperimentBundleDetails{
+			Name:       "exp",
+			Manifest:   `{"kind":"Workflow","metadata":{"name":"exp"}}`,
+			Weightages: []*model.WeightagesInput{{FaultName: "pod-delete", Weightage: 10}},
+		},
+		Probes: []model.ProbeRequest{{Name: "http-probe", Type: model.ProbeTypeHTTPProbe}},
+		HubReferences: []types.ExperimentBundleHubReference{
+			{HubName: "hub", RepoURL: "https://github.com/litmuschaos/chaos-charts", RepoBranch: "master", Faults: []string{"pod-delete"}},
+		},
+	}
+
+	archive, err := marshalExperimentBundle(bundle)
+	if err != nil {
+		t.Fatalf("marshalExperimentBundle() error = %v", err)
+	}
+	if json.Valid([]byte(archive)) {
+		t.Fatalf("marshalExperimentBundle() = %v, want an archive", archive)
+	}
+
+	got, err := unmarshalExperimentBundle(archive)
+	if err != nil {
+		t.Fatalf("unmarshalExperimentBundle() error = %v", err)
+	}
+	if got.Experiment.Name != "exp" || gjson.Get(got.Experiment.Manifest, "kind").String() != "Workflow" {
+		t.Errorf("unmarshalExperimentBundle() experiment = %+v, want the exported experiment", got.Experiment)
+	}
+	if len(got.Experiment.Weightages) != 1 || got.Experiment.Weightages[0].Weightage != 10 {
+		t.Errorf("unmarshalExperimentBundle() weightages = %v, want the exported weightages", got.Experiment.Weightages)
+	}
+	if len(got.Probes) != 1 || got.Probes[0].Name != "http-probe" || got.Probes[0].Type != model.ProbeTypeHTTPProbe {
+		t.Errorf("unmarshalExperimentBundle() probes = %v, want the exported probes", got.Probes)
+	}
+	if len(got.HubReferences) != 1 || got.HubReferences[0].HubName != "hub" {
+		t.Errorf("unmarshalExperimentBundle() hub references = %v, want the exported hub references", got.HubReferences)
+	}
+
+	if _, err := unmarshalExperimentBundle(`{"version":"v1"}`); err == nil {
+		t.Errorf("unmarshalExperimentBundle() error = nil, want an error for a bundle which isn't an archive")
+	}
+}
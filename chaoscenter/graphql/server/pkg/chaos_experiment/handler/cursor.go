@@ -0,0 +1,160 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// listExperimentsByCursor returns a page of the experiments sorted by their last update time. Unlike the offset
+// pagination, the experiments of the page are matched with the indexed fields and limited before the details of
+// their infra are looked up, the filter on the status of the infra is resolved to infra IDs
+func (c *ChaosExperimentHandler) listExperimentsByCursor(ctx context.Context, projectID string, request model.ListExperimentRequest) (*model.ListExperimentResponse, error) {
+	if request.Pagination != nil {
+		return nil, errors.New("pagination and cursor can't be used together")
+	}
+	if request.Sort != nil && request.Sort.Field != model.ExperimentSortingFieldTime {
+		return nil, errors.New("experiments can only be sorted by time with cursor pagination")
+	}
+	ascending := request.Sort != nil && request.Sort.Ascending != nil && *request.Sort.Ascending
+
+	limit, err := mongodb.GetPageLimit(request.Cursor.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := c.getExperimentsCursorQuery(ctx, projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	total, err := c.chaosExperimentOperator.CountChaosExperiments(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	pageQuery := query
+	if request.Cursor.After != nil && *request.Cursor.After != "" {
+		cursor, err := mongodb.DecodePageCursor(*request.Cursor.After)
+		if err != nil {
+			return nil, err
+		}
+		pageQuery = append(pageQuery, cursor.After(ascending))
+	}
+
+	// One more experiment is fetched to know if there is a next page
+	pipeline := mongo.Pipeline{
+		{{"$match", pageQuery}},
+		{{"$sort", mongodb.PageCursorSort(ascending)}},
+		{{"$limit", limit + 1}},
+		infraDetailsLookupStage(),
+	}
+	experimentsCursor, err := c.chaosExperimentOperator.GetAggregateExperiments(pipeline)
+	if err != nil {
+		return nil, errors.New("DB aggregate stage error: " + err.Error())
+	}
+
+	var experiments []dbChaosExperiment.ChaosExperimentsWithRunDetails
+	if err = experimentsCursor.All(ctx, &experiments); err != nil {
+		return nil, errors.New("error decoding experiments cursor: " + err.Error())
+	}
+
+	response := &model.ListExperimentResponse{
+		TotalNoOfExperiments: int(total),
+	}
+	if len(experiments) > limit {
+		experiments = experiments[:limit]
+		nextCursor := mongodb.PageCursor{
+			UpdatedAt: experiments[limit-1].UpdatedAt,
+			ID:        experiments[limit-1].ID,
+		}.Encode()
+		response.NextCursor = &nextCursor
+	}
+	response.Experiments = getExperiments(experiments)
+
+	return response, nil
+}
+
+// getExperimentsCursorQuery returns the query matching the experiments of the request, the query only contains
+// fields of the experiments so that it can be served by the indexes of the collection
+func (c *ChaosExperimentHandler) getExperimentsCursorQuery(ctx context.Context, projectID string, request model.ListExperimentRequest) (bson.D, error) {
+	query := bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	if len(request.ExperimentIDs) > 0 {
+		query = append(query, bson.E{Key: "experiment_id", Value: bson.D{{"$in", request.ExperimentIDs}}})
+	}
+
+	filter := request.Filter
+	if filter == nil {
+		return query, nil
+	}
+
+	if filter.ExperimentName != nil && *filter.ExperimentName != "" {
+		query = append(query, bson.E{Key: "name", Value: bson.D{{"$regex", *filter.ExperimentName}}})
+	}
+	if filter.InfraID != nil && *filter.InfraID != "All" && *filter.InfraID != "" {
+		query = append(query, bson.E{Key: "infra_id", Value: *filter.InfraID})
+	}
+	if filter.ScheduleType != nil && *filter.ScheduleType != model.ScheduleTypeAll {
+		experimentType := ""
+		if *filter.ScheduleType == model.ScheduleTypeNonCron {
+			experimentType = string(dbChaosExperiment.NonCronExperiment)
+		} else if *filter.ScheduleType == model.ScheduleTypeCron {
+			experimentType = string(dbChaosExperiment.CronExperiment)
+		}
+		query = append(query, bson.E{Key: "experiment_type", Value: experimentType})
+	}
+	if len(filter.InfraTypes) > 0 {
+		query = append(query, bson.E{Key: "infra_type", Value: bson.D{{"$in", filter.InfraTypes}}})
+	}
+	if filter.DateRange != nil {
+		updatedAt, err := mongodb.TimestampRangeQuery(filter.DateRange.StartDate, filter.DateRange.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, bson.E{Key: "updated_at", Value: updatedAt})
+	}
+	if filter.InfraActive != nil {
+		infraIDs, err := c.getInfraIDsByStatus(ctx, projectID, *filter.InfraActive)
+		if err != nil {
+			return nil, err
+		}
+		// the infra_id condition is combined with the infra filter if both are set
+		query = append(query, bson.E{Key: "$and", Value: bson.A{
+			bson.D{{"infra_id", bson.D{{"$in", infraIDs}}}},
+		}})
+	}
+
+	return query, nil
+}
+
+// getInfraIDsByStatus returns the IDs of the infras of the project which are active or inactive
+func (c *ChaosExperimentHandler) getInfraIDsByStatus(ctx context.Context, projectID string, isActive bool) ([]string, error) {
+	opts := options.Find().SetProjection(bson.D{{"infra_id", 1}})
+	cursor, err := c.mongodbOperator.List(ctx, mongodb.ChaosInfraCollection, bson.D{
+		{"project_id", projectID},
+		{"is_active", isActive},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var infras []struct {
+		InfraID string `bson:"infra_id"`
+	}
+	if err = cursor.All(ctx, &infras); err != nil {
+		return nil, err
+	}
+	infraIDs := make([]string, 0, len(infras))
+	for _, infra := range infras {
+		infraIDs = append(infraIDs, infra.InfraID)
+	}
+	return infraIDs, nil
+}
@@ -22,6 +22,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/tidwall/gjson"
 	"github.com/tidwall/sjson"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
@@ -36,6 +37,8 @@ import (
 	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run"
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 
 	"github.com/google/uuid"
 )
@@ -50,6 +53,14 @@ type ChaosExperimentHandler struct {
 	chaosExperimentRunOperator *dbChaosExperimentRun.Operator
 	probeService               probe.Service
 	mongodbOperator            mongodb.MongoOperator
+	experimentRunListeners     []ExperimentRunListener
+	approvalRequester          ApprovalRequester
+}
+
+// ExperimentRunListener is notified once the queued experiment runs are stopped, the runs
+// sent to the infra are completed by the events of the infra instead
+type ExperimentRunListener interface {
+	ExperimentRunCompleted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, r *store.StateData)
 }
 
 // NewChaosExperimentHandler returns a new instance of ChaosWorkflowHandler
@@ -75,6 +86,11 @@ func NewChaosExperimentHandler(
 	}
 }
 
+// AddExperimentRunListener registers a listener which is notified once the queued experiment runs are stopped
+func (c *ChaosExperimentHandler) AddExperimentRunListener(listener ExperimentRunListener) {
+	c.experimentRunListeners = append(c.experimentRunListeners, listener)
+}
+
 func (c *ChaosExperimentHandler) SaveChaosExperiment(ctx context.Context, request model.SaveChaosExperimentRequest, projectID string, username string) (string, error) {
 
 	var revID = uuid.New().String()
@@ -95,13 +111,14 @@ func (c *ChaosExperimentHandler) SaveChaosExperiment(ctx context.Context, reques
 
 	// typecasting request into chaosExperimentRequest
 	chaosWfReq := model.ChaosExperimentRequest{
-		ExperimentID:          &request.ID,
-		ExperimentManifest:    request.Manifest,
-		ExperimentType:        request.Type,
-		ExperimentName:        request.Name,
-		ExperimentDescription: request.Description,
-		InfraID:               request.InfraID,
-		Tags:                  request.Tags,
+		ExperimentID:            &request.ID,
+		ExperimentManifest:      request.Manifest,
+		ExperimentType:          request.Type,
+		ExperimentName:          request.Name,
+		ExperimentDescription:   request.Description,
+		InfraID:                 request.InfraID,
+		Tags:                    request.Tags,
+		ResiliencyScoreStrategy: request.ResiliencyScoreStrategy,
 	}
 
 	newRequest, wfType, err := c.chaosExperimentService.ProcessExperiment(ctx, &chaosWfReq, projectID, revID)
@@ -109,6 +126,11 @@ func (c *ChaosExperimentHandler) SaveChaosExperiment(ctx context.Context, reques
 		return "", err
 	}
 
+	// A saved experiment isn't sent to the infra, its schedule waits for an approval once it is enabled
+	if _, err = c.suspendGatedCronSchedule(ctx, projectID, newRequest, wfType); err != nil {
+		return "", err
+	}
+
 	// Updating the existing experiment
 	if wfDetails.ExperimentID == request.ID {
 		logrus.WithFields(logFields).Info("request received to update k8s chaos experiment")
@@ -171,6 +193,11 @@ func (c *ChaosExperimentHandler) CreateChaosExperiment(ctx context.Context, requ
 		return nil, err
 	}
 
+	// The approval to enable the schedule is requested once the experiment is run
+	if _, err = c.suspendGatedCronSchedule(ctx, projectID, newRequest, wfType); err != nil {
+		return nil, err
+	}
+
 	// Gitops Update
 	err = c.gitOpsService.UpsertExperimentToGit(ctx, projectID, newRequest)
 	if err != nil {
@@ -281,6 +308,11 @@ func (c *ChaosExperimentHandler) UpdateChaosExperiment(ctx context.Context, requ
 		return nil, err
 	}
 
+	scheduleSuspended, err := c.suspendGatedCronSchedule(ctx, projectID, newRequest, wfType)
+	if err != nil {
+		return nil, err
+	}
+
 	err = c.gitOpsService.UpsertExperimentToGit(ctx, projectID, newRequest)
 	if err != nil {
 		logrus.Errorf("failed to push experiment manifest to git, err: %v", err)
@@ -292,6 +324,12 @@ func (c *ChaosExperimentHandler) UpdateChaosExperiment(ctx context.Context, requ
 		return nil, err
 	}
 
+	if scheduleSuspended {
+		if err = c.requestCronScheduleApproval(ctx, projectID, *newRequest.ExperimentID, username); err != nil {
+			return nil, err
+		}
+	}
+
 	return &model.ChaosExperimentResponse{
 		ExperimentID:          *newRequest.ExperimentID,
 		CronSyntax:            newRequest.CronSyntax,
@@ -301,6 +339,193 @@ func (c *ChaosExperimentHandler) UpdateChaosExperiment(ctx context.Context, requ
 	}, nil
 }
 
+// ListExperimentRevisions returns the revisions of the experiment, latest revision first
+func (c *ChaosExperimentHandler) ListExperimentRevisions(ctx context.Context, projectID string, experimentID string) ([]*model.ExperimentRevision, error) {
+	experiment, err := c.getExperimentWithRevisions(ctx, projectID, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRevisionID := experiment.Revision[len(experiment.Revision)-1].RevisionID
+	revisions := make([]*model.ExperimentRevision, 0, len(experiment.Revision))
+	for i := len(experiment.Revision) - 1; i >= 0; i-- {
+		revision := experiment.Revision[i]
+
+		weightages := []*model.Weightages{}
+		for _, v := range revision.Weightages {
+			weightages = append(weightages, &model.Weightages{
+				FaultName: v.FaultName,
+				Weightage: v.Weightage,
+			})
+		}
+
+		var updatedBy *model.UserDetails
+		if revision.UpdatedBy.Username != "" {
+			updatedBy = &model.UserDetails{
+				Username: revision.UpdatedBy.Username,
+				UserID:   revision.UpdatedBy.UserID,
+				Email:    revision.UpdatedBy.Email,
+			}
+		}
+
+		revisions = append(revisions, &model.ExperimentRevision{
+			RevisionID:         revision.RevisionID,
+			ExperimentManifest: revision.ExperimentManifest,
+			Weightages:         weightages,
+			UpdatedAt:          strconv.FormatInt(revision.UpdatedAt, 10),
+			UpdatedBy:          updatedBy,
+			IsCurrent:          revision.RevisionID == currentRevisionID,
+		})
+	}
+
+	return revisions, nil
+}
+
+// GetExperimentRevisionDiff returns the differences between the manifests of two revisions of the experiment
+func (c *ChaosExperimentHandler) GetExperimentRevisionDiff(ctx context.Context, projectID string, experimentID string, revisionIDA string, revisionIDB string) ([]*model.ManifestDiff, error) {
+	experiment, err := c.getExperimentWithRevisions(ctx, projectID, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	revisionA, err := getExperimentRevision(experiment, revisionIDA)
+	if err != nil {
+		return nil, err
+	}
+	revisionB, err := getExperimentRevision(experiment, revisionIDB)
+	if err != nil {
+		return nil, err
+	}
+
+	diff, err := utils.DiffManifests(revisionA.ExperimentManifest, revisionB.ExperimentManifest)
+	if err != nil {
+		return nil, err
+	}
+	if diff == nil {
+		diff = []*model.ManifestDiff{}
+	}
+
+	return diff, nil
+}
+
+// RollbackChaosExperiment makes an old revision the current revision of the experiment. The manifest of the old
+// revision is processed and stored as a new revision, the same way as an experiment update
+func (c *ChaosExperimentHandler) RollbackChaosExperiment(ctx context.Context, projectID string, experimentID string, revisionID string, r *store.StateData, username string) (*model.ChaosExperimentResponse, error) {
+	var (
+		revID = uuid.New().String()
+	)
+
+	experiment, err := c.getExperimentWithRevisions(ctx, projectID, experimentID)
+	if err != nil {
+		return nil, err
+	}
+
+	currentRevision := experiment.Revision[len(experiment.Revision)-1]
+	if currentRevision.RevisionID == revisionID {
+		return nil, fmt.Errorf("revision %s is already the current revision of the experiment", revisionID)
+	}
+
+	revision, err := getExperimentRevision(experiment, revisionID)
+	if err != nil {
+		return nil, err
+	}
+
+	// The experiment might have been renamed after the revision was created
+	manifest, err := sjson.Set(revision.ExperimentManifest, "metadata.name", experiment.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update experiment name in manifest, error: %v", err)
+	}
+
+	// Rollback must not enable or disable the schedule of a cron experiment
+	if experiment.ExperimentType == dbChaosExperiment.CronExperiment {
+		manifest, err = sjson.Set(manifest, "spec.suspend", gjson.Get(currentRevision.ExperimentManifest, "spec.suspend").Bool())
+		if err != nil {
+			return nil, fmt.Errorf("failed to update schedule state in manifest, error: %v", err)
+		}
+	}
+
+	weightages := []*model.WeightagesInput{}
+	for _, v := range revision.Weightages {
+		weightages = append(weightages, &model.WeightagesInput{
+			FaultName: v.FaultName,
+			Weightage: v.Weightage,
+		})
+	}
+
+	request := model.ChaosExperimentRequest{
+		ExperimentID:          &experiment.ExperimentID,
+		ExperimentManifest:    manifest,
+		CronSyntax:            experiment.CronSyntax,
+		ExperimentName:        experiment.Name,
+		ExperimentDescription: experiment.Description,
+		Weightages:            weightages,
+		IsCustomExperiment:    experiment.IsCustomExperiment,
+		InfraID:               experiment.InfraID,
+		Tags:                  experiment.Tags,
+	}
+
+	newRequest, wfType, err := c.chaosExperimentService.ProcessExperiment(ctx, &request, projectID, revID)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduleSuspended, err := c.suspendGatedCronSchedule(ctx, projectID, newRequest, wfType)
+	if err != nil {
+		return nil, err
+	}
+
+	err = c.gitOpsService.UpsertExperimentToGit(ctx, projectID, newRequest)
+	if err != nil {
+		logrus.Errorf("failed to push experiment manifest to git, err: %v", err)
+		return nil, err
+	}
+
+	err = c.chaosExperimentService.ProcessExperimentUpdate(newRequest, username, wfType, revID, false, projectID, r)
+	if err != nil {
+		return nil, err
+	}
+
+	if scheduleSuspended {
+		if err = c.requestCronScheduleApproval(ctx, projectID, *newRequest.ExperimentID, username); err != nil {
+			return nil, err
+		}
+	}
+
+	return &model.ChaosExperimentResponse{
+		ExperimentID:          *newRequest.ExperimentID,
+		CronSyntax:            newRequest.CronSyntax,
+		ExperimentName:        newRequest.ExperimentName,
+		ExperimentDescription: newRequest.ExperimentDescription,
+		IsCustomExperiment:    newRequest.IsCustomExperiment,
+	}, nil
+}
+
+func (c *ChaosExperimentHandler) getExperimentWithRevisions(ctx context.Context, projectID string, experimentID string) (dbChaosExperiment.ChaosExperimentRequest, error) {
+	query := bson.D{
+		{"experiment_id", experimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, query)
+	if err != nil {
+		return dbChaosExperiment.ChaosExperimentRequest{}, fmt.Errorf("could not get experiment, error: %v", err)
+	}
+	if len(experiment.Revision) == 0 {
+		return dbChaosExperiment.ChaosExperimentRequest{}, fmt.Errorf("no revisions found")
+	}
+
+	return experiment, nil
+}
+
+func getExperimentRevision(experiment dbChaosExperiment.ChaosExperimentRequest, revisionID string) (dbChaosExperiment.ExperimentRevision, error) {
+	for _, revision := range experiment.Revision {
+		if revision.RevisionID == revisionID {
+			return revision, nil
+		}
+	}
+	return dbChaosExperiment.ExperimentRevision{}, fmt.Errorf("revision %s not found for experiment %s", revisionID, experiment.ExperimentID)
+}
+
 // GetExperiment returns details of the requested experiment
 func (c *ChaosExperimentHandler) GetExperiment(ctx context.Context, projectID string, experimentID string) (*model.GetExperimentResponse, error) {
 	var pipeline mongo.Pipeline
@@ -460,6 +685,12 @@ func (c *ChaosExperimentHandler) GetExperiment(ctx context.Context, projectID st
 		}
 	}
 
+	inputs, err := utils.GetExperimentInputs(exp.Revision[len(exp.Revision)-1].ExperimentManifest)
+	if err != nil {
+		return nil, err
+	}
+	resiliencyScoreStrategy, _ := chaosExperimentRun.GetResiliencyScoreStrategy(exp.ResiliencyScoreStrategy)
+
 	var avg float64
 	// Truncating score to 2 decimal places
 	if len(exp.AvgResiliencyScore) > 0 {
@@ -487,6 +718,8 @@ func (c *ChaosExperimentHandler) GetExperiment(ctx context.Context, projectID st
 				Username: exp.UpdatedBy.Username,
 			},
 			RecentExperimentRunDetails: recentExpRuns,
+			Inputs:                     inputs,
+			ResiliencyScoreStrategy:    resiliencyScoreStrategy,
 		},
 		AverageResiliencyScore: &avg,
 	}
@@ -497,6 +730,10 @@ func (c *ChaosExperimentHandler) GetExperiment(ctx context.Context, projectID st
 
 // ListExperiment returns all the workflows for matching identifiers from the DB
 func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.ListExperimentRequest) (*model.ListExperimentResponse, error) {
+	if request.Cursor != nil {
+		return c.listExperimentsByCursor(context.Background(), projectID, request)
+	}
+
 	var pipeline mongo.Pipeline
 
 	// Match the workflowIDs from the input array
@@ -610,35 +847,7 @@ func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.
 		}
 	}
 
-	// fetchKubernetesInfraDetailsStage adds infra details of corresponding experiment_id to each document
-	fetchKubernetesInfraDetailsStage := bson.D{
-		{"$lookup", bson.D{
-			{"from", "chaosInfrastructures"},
-			{"let", bson.M{"infraID": "$infra_id"}},
-			{
-				"pipeline", bson.A{
-					bson.D{
-						{"$match", bson.D{
-							{"$expr", bson.D{
-								{"$eq", bson.A{"$infra_id", "$$infraID"}},
-							}},
-						}},
-					},
-					bson.D{
-						{"$project", bson.D{
-							{"token", 0},
-							{"infra_ns_exists", 0},
-							{"infra_sa_exists", 0},
-							{"access_key", 0},
-						}},
-					},
-				},
-			},
-			{"as", "kubernetesInfraDetails"},
-		}},
-	}
-
-	pipeline = append(pipeline, fetchKubernetesInfraDetailsStage)
+	pipeline = append(pipeline, infraDetailsLookupStage())
 
 	if request.Filter != nil && request.Filter.InfraActive != nil {
 		filterInfraStatusStage := bson.D{
@@ -767,7 +976,54 @@ func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.
 		}, nil
 	}
 
-	for _, workflow := range workflows[0].ScheduledExperiments {
+	result = getExperiments(workflows[0].ScheduledExperiments)
+
+	totalFilteredExperimentsCounter := 0
+	if len(workflows) > 0 && len(workflows[0].TotalFilteredExperiments) > 0 {
+		totalFilteredExperimentsCounter = workflows[0].TotalFilteredExperiments[0].Count
+	}
+
+	output := model.ListExperimentResponse{
+		TotalNoOfExperiments: totalFilteredExperimentsCounter,
+		Experiments:          result,
+	}
+	return &output, nil
+}
+
+// infraDetailsLookupStage adds the details of its infra to each experiment, the secrets of the infra are excluded
+func infraDetailsLookupStage() bson.D {
+	return bson.D{
+		{"$lookup", bson.D{
+			{"from", "chaosInfrastructures"},
+			{"let", bson.M{"infraID": "$infra_id"}},
+			{
+				"pipeline", bson.A{
+					bson.D{
+						{"$match", bson.D{
+							{"$expr", bson.D{
+								{"$eq", bson.A{"$infra_id", "$$infraID"}},
+							}},
+						}},
+					},
+					bson.D{
+						{"$project", bson.D{
+							{"token", 0},
+							{"infra_ns_exists", 0},
+							{"infra_sa_exists", 0},
+							{"access_key", 0},
+						}},
+					},
+				},
+			},
+			{"as", "kubernetesInfraDetails"},
+		}},
+	}
+}
+
+// getExperiments converts the experiments with the details of their infra to the GraphQL model
+func getExperiments(experiments []dbChaosExperiment.ChaosExperimentsWithRunDetails) []*model.Experiment {
+	var result []*model.Experiment
+	for _, workflow := range experiments {
 		var chaosInfrastructure *model.Infra
 
 		if len(workflow.KubernetesInfraDetails) > 0 {
@@ -823,6 +1079,12 @@ func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.
 			}
 		}
 
+		inputs, err := utils.GetExperimentInputs(workflow.Revision[len(workflow.Revision)-1].ExperimentManifest)
+		if err != nil {
+			logrus.Errorf("failed to get inputs of experiment %s, error: %v", workflow.ExperimentID, err)
+		}
+		resiliencyScoreStrategy, _ := chaosExperimentRun.GetResiliencyScoreStrategy(workflow.ResiliencyScoreStrategy)
+
 		newChaosExperiments := model.Experiment{
 			ExperimentID:       workflow.ExperimentID,
 			Name:               workflow.Name,
@@ -844,21 +1106,13 @@ func (c *ChaosExperimentHandler) ListExperiment(projectID string, request model.
 				Username: workflow.UpdatedBy.Username,
 			},
 			RecentExperimentRunDetails: recentExpRuns,
+			Inputs:                     inputs,
+			ResiliencyScoreStrategy:    resiliencyScoreStrategy,
 		}
 		result = append(result, &newChaosExperiments)
 
 	}
-
-	totalFilteredExperimentsCounter := 0
-	if len(workflows) > 0 && len(workflows[0].TotalFilteredExperiments) > 0 {
-		totalFilteredExperimentsCounter = workflows[0].TotalFilteredExperiments[0].Count
-	}
-
-	output := model.ListExperimentResponse{
-		TotalNoOfExperiments: totalFilteredExperimentsCounter,
-		Experiments:          result,
-	}
-	return &output, nil
+	return result
 }
 
 // getWfRunDetails returns details of the latest workflow run of passed workflows.
@@ -1189,87 +1443,113 @@ func (c *ChaosExperimentHandler) GetExperimentStats(ctx context.Context, project
 }
 
 // GetLogs query is used to fetch the logs from the cluster
-func (c *ChaosExperimentHandler) GetLogs(reqID string, pod model.PodLogRequest, r store.StateData) {
+func (c *ChaosExperimentHandler) GetLogs(ctx context.Context, reqID string, pod model.PodLogRequest, r store.StateData) {
 	data, err := json.Marshal(pod)
 	if err != nil {
 		logrus.Print("ERROR WHILE MARSHALLING POD DETAILS")
 	}
 	reqType := "logs"
 	externalData := string(data)
+	span, traceContext := tracing.StartSubscriberRequestSpan(ctx, pod.InfraID, reqType)
+	defer span.End()
 	payload := model.InfraActionResponse{
 		//ProjectID: reqID,
 		Action: &model.ActionPayload{
 			RequestID:    reqID,
 			RequestType:  reqType,
 			ExternalData: &externalData,
+			TraceContext: traceContext,
 		},
 	}
-	if clusterChan, ok := r.ConnectedInfra[pod.InfraID]; ok {
-		clusterChan <- &payload
-	} else if reqChan, ok := r.ExperimentLog[reqID]; ok {
-		resp := model.PodLogResponse{
+	if c.isInfraConnected(pod.InfraID, r) {
+		err = r.SendInfraAction(ctx, pod.InfraID, &payload)
+	} else {
+		err = r.PublishPodLog(ctx, reqID, &model.PodLogResponse{
 			PodName:         pod.PodName,
 			ExperimentRunID: pod.ExperimentRunID,
 			PodType:         pod.PodType,
 			Log:             "INFRA ERROR : INFRA NOT CONNECTED",
-		}
-		reqChan <- &resp
-		close(reqChan)
+		})
+	}
+	if err != nil {
+		logrus.WithField("infraID", pod.InfraID).Errorf("failed to request the pod logs, error: %v", err)
 	}
 }
 
-func (c *ChaosExperimentHandler) GetKubeObjData(reqID string, kubeObject model.KubeObjectRequest, r store.StateData) {
+func (c *ChaosExperimentHandler) GetKubeObjData(ctx context.Context, reqID string, kubeObject model.KubeObjectRequest, r store.StateData) {
 	reqType := kubeObject.ObjectType
 	data, err := json.Marshal(kubeObject)
 	if err != nil {
 		logrus.Print("ERROR WHILE MARSHALLING POD DETAILS")
 	}
 	externalData := string(data)
+	span, traceContext := tracing.StartSubscriberRequestSpan(ctx, kubeObject.InfraID, reqType)
+	defer span.End()
 	payload := model.InfraActionResponse{
 		Action: &model.ActionPayload{
 			RequestID:    reqID,
 			RequestType:  reqType,
 			ExternalData: &externalData,
+			TraceContext: traceContext,
 		},
 	}
-	if clusterChan, ok := r.ConnectedInfra[kubeObject.InfraID]; ok {
-		clusterChan <- &payload
-	} else if reqChan, ok := r.KubeObjectData[reqID]; ok {
-		resp := model.KubeObjectResponse{
+	if c.isInfraConnected(kubeObject.InfraID, r) {
+		err = r.SendInfraAction(ctx, kubeObject.InfraID, &payload)
+	} else {
+		err = r.PublishKubeObject(ctx, reqID, &model.KubeObjectResponse{
 			InfraID: kubeObject.InfraID,
 			KubeObj: &model.KubeObject{},
-		}
-		reqChan <- &resp
-		close(reqChan)
+		})
+	}
+	if err != nil {
+		logrus.WithField("infraID", kubeObject.InfraID).Errorf("failed to request the kubernetes objects, error: %v", err)
 	}
 }
 
-func (c *ChaosExperimentHandler) GetKubeNamespaceData(reqID string, kubeNamespace model.KubeNamespaceRequest, r store.StateData) {
+func (c *ChaosExperimentHandler) GetKubeNamespaceData(ctx context.Context, reqID string, kubeNamespace model.KubeNamespaceRequest, r store.StateData) {
 	reqType := "namespace"
 	data, err := json.Marshal(kubeNamespace)
 	if err != nil {
 		logrus.Print("ERROR WHILE MARSHALLING POD DETAILS")
 	}
 	externalData := string(data)
+	span, traceContext := tracing.StartSubscriberRequestSpan(ctx, kubeNamespace.InfraID, reqType)
+	defer span.End()
 	payload := model.InfraActionResponse{
 		Action: &model.ActionPayload{
 			RequestID:    reqID,
 			RequestType:  reqType,
 			ExternalData: &externalData,
+			TraceContext: traceContext,
 		},
 	}
-	if clusterChan, ok := r.ConnectedInfra[kubeNamespace.InfraID]; ok {
-		clusterChan <- &payload
-	} else if reqChan, ok := r.KubeNamespaceData[reqID]; ok {
-		resp := model.KubeNamespaceResponse{
+	if c.isInfraConnected(kubeNamespace.InfraID, r) {
+		err = r.SendInfraAction(ctx, kubeNamespace.InfraID, &payload)
+	} else {
+		err = r.PublishKubeNamespace(ctx, reqID, &model.KubeNamespaceResponse{
 			InfraID:       kubeNamespace.InfraID,
 			KubeNamespace: []*model.KubeNamespace{},
-		}
-		reqChan <- &resp
-		close(reqChan)
+		})
+	}
+	if err != nil {
+		logrus.WithField("infraID", kubeNamespace.InfraID).Errorf("failed to request the kubernetes namespaces, error: %v", err)
 	}
 }
 
+// isInfraConnected returns whether the subscriber of the infra is connected to a replica of the server,
+// the connections to the other replicas are only known through the status of the infra
+func (c *ChaosExperimentHandler) isInfraConnected(infraID string, r store.StateData) bool {
+	if r.IsInfraConnected(infraID) {
+		return true
+	}
+	if r.PubSub == nil || !r.PubSub.Distributed() {
+		return false
+	}
+
+	infra, err := c.infrastructureService.GetDBInfra(infraID)
+	return err == nil && infra.IsActive
+}
+
 func (c *ChaosExperimentHandler) GetDBExperiment(query bson.D) (dbChaosExperiment.ChaosExperimentRequest, error) {
 	experiment, err := c.chaosExperimentOperator.GetExperiment(context.Background(), query)
 	if err != nil {
@@ -1294,6 +1574,10 @@ func (c *ChaosExperimentHandler) GetProbesInExperimentRun(ctx context.Context, p
 	if err != nil {
 		return nil, err
 	}
+	runExecutionData, err := wfRun.GetExecutionData()
+	if err != nil {
+		return nil, err
+	}
 
 	for _, _probe := range wfRun.Probes {
 		if _probe.FaultName == faultName {
@@ -1304,7 +1588,7 @@ func (c *ChaosExperimentHandler) GetProbesInExperimentRun(ctx context.Context, p
 				description := "Either probe is not executed or not evaluated"
 				probeDescriptionMap[probeName] = &description
 
-				if err = json.Unmarshal([]byte(wfRun.ExecutionData), &executionData); err != nil {
+				if err = json.Unmarshal([]byte(runExecutionData), &executionData); err != nil {
 					return nil, errors.New("failed to unmarshal workflow manifest")
 				}
 
@@ -1415,6 +1699,13 @@ func (c *ChaosExperimentHandler) UpdateCronExperimentState(ctx context.Context,
 		return false, fmt.Errorf("cron experiement updation failed due to inactive infra, err: %v", err)
 	}
 
+	//Cron experiments can not be enabled during a blackout window of the infra's environment
+	if !disable {
+		if err := envHandler.ValidateInfraBlackoutWindow(ctx, c.mongodbOperator, infra); err != nil {
+			return false, err
+		}
+	}
+
 	//Validate if revisions are available
 	if len(experiment.Revision) == 0 {
 		return false, fmt.Errorf("no revisions found")
@@ -1459,7 +1750,13 @@ func (c *ChaosExperimentHandler) UpdateCronExperimentState(ctx context.Context,
 		return false, errors.New("failed to marshal workflow manifest")
 	}
 
-	cronWorkflowManifest, err = c.probeService.GenerateCronExperimentManifestWithProbes(string(updatedManifest), experiment.ProjectID)
+	//Substitute the default values of the experiment inputs
+	experimentManifest, err := utils.ApplyDefaultExperimentInputs(string(updatedManifest))
+	if err != nil {
+		return false, err
+	}
+
+	cronWorkflowManifest, err = c.probeService.GenerateCronExperimentManifestWithProbes(experimentManifest, experiment.ProjectID)
 	if err != nil {
 		return false, fmt.Errorf("failed to unmarshal experiment manifest, error: %v", err)
 	}
@@ -1470,7 +1767,7 @@ func (c *ChaosExperimentHandler) UpdateCronExperimentState(ctx context.Context,
 	}
 
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, &model.ChaosExperimentRequest{
 			ExperimentID:       &workflowID,
 			ExperimentManifest: string(updatedManifest),
 			ExperimentName:     experiment.Name,
@@ -1506,14 +1803,24 @@ func (c *ChaosExperimentHandler) StopExperimentRuns(ctx context.Context, project
 			return false, err
 		}
 
+		var queuedRuns []dbChaosExperimentRun.ChaosExperimentRun
 		for _, runs := range expRuns {
+			if runs.InQueue && !runs.Completed {
+				queuedRuns = append(queuedRuns, runs)
+				continue
+			}
 			if (runs.Phase == string(model.ExperimentRunStatusRunning) || runs.Phase == string(model.ExperimentRunStatusTimeout)) && !runs.Completed {
 				experimentRunsID = append(experimentRunsID, runs.ExperimentRunID)
 			}
 		}
 
+		// Queued runs are not sent to the infra yet, hence they are stopped without notifying the infra
+		if err := c.stopQueuedExperimentRuns(ctx, queuedRuns, username, r); err != nil {
+			return false, err
+		}
+
 		// Check if experiment run count is 0 and if it's not a cron experiment
-		if len(experimentRunsID) == 0 && experiment.CronSyntax == "" {
+		if len(experimentRunsID) == 0 && len(queuedRuns) == 0 && experiment.CronSyntax == "" {
 			return false, fmt.Errorf("no running or timeout experiments found")
 		}
 	} else if experimentRunID != nil && *experimentRunID != "" {
@@ -1529,3 +1836,91 @@ func (c *ChaosExperimentHandler) StopExperimentRuns(ctx context.Context, project
 
 	return true, nil
 }
+
+// StopExperimentRun stops the experiment run of the notify ID, it returns false if the run is already completed.
+// A run which is sent to the infra can only be stopped once the infra reported it
+func (c *ChaosExperimentHandler) StopExperimentRun(ctx context.Context, projectID string, notifyID string, r *store.StateData, username string) (bool, error) {
+	run, err := c.chaosExperimentRunOperator.GetExperimentRun(bson.D{
+		{"notify_id", notifyID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return false, err
+	}
+	if run.Completed {
+		return false, nil
+	}
+
+	if run.InQueue {
+		if err := c.stopQueuedExperimentRuns(ctx, []dbChaosExperimentRun.ChaosExperimentRun{run}, username, r); err != nil {
+			return false, err
+		}
+		return true, nil
+	}
+	if run.ExperimentRunID == "" {
+		return false, fmt.Errorf("experiment run %s has not been started by the infra yet", notifyID)
+	}
+
+	query := bson.D{
+		{"experiment_id", run.ExperimentID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, query)
+	if err != nil {
+		return false, err
+	}
+	if err := c.chaosExperimentRunService.ProcessExperimentRunStop(ctx, query, &run.ExperimentRunID, experiment, username, projectID, r); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+func (c *ChaosExperimentHandler) stopQueuedExperimentRuns(ctx context.Context, queuedRuns []dbChaosExperimentRun.ChaosExperimentRun, username string, r *store.StateData) error {
+	currentTime := time.Now().UnixMilli()
+	for _, run := range queuedRuns {
+		err := c.chaosExperimentRunOperator.UpdateExperimentRunWithQuery(ctx, bson.D{
+			{"notify_id", run.NotifyID},
+			{"in_queue", true},
+		}, bson.D{
+			{"$set", bson.D{
+				{"in_queue", false},
+				{"completed", true},
+				{"phase", string(model.ExperimentRunStatusStopped)},
+				{"updated_at", currentTime},
+				{"updated_by", mongodb.UserDetailResponse{
+					Username: username,
+				}},
+			}},
+			{"$unset", bson.D{
+				{"queued_manifest", ""},
+			}},
+		})
+		if err != nil {
+			return err
+		}
+
+		err = c.chaosExperimentOperator.UpdateChaosExperiment(ctx, bson.D{
+			{"experiment_id", run.ExperimentID},
+			{"recent_experiment_run_details.notify_id", run.NotifyID},
+		}, bson.D{
+			{"$set", bson.D{
+				{"recent_experiment_run_details.$.phase", string(model.ExperimentRunStatusStopped)},
+				{"recent_experiment_run_details.$.completed", true},
+				{"recent_experiment_run_details.$.updated_at", currentTime},
+			}},
+		})
+		if err != nil {
+			return err
+		}
+
+		run.Phase = string(model.ExperimentRunStatusStopped)
+		run.Completed = true
+		run.InQueue = false
+		for _, listener := range c.experimentRunListeners {
+			listener.ExperimentRunCompleted(ctx, run, r)
+		}
+	}
+	return nil
+}
@@ -435,6 +435,231 @@ func TestChaosExperimentHandler_UpdateChaosExperiment(t *testing.T) {
 	}
 }
 
+type approvalRecorder struct {
+	actions []model.RunApprovalAction
+}
+
+func (a *approvalRecorder) RequestApproval(_ context.Context, _ string, _ dbChaosExperiment.ChaosExperimentRequest, action model.RunApprovalAction, _ []*model.ExperimentInputValueRequest, _ *int, _ string) (*model.RunApprovalRequest, error) {
+	a.actions = append(a.actions, action)
+	return &model.RunApprovalRequest{ApprovalID: "approval", Action: action}, nil
+}
+
+func TestChaosExperimentHandler_UpdateChaosExperiment_CronScheduleApproval(t *testing.T) {
+	ctx := context.Background()
+	projectId := uuid.New().String()
+	infraId := uuid.New().String()
+	experimentId := uuid.New().String()
+	experimentType := dbChaosExperiment.CronExperiment
+	store := store.NewStore()
+	enabledManifest := `{"kind":"CronWorkflow","metadata":{"name":"exp"},"spec":{"suspend":false}}`
+	suspendedManifest := `{"kind":"CronWorkflow","metadata":{"name":"exp"},"spec":{"suspend":true}}`
+
+	tests := []struct {
+		name            string
+		currentManifest string
+		wantManifest    string
+		wantApprovals   int
+	}{
+		{
+			name:            "success: enabling a suspended schedule keeps it suspended until it is approved",
+			currentManifest: suspendedManifest,
+			wantManifest:    suspendedManifest,
+			wantApprovals:   1,
+		},
+		{
+			name:            "success: an enabled schedule is left as is",
+			currentManifest: enabledManifest,
+			wantManifest:    enabledManifest,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockServices := NewMockServices()
+			approvals := &approvalRecorder{}
+			mockServices.ChaosExperimentHandler.SetApprovalRequester(approvals)
+			request := &model.ChaosExperimentRequest{
+				ExperimentID:       &experimentId,
+				ExperimentName:     "exp",
+				ExperimentManifest: enabledManifest,
+				InfraID:            infraId,
+			}
+
+			experiment := bson.D{
+				{Key: "experiment_id", Value: experimentId},
+				{Key: "experiment_type", Value: experimentType},
+				{Key: "infra_id", Value: infraId},
+				{Key: "revision", Value: []dbChaosExperiment.ExperimentRevision{{RevisionID: "rev-1", ExperimentManifest: tc.currentManifest}}},
+			}
+			infra := bson.D{
+				{Key: "infra_id", Value: infraId},
+				{Key: "project_id", Value: projectId},
+				{Key: "environment_id", Value: "prod"},
+			}
+			environment := bson.D{
+				{Key: "environment_id", Value: "prod"},
+				{Key: "type", Value: model.EnvironmentTypeProd},
+				{Key: "require_run_approval", Value: true},
+			}
+			mockServices.MongodbOperator.On("CountDocuments", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything, mock.Anything).Return(int64(0), nil).Once()
+			mockServices.ChaosExperimentService.On("ProcessExperiment", mock.Anything, mock.Anything, projectId, mock.Anything).Return(request, &experimentType, nil).Once()
+			mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(mongo.NewSingleResultFromDocument(experiment, nil, nil), nil)
+			mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosInfraCollection, mock.Anything).Return(mongo.NewSingleResultFromDocument(infra, nil, nil), nil).Maybe()
+			mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.EnvironmentCollection, mock.Anything).Return(mongo.NewSingleResultFromDocument(environment, nil, nil), nil).Maybe()
+			mockServices.GitOpsService.On("UpsertExperimentToGit", mock.Anything, projectId, request).Return(nil).Once()
+			mockServices.ChaosExperimentService.On("ProcessExperimentUpdate", mock.Anything, mock.Anything, &experimentType, mock.Anything, false, projectId, store).Return(nil).Once()
+
+			_, err := mockServices.ChaosExperimentHandler.UpdateChaosExperiment(ctx, *request, projectId, store, "executor")
+			if err != nil {
+				t.Fatalf("ChaosExperimentHandler.UpdateChaosExperiment() error = %v", err)
+			}
+			if request.ExperimentManifest != tc.wantManifest {
+				t.Errorf("ChaosExperimentHandler.UpdateChaosExperiment() manifest = %s, want %s", request.ExperimentManifest, tc.wantManifest)
+			}
+			if len(approvals.actions) != tc.wantApprovals {
+				t.Errorf("ChaosExperimentHandler.UpdateChaosExperiment() requested %d approvals, want %d", len(approvals.actions), tc.wantApprovals)
+			}
+			for _, action := range approvals.actions {
+				if action != model.RunApprovalActionEnableCron {
+					t.Errorf("ChaosExperimentHandler.UpdateChaosExperiment() requested the approval of %s, want %s", action, model.RunApprovalActionEnableCron)
+				}
+			}
+		})
+	}
+}
+
+func TestChaosExperimentHandler_ListExperimentRevisions(t *testing.T) {
+	ctx := context.Background()
+	projectId := uuid.New().String()
+	experimentId := uuid.New().String()
+	revisions := []dbChaosExperiment.ExperimentRevision{
+		{RevisionID: "rev-1", ExperimentManifest: `{"kind":"Workflow"}`, UpdatedAt: 1, UpdatedBy: mongodb.UserDetailResponse{Username: "admin"}},
+		{RevisionID: "rev-2", ExperimentManifest: `{"kind":"Workflow"}`, UpdatedAt: 2},
+	}
+
+	tests := []struct {
+		name    string
+		given   func(mockServices *MockServices)
+		want    []string
+		wantErr bool
+	}{
+		{
+			name: "success: latest revision first",
+			given: func(mockServices *MockServices) {
+				singleResult := mongo.NewSingleResultFromDocument(bson.D{
+					{Key: "experiment_id", Value: experimentId},
+					{Key: "revision", Value: revisions},
+				}, nil, nil)
+				mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(singleResult, nil).Once()
+			},
+			want:    []string{"rev-2", "rev-1"},
+			wantErr: false,
+		},
+		{
+			name: "failure: no revisions found",
+			given: func(mockServices *MockServices) {
+				singleResult := mongo.NewSingleResultFromDocument(bson.D{
+					{Key: "experiment_id", Value: experimentId},
+				}, nil, nil)
+				mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(singleResult, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockServices := NewMockServices()
+			tc.given(mockServices)
+			got, err := mockServices.ChaosExperimentHandler.ListExperimentRevisions(ctx, projectId, experimentId)
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ChaosExperimentHandler.ListExperimentRevisions() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			var gotIDs []string
+			for _, revision := range got {
+				gotIDs = append(gotIDs, revision.RevisionID)
+			}
+			if !reflect.DeepEqual(gotIDs, tc.want) {
+				t.Errorf("ChaosExperimentHandler.ListExperimentRevisions() = %v, want %v", gotIDs, tc.want)
+			}
+			if len(got) > 0 && (!got[0].IsCurrent || got[1].IsCurrent || got[1].UpdatedBy == nil) {
+				t.Errorf("ChaosExperimentHandler.ListExperimentRevisions() returned unexpected revision details")
+			}
+			assertExpectations(mockServices, t)
+		})
+	}
+}
+
+func TestChaosExperimentHandler_RollbackChaosExperiment(t *testing.T) {
+	ctx := context.Background()
+	projectId := uuid.New().String()
+	experimentId := uuid.New().String()
+	experimentType := dbChaosExperiment.NonCronExperiment
+	store := store.NewStore()
+	revisions := []dbChaosExperiment.ExperimentRevision{
+		{RevisionID: "rev-1", ExperimentManifest: `{"kind":"Workflow","metadata":{"name":"old-name"}}`, UpdatedAt: 1},
+		{RevisionID: "rev-2", ExperimentManifest: `{"kind":"Workflow","metadata":{"name":"exp"}}`, UpdatedAt: 2},
+	}
+	experiment := bson.D{
+		{Key: "experiment_id", Value: experimentId},
+		{Key: "name", Value: "exp"},
+		{Key: "revision", Value: revisions},
+	}
+
+	tests := []struct {
+		name       string
+		revisionID string
+		given      func(mockServices *MockServices)
+		wantErr    bool
+	}{
+		{
+			name:       "success: rollback to an old revision",
+			revisionID: "rev-1",
+			given: func(mockServices *MockServices) {
+				singleResult := mongo.NewSingleResultFromDocument(experiment, nil, nil)
+				mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(singleResult, nil).Once()
+				mockServices.ChaosExperimentService.On("ProcessExperiment", mock.Anything, mock.MatchedBy(func(request *model.ChaosExperimentRequest) bool {
+					return request.ExperimentManifest == `{"kind":"Workflow","metadata":{"name":"exp"}}`
+				}), projectId, mock.Anything).Return(func(ctx context.Context, request *model.ChaosExperimentRequest, projectID string, revID string) *model.ChaosExperimentRequest {
+					return request
+				}, &experimentType, nil).Once()
+				mockServices.GitOpsService.On("UpsertExperimentToGit", mock.Anything, projectId, mock.Anything).Return(nil).Once()
+				mockServices.ChaosExperimentService.On("ProcessExperimentUpdate", mock.Anything, mock.Anything, &experimentType, mock.Anything, false, projectId, store).Return(nil).Once()
+			},
+			wantErr: false,
+		},
+		{
+			name:       "failure: revision is already the current revision",
+			revisionID: "rev-2",
+			given: func(mockServices *MockServices) {
+				singleResult := mongo.NewSingleResultFromDocument(experiment, nil, nil)
+				mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(singleResult, nil).Once()
+			},
+			wantErr: true,
+		},
+		{
+			name:       "failure: revision not found",
+			revisionID: "rev-3",
+			given: func(mockServices *MockServices) {
+				singleResult := mongo.NewSingleResultFromDocument(experiment, nil, nil)
+				mockServices.MongodbOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(singleResult, nil).Once()
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mockServices := NewMockServices()
+			tc.given(mockServices)
+			_, err := mockServices.ChaosExperimentHandler.RollbackChaosExperiment(ctx, projectId, experimentId, tc.revisionID, store, "")
+			if (err != nil) != tc.wantErr {
+				t.Errorf("ChaosExperimentHandler.RollbackChaosExperiment() error = %v, wantErr %v", err, tc.wantErr)
+				return
+			}
+			assertExpectations(mockServices, t)
+		})
+	}
+}
+
 func TestChaosExperimentHandler_GetExperiment(t *testing.T) {
 	projectId := uuid.New().String()
 	experimentId := uuid.New().String()
@@ -0,0 +1,471 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ghodss/yaml"
+	"github.com/google/uuid"
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbImageRegistry "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/image_registry"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"github.com/tidwall/gjson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// namespaceRequestTimeout is the duration for which the namespaces of the infra are awaited while validating an experiment
+const namespaceRequestTimeout = 10 * time.Second
+
+// cronDescriptors are the predefined schedules supported in place of the cron syntax
+var cronDescriptors = map[string]bool{
+	"@yearly":   true,
+	"@annually": true,
+	"@monthly":  true,
+	"@weekly":   true,
+	"@daily":    true,
+	"@midnight": true,
+	"@hourly":   true,
+}
+
+type cronField struct {
+	name  string
+	min   int
+	max   int
+	names map[string]int
+}
+
+// cronFields are the fields of the cron syntax in the order they are specified
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"JAN": 1, "FEB": 2, "MAR": 3, "APR": 4, "MAY": 5, "JUN": 6,
+		"JUL": 7, "AUG": 8, "SEP": 9, "OCT": 10, "NOV": 11, "DEC": 12,
+	}},
+	{name: "day of week", min: 0, max: 7, names: map[string]int{
+		"SUN": 0, "MON": 1, "TUE": 2, "WED": 3, "THU": 4, "FRI": 5, "SAT": 6,
+	}},
+}
+
+// experimentValidation collects the issues found while validating an experiment
+type experimentValidation struct {
+	errors   []*model.ExperimentValidationIssue
+	warnings []*model.ExperimentValidationIssue
+	// pendingProbes are the probes which are created along with the experiment, they are considered to exist
+	pendingProbes map[string]bool
+}
+
+func (v *experimentValidation) addError(path string, format string, a ...interface{}) {
+	v.errors = append(v.errors, &model.ExperimentValidationIssue{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+func (v *experimentValidation) addWarning(path string, format string, a ...interface{}) {
+	v.warnings = append(v.warnings, &model.ExperimentValidationIssue{
+		Path:    path,
+		Message: fmt.Sprintf(format, a...),
+	})
+}
+
+// getResponse returns the response of the validation, the experiment is valid if no errors were found
+func (v *experimentValidation) getResponse() *model.ValidateChaosExperimentResponse {
+	response := &model.ValidateChaosExperimentResponse{
+		IsValid:  len(v.errors) == 0,
+		Errors:   v.errors,
+		Warnings: v.warnings,
+	}
+	if response.Errors == nil {
+		response.Errors = []*model.ExperimentValidationIssue{}
+	}
+	if response.Warnings == nil {
+		response.Warnings = []*model.ExperimentValidationIssue{}
+	}
+	return response
+}
+
+// experimentNamespace is a namespace referred by the experiment manifest
+type experimentNamespace struct {
+	path      string
+	namespace string
+}
+
+// ValidateChaosExperiment checks the experiment the same way as it is processed on saving and running it, without
+// persisting anything. The namespaces used by the experiment are checked against the namespaces of the infra if it is connected
+func (c *ChaosExperimentHandler) ValidateChaosExperiment(ctx context.Context, projectID string, request model.ChaosExperimentRequest, r *store.StateData) (*model.ValidateChaosExperimentResponse, error) {
+	return c.validateExperiment(ctx, projectID, request, r, &experimentValidation{}), nil
+}
+
+// validateExperiment collects the issues of the experiment in the validation and returns its response
+func (c *ChaosExperimentHandler) validateExperiment(ctx context.Context, projectID string, request model.ChaosExperimentRequest, r *store.StateData, validation *experimentValidation) *model.ValidateChaosExperimentResponse {
+	infraAvailable := false
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(request.InfraID)
+	if err != nil {
+		validation.addError("infraID", "failed to get infra details: %v", err)
+	} else if infra.ProjectID != projectID {
+		validation.addError("infraID", "infra %s doesn't belong to the project", request.InfraID)
+	} else if !infra.IsActive {
+		validation.addError("infraID", "infra %s is inactive, the experiment can't be scheduled on it", infra.Name)
+	} else {
+		infraAvailable = true
+	}
+
+	manifestBytes, err := yaml.YAMLToJSON([]byte(request.ExperimentManifest))
+	if err != nil || !gjson.ValidBytes(manifestBytes) || !gjson.ParseBytes(manifestBytes).IsObject() {
+		validation.addError("", "failed to unmarshal experiment manifest")
+		return validation.getResponse()
+	}
+	manifest := string(manifestBytes)
+
+	kind := strings.ToLower(gjson.Get(manifest, "kind").String())
+	if name := gjson.Get(manifest, "metadata.name").String(); name != request.ExperimentName {
+		validation.addError("metadata.name", "%s name %q doesn't match the experiment name %q", gjson.Get(manifest, "kind").String(), name, request.ExperimentName)
+	}
+
+	inputsPath := fmt.Sprintf("metadata.annotations[%q]", utils.ExperimentInputsAnnotation)
+	if kind == "cronworkflow" {
+		// inputs of cron experiments are always substituted with their default values, hence the defaults are required
+		if _, err := utils.ApplyDefaultExperimentInputs(manifest); err != nil {
+			validation.addError(inputsPath, "%v", err)
+		}
+	} else if _, err := utils.GetExperimentInputs(manifest); err != nil {
+		validation.addError(inputsPath, "%v", err)
+	}
+
+	var (
+		engines     = make(map[string]chaosTypes.ChaosEngine)
+		enginePaths []string
+		faults      = make(map[string]bool)
+		images      = make(map[string]string)
+		imagePaths  []string
+		namespaces  []experimentNamespace
+	)
+
+	switch kind {
+	case "workflow", "cronworkflow":
+		if kind == "cronworkflow" {
+			schedule := gjson.Get(manifest, "spec.schedule").String()
+			if strings.TrimSpace(schedule) == "" {
+				validation.addError("spec.schedule", "cron syntax not provided in manifest")
+			} else if err := validateCronSyntax(schedule); err != nil {
+				validation.addError("spec.schedule", "invalid cron syntax %q: %v", schedule, err)
+			}
+		}
+
+		templatesPath := getTemplatesPath(manifest)
+		parameters := getWorkflowParameters(manifest)
+		if namespace := gjson.Get(manifest, "metadata.namespace").String(); namespace != "" {
+			namespaces = append(namespaces, experimentNamespace{path: "metadata.namespace", namespace: namespace})
+		}
+
+		for i, template := range gjson.Get(manifest, templatesPath).Array() {
+			if image := template.Get("container.image").String(); image != "" {
+				path := fmt.Sprintf("%s[%d].container.image", templatesPath, i)
+				images[path] = image
+				imagePaths = append(imagePaths, path)
+			}
+
+			for j, artifact := range template.Get("inputs.artifacts").Array() {
+				data := artifact.Get("raw.data").String()
+				if data == "" {
+					continue
+				}
+				path := fmt.Sprintf("%s[%d].inputs.artifacts[%d].raw.data", templatesPath, i, j)
+
+				// This replacement is required because chaos engine yaml have a syntax template. example:{{ workflow.parameters.adminModeNamespace }}
+				data = strings.ReplaceAll(data, "{{", "")
+				data = strings.ReplaceAll(data, "}}", "")
+
+				var engine chaosTypes.ChaosEngine
+				if err := yaml.Unmarshal([]byte(data), &engine); err != nil {
+					validation.addError(path, "failed to unmarshal chaosengine: %v", err)
+					continue
+				}
+
+				switch strings.ToLower(engine.Kind) {
+				case "chaosexperiment":
+					faults[engine.Name] = true
+				case "chaosengine":
+					if engine.GenerateName == "" {
+						validation.addError(path+".metadata.generateName", "empty chaos experiment name")
+					}
+					for _, namespace := range []experimentNamespace{
+						{path: path + ".metadata.namespace", namespace: engine.Namespace},
+						{path: path + ".spec.appinfo.appns", namespace: engine.Spec.Appinfo.Appns},
+					} {
+						if namespace.namespace = resolveWorkflowParameter(namespace.namespace, parameters); namespace.namespace != "" {
+							namespaces = append(namespaces, namespace)
+						}
+					}
+					engines[path] = engine
+					enginePaths = append(enginePaths, path)
+				}
+			}
+		}
+	case "chaosengine", "chaosschedule":
+		var engine chaosTypes.ChaosEngine
+		if err := yaml.Unmarshal(manifestBytes, &engine); err != nil {
+			validation.addError("", "failed to unmarshal chaosengine: %v", err)
+			return validation.getResponse()
+		}
+		if kind == "chaosengine" {
+			if engine.Spec.Appinfo.Appns != "" {
+				namespaces = append(namespaces, experimentNamespace{path: "spec.appinfo.appns", namespace: engine.Spec.Appinfo.Appns})
+			}
+			engines[""] = engine
+			enginePaths = append(enginePaths, "")
+		}
+	default:
+		validation.addError("kind", "not a valid object, only workflows/cron workflows/chaos engines supported")
+		return validation.getResponse()
+	}
+
+	for _, path := range enginePaths {
+		c.validateChaosEngine(ctx, projectID, joinYAMLPath(path), engines[path], faults, validation)
+	}
+
+	c.validateImageRegistry(ctx, projectID, images, imagePaths, validation)
+
+	if infraAvailable && len(namespaces) > 0 {
+		c.validateNamespaces(ctx, infra, namespaces, r, validation)
+	}
+
+	return validation.getResponse()
+}
+
+// validateChaosEngine checks the probes and the faults of a chaos engine of the experiment
+func (c *ChaosExperimentHandler) validateChaosEngine(ctx context.Context, projectID string, path string, engine chaosTypes.ChaosEngine, faults map[string]bool, validation *experimentValidation) {
+	if len(engine.Spec.Experiments) == 0 {
+		validation.addError(path+"spec.experiments", "no experiments specified in chaosengine - %s", engine.Name)
+		return
+	}
+
+	if probeRef, ok := engine.Annotations["probeRef"]; !ok {
+		if engine.Spec.Experiments[0].Spec.Probe == nil {
+			validation.addError(path+"metadata.annotations.probeRef", "no probes specified in chaosengine - %s", engine.Name)
+		} else {
+			validation.addWarning(path+"spec.experiments[0].spec.probe", "probes of chaosengine %s will be created in the project on saving the experiment", engine.Name)
+		}
+	} else {
+		probeRefs, err := getProbeReferences(probeRef)
+		if err != nil {
+			validation.addError(path+"metadata.annotations.probeRef", "%v", err)
+		}
+		for _, ref := range probeRefs {
+			if validation.pendingProbes[ref.Name] {
+				continue
+			}
+			if _, err := c.probeService.GetProbe(ctx, ref.Name, projectID); err != nil {
+				validation.addError(path+"metadata.annotations.probeRef", "probe %s doesn't exist in the project", ref.Name)
+			}
+		}
+	}
+
+	for i, fault := range engine.Spec.Experiments {
+		if faults[fault.Name] || isFaultInChaosHubs(projectID, fault.Name) {
+			continue
+		}
+		validation.addWarning(fmt.Sprintf("%sspec.experiments[%d].name", path, i), "fault %s is neither defined in the experiment nor available in the chaos hubs of the project", fault.Name)
+	}
+}
+
+// validateImageRegistry checks that the images of the experiment use the image registry configured for the project
+func (c *ChaosExperimentHandler) validateImageRegistry(ctx context.Context, projectID string, images map[string]string, imagePaths []string, validation *experimentValidation) {
+	if len(imagePaths) == 0 {
+		return
+	}
+
+	imageRegistry, err := dbImageRegistry.NewImageRegistryOperator(c.mongodbOperator).GetImageRegistry(ctx, bson.D{{"project_id", projectID}})
+	if err != nil || imageRegistry.IsDefault || imageRegistry.EnableRegistry == nil || !*imageRegistry.EnableRegistry {
+		return
+	}
+
+	registry := imageRegistry.ImageRegistryName + "/" + imageRegistry.ImageRepoName + "/"
+	for _, path := range imagePaths {
+		if !strings.HasPrefix(images[path], registry) {
+			validation.addWarning(path, "image %s doesn't use the image registry %s configured for the project", images[path], strings.TrimSuffix(registry, "/"))
+		}
+	}
+}
+
+// validateNamespaces checks that the namespaces used by the experiment exist in the infra
+func (c *ChaosExperimentHandler) validateNamespaces(ctx context.Context, infra dbChaosInfra.ChaosInfra, namespaces []experimentNamespace, r *store.StateData, validation *experimentValidation) {
+	infraNamespaces, err := c.getInfraNamespaces(ctx, infra.InfraID, r)
+	if err != nil {
+		validation.addWarning("infraID", "failed to verify the namespaces used by the experiment: %v", err)
+		return
+	}
+
+	for _, namespace := range namespaces {
+		if strings.Contains(namespace.namespace, "experiment.inputs.") || strings.Contains(namespace.namespace, "workflow.parameters.") {
+			continue
+		}
+		if !infraNamespaces[namespace.namespace] {
+			validation.addError(namespace.path, "namespace %s doesn't exist in infra %s", namespace.namespace, infra.Name)
+		}
+	}
+}
+
+// getInfraNamespaces requests the namespaces of a connected infra through the subscriber and waits for the response
+func (c *ChaosExperimentHandler) getInfraNamespaces(ctx context.Context, infraID string, r *store.StateData) (map[string]bool, error) {
+	if r == nil {
+		return nil, errors.New("infra is not connected")
+	}
+
+	var (
+		reqID         = uuid.New().String()
+		namespaceData = make(chan *model.KubeNamespaceResponse, 1)
+	)
+	if !c.isInfraConnected(infraID, *r) {
+		return nil, errors.New("infra is not connected")
+	}
+	r.Mutex.Lock()
+	r.KubeNamespaceData[reqID] = namespaceData
+	r.Mutex.Unlock()
+	defer func() {
+		r.Mutex.Lock()
+		delete(r.KubeNamespaceData, reqID)
+		r.Mutex.Unlock()
+	}()
+
+	go c.GetKubeNamespaceData(ctx, reqID, model.KubeNamespaceRequest{InfraID: infraID}, *r)
+
+	select {
+	case response, ok := <-namespaceData:
+		if !ok || response == nil || len(response.KubeNamespace) == 0 {
+			return nil, errors.New("infra didn't return any namespace")
+		}
+		namespaces := make(map[string]bool)
+		for _, namespace := range response.KubeNamespace {
+			if namespace != nil {
+				namespaces[namespace.Name] = true
+			}
+		}
+		return namespaces, nil
+	case <-time.After(namespaceRequestTimeout):
+		return nil, errors.New("timed out waiting for the namespaces of the infra")
+	}
+}
+
+// isFaultInChaosHubs checks if the fault is available in the default chaos hubs or in the chaos hubs of the project
+func isFaultInChaosHubs(projectID string, faultName string) bool {
+	for _, pattern := range []string{
+		chaoshub.DefaultPath + "default/*/faults/*/" + faultName,
+		chaoshub.DefaultPath + projectID + "/*/faults/*/" + faultName,
+	} {
+		if matches, _ := filepath.Glob(pattern); len(matches) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// getWorkflowParameters returns the values of the arguments of the workflow by their names
+func getWorkflowParameters(manifest string) map[string]string {
+	argumentsPath := "spec.arguments.parameters"
+	if strings.ToLower(gjson.Get(manifest, "kind").String()) == "cronworkflow" {
+		argumentsPath = "spec.workflowSpec.arguments.parameters"
+	}
+
+	parameters := make(map[string]string)
+	for _, parameter := range gjson.Get(manifest, argumentsPath).Array() {
+		parameters[parameter.Get("name").String()] = parameter.Get("value").String()
+	}
+	return parameters
+}
+
+// resolveWorkflowParameter returns the value of the workflow parameter if the value refers to one, e.g. workflow.parameters.adminModeNamespace
+func resolveWorkflowParameter(value string, parameters map[string]string) string {
+	value = strings.TrimSpace(value)
+	if name, ok := strings.CutPrefix(value, "workflow.parameters."); ok {
+		if parameter, ok := parameters[name]; ok {
+			return parameter
+		}
+	}
+	return value
+}
+
+// joinYAMLPath returns the prefix used for the paths of the fields nested in the given path
+func joinYAMLPath(path string) string {
+	if path == "" {
+		return ""
+	}
+	return path + "."
+}
+
+// validateCronSyntax checks the schedule of a cron workflow, both the standard cron syntax and the predefined schedules are supported
+func validateCronSyntax(schedule string) error {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "@") {
+		if interval, ok := strings.CutPrefix(schedule, "@every "); ok {
+			if _, err := time.ParseDuration(strings.TrimSpace(interval)); err != nil {
+				return fmt.Errorf("invalid interval %q", interval)
+			}
+			return nil
+		}
+		if !cronDescriptors[schedule] {
+			return fmt.Errorf("unknown schedule %s", schedule)
+		}
+		return nil
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected %d fields, found %d", len(cronFields), len(fields))
+	}
+	for i, field := range fields {
+		if err := validateCronField(field, cronFields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateCronField(value string, field cronField) error {
+	for _, item := range strings.Split(value, ",") {
+		rangeExpr, step, hasStep := strings.Cut(item, "/")
+		if hasStep {
+			if n, err := strconv.Atoi(step); err != nil || n <= 0 {
+				return fmt.Errorf("invalid step %q in %s field", step, field.name)
+			}
+		}
+		if rangeExpr == "*" || rangeExpr == "?" {
+			continue
+		}
+
+		start, end, isRange := strings.Cut(rangeExpr, "-")
+		bounds := []string{start}
+		if isRange {
+			bounds = append(bounds, end)
+		}
+		var values []int
+		for _, bound := range bounds {
+			n, err := strconv.Atoi(bound)
+			if err != nil {
+				var ok bool
+				if n, ok = field.names[strings.ToUpper(bound)]; !ok {
+					return fmt.Errorf("invalid value %q in %s field", bound, field.name)
+				}
+			}
+			if n < field.min || n > field.max {
+				return fmt.Errorf("value %d out of range [%d-%d] in %s field", n, field.min, field.max, field.name)
+			}
+			values = append(values, n)
+		}
+		if isRange && values[0] > values[1] {
+			return fmt.Errorf("invalid range %q in %s field", rangeExpr, field.name)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package handler
+
+import "testing"
+
+func TestValidateCronSyntax(t *testing.T) {
+	tests := []struct {
+		name     string
+		schedule string
+		wantErr  bool
+	}{
+		{
+			name:     "success: every minute",
+			schedule: "* * * * *",
+		},
+		{
+			name:     "success: ranges, lists and steps",
+			schedule: "*/15 9-17 1,15 JAN-JUN mon-fri",
+		},
+		{
+			name:     "success: predefined schedule",
+			schedule: "@daily",
+		},
+		{
+			name:     "success: interval",
+			schedule: "@every 1h30m",
+		},
+		{
+			name:     "failure: missing field",
+			schedule: "0 12 * *",
+			wantErr:  true,
+		},
+		{
+			name:     "failure: value out of range",
+			schedule: "0 24 * * *",
+			wantErr:  true,
+		},
+		{
+			name:     "failure: reversed range",
+			schedule: "0 17-9 * * *",
+			wantErr:  true,
+		},
+		{
+			name:     "failure: invalid step",
+			schedule: "*/0 * * * *",
+			wantErr:  true,
+		},
+		{
+			name:     "failure: unknown predefined schedule",
+			schedule: "@fortnightly",
+			wantErr:  true,
+		},
+		{
+			name:     "failure: invalid interval",
+			schedule: "@every soon",
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if err := validateCronSyntax(tc.schedule); (err != nil) != tc.wantErr {
+				t.Errorf("validateCronSyntax() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestResolveWorkflowParameter(t *testing.T) {
+	parameters := map[string]string{"adminModeNamespace": "litmus"}
+
+	tests := []struct {
+		name  string
+		value string
+		want  string
+	}{
+		{
+			name:  "success: reference to a workflow parameter is resolved",
+			value: " workflow.parameters.adminModeNamespace ",
+			want:  "litmus",
+		},
+		{
+			name:  "success: reference to an unknown parameter is kept",
+			value: "workflow.parameters.appNamespace",
+			want:  "workflow.parameters.appNamespace",
+		},
+		{
+			name:  "success: plain value is kept",
+			value: "default",
+			want:  "default",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := resolveWorkflowParameter(tc.value, parameters); got != tc.want {
+				t.Errorf("resolveWorkflowParameter() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
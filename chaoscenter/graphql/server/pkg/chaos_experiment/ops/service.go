@@ -106,6 +106,16 @@ func (c *chaosExperimentService) ProcessExperiment(ctx context.Context, workflow
 		return nil, nil, errors.New(objMeta.GetKind() + " name doesn't match")
 	}
 
+	// inputs of cron experiments are always substituted with their default values, hence the defaults are required
+	if strings.ToLower(objMeta.GetKind()) == "cronworkflow" {
+		_, err = utils.ApplyDefaultExperimentInputs(workflow.ExperimentManifest)
+	} else {
+		_, err = utils.GetExperimentInputs(workflow.ExperimentManifest)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+
 	switch strings.ToLower(objMeta.GetKind()) {
 	case "workflow":
 		{
@@ -170,7 +180,10 @@ func (c *chaosExperimentService) ProcessExperimentCreation(ctx context.Context,
 		RevisionID:         revisionID,
 		ExperimentManifest: input.ExperimentManifest,
 		UpdatedAt:          timeNow,
-		Weightages:         weightages,
+		UpdatedBy: mongodb.UserDetailResponse{
+			Username: username,
+		},
+		Weightages: weightages,
 	})
 
 	newChaosExperiment := dbChaosExperiment.ChaosExperimentRequest{
@@ -198,6 +211,10 @@ func (c *chaosExperimentService) ProcessExperimentCreation(ctx context.Context,
 		},
 		Revision:                   revision,
 		RecentExperimentRunDetails: []dbChaosExperiment.ExperimentRunDetail{},
+		ResiliencyScoreStrategy:    model.ResiliencyScoreStrategyWeightedProbeSuccess.String(),
+	}
+	if input.ResiliencyScoreStrategy != nil {
+		newChaosExperiment.ResiliencyScoreStrategy = input.ResiliencyScoreStrategy.String()
 	}
 
 	err := c.chaosExperimentOperator.InsertChaosExperiment(ctx, newChaosExperiment)
@@ -205,7 +222,11 @@ func (c *chaosExperimentService) ProcessExperimentCreation(ctx context.Context,
 		return err
 	}
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, input, &username, nil, "create", r)
+		request, err := getSubscriberExperimentRequest(input, wfType)
+		if err != nil {
+			return err
+		}
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, request, &username, nil, "create", r)
 	}
 	return nil
 }
@@ -231,7 +252,10 @@ func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosEx
 		RevisionID:         revisionID,
 		ExperimentManifest: workflow.ExperimentManifest,
 		UpdatedAt:          time.Now().UnixMilli(),
-		Weightages:         weightages,
+		UpdatedBy: mongodb.UserDetailResponse{
+			Username: username,
+		},
+		Weightages: weightages,
 	}
 
 	query := bson.D{
@@ -258,6 +282,11 @@ func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosEx
 		}},
 	}
 
+	// the current resiliency score strategy is kept if it's not provided in the request
+	if workflow.ResiliencyScoreStrategy != nil {
+		update[0].Value = append(update[0].Value.(bson.D), bson.E{Key: "resiliency_score_strategy", Value: workflow.ResiliencyScoreStrategy.String()})
+	}
+
 	// This case is required while disabling/enabling cron experiments
 	if updateRevision {
 		query = bson.D{
@@ -288,11 +317,30 @@ func (c *chaosExperimentService) ProcessExperimentUpdate(workflow *model.ChaosEx
 	}
 
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, workflow, &username, nil, "update", r)
+		request, err := getSubscriberExperimentRequest(workflow, wfType)
+		if err != nil {
+			return err
+		}
+		chaos_infrastructure.SendExperimentToSubscriber(context.Background(), projectID, request, &username, nil, "update", r)
 	}
 	return nil
 }
 
+// getSubscriberExperimentRequest returns the request to be sent to the chaos_infra, the inputs of cron
+// experiments are substituted with their default values as the runs are scheduled by the chaos_infra
+func getSubscriberExperimentRequest(workflow *model.ChaosExperimentRequest, wfType *dbChaosExperiment.ChaosExperimentType) (*model.ChaosExperimentRequest, error) {
+	if wfType == nil || *wfType != dbChaosExperiment.CronExperiment {
+		return workflow, nil
+	}
+	manifest, err := utils.ApplyDefaultExperimentInputs(workflow.ExperimentManifest)
+	if err != nil {
+		return nil, err
+	}
+	request := *workflow
+	request.ExperimentManifest = manifest
+	return &request, nil
+}
+
 // ProcessExperimentDelete deletes the workflow entry and sends delete resource request to required chaos_infra
 func (c *chaosExperimentService) ProcessExperimentDelete(query bson.D, workflow dbChaosExperiment.ChaosExperimentRequest, username string, r *store.StateData) error {
 	var (
@@ -347,7 +395,7 @@ func (c *chaosExperimentService) ProcessExperimentDelete(query bson.D, workflow
 
 	session.EndSession(ctx)
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(workflow.ProjectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, workflow.ProjectID, &model.ChaosExperimentRequest{
 			InfraID: workflow.InfraID,
 		}, &username, &workflow.ExperimentID, "workflow_delete", r)
 	}
@@ -2,8 +2,12 @@ package chaos_experiment
 
 import (
 	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 )
 
+// ExperimentBundleVersion is the version of the experiment bundle format
+const ExperimentBundleVersion = "v1"
+
 type ExperimentRunMetrics struct {
 	ResiliencyScore  float64 `json:"resiliency_score"`
 	FaultsPassed     int     `json:"faults_passed"`
@@ -128,3 +132,34 @@ type FaultDetailsForAnalytics struct {
 	TotalProbes int                        `json:"total_probes"`
 	Probes      []ProbeDetailsForAnalytics `json:"probes"`
 }
+
+// ExperimentBundle is a portable representation of an experiment along with the probes it references
+type ExperimentBundle struct {
+	Version       string                         `json:"version"`
+	ExportedAt    int64                          `json:"exported_at"`
+	Experiment    ExperimentBundleDetails        `json:"experiment"`
+	Probes        []model.ProbeRequest           `json:"probes,omitempty"`
+	HubReferences []ExperimentBundleHubReference `json:"hub_references"`
+}
+
+// ExperimentBundleDetails contains the details of the exported experiment
+type ExperimentBundleDetails struct {
+	Name               string                   `json:"name"`
+	Description        string                   `json:"description"`
+	Tags               []string                 `json:"tags"`
+	CronSyntax         string                   `json:"cron_syntax"`
+	ExperimentType     string                   `json:"experiment_type"`
+	IsCustomExperiment bool                     `json:"is_custom_experiment"`
+	Manifest           string                   `json:"manifest,omitempty"`
+	Weightages         []*model.WeightagesInput `json:"weightages"`
+	InfraID            string                   `json:"infra_id"`
+	EnvironmentID      string                   `json:"environment_id"`
+}
+
+// ExperimentBundleHubReference contains the details of a chaos hub providing faults of the exported experiment
+type ExperimentBundleHubReference struct {
+	HubName    string   `json:"hub_name"`
+	RepoURL    string   `json:"repo_url"`
+	RepoBranch string   `json:"repo_branch"`
+	Faults     []string `json:"faults"`
+}
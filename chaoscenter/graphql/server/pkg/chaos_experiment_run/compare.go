@@ -0,0 +1,153 @@
+package chaos_experiment_run
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// faultRunResult contains the result of a single fault in an experiment run
+type faultRunResult struct {
+	faultName              string
+	verdict                string
+	probeSuccessPercentage string
+	duration               *int
+	probes                 map[string]probeRunResult
+}
+
+// probeRunResult contains the result of a single probe of a fault in an experiment run
+type probeRunResult struct {
+	mode    string
+	verdict string
+}
+
+// CompareExecutionData returns the per fault differences between the execution data of two experiment runs,
+// faults are matched using the name of the workflow step which is stable across runs of the experiment
+func CompareExecutionData(execA, execB ExecutionData) []*model.FaultComparison {
+	faultsA := getFaultRunResults(execA)
+	faultsB := getFaultRunResults(execB)
+
+	var stepNames []string
+	for stepName := range faultsA {
+		stepNames = append(stepNames, stepName)
+	}
+	for stepName := range faultsB {
+		if _, ok := faultsA[stepName]; !ok {
+			stepNames = append(stepNames, stepName)
+		}
+	}
+	sort.Strings(stepNames)
+
+	comparisons := make([]*model.FaultComparison, 0, len(stepNames))
+	for _, stepName := range stepNames {
+		faultA, okA := faultsA[stepName]
+		faultB, okB := faultsB[stepName]
+
+		comparison := &model.FaultComparison{
+			StepName: stepName,
+			Probes:   []*model.ProbeComparison{},
+		}
+		if okA {
+			comparison.FaultName = faultA.faultName
+			comparison.VerdictA = &faultA.verdict
+			comparison.ProbeSuccessPercentageA = &faultA.probeSuccessPercentage
+			comparison.DurationA = faultA.duration
+		}
+		if okB {
+			comparison.FaultName = faultB.faultName
+			comparison.VerdictB = &faultB.verdict
+			comparison.ProbeSuccessPercentageB = &faultB.probeSuccessPercentage
+			comparison.DurationB = faultB.duration
+		}
+		comparison.IsChanged = !okA || !okB || faultA.verdict != faultB.verdict
+
+		var probeNames []string
+		for probeName := range faultA.probes {
+			probeNames = append(probeNames, probeName)
+		}
+		for probeName := range faultB.probes {
+			if _, ok := faultA.probes[probeName]; !ok {
+				probeNames = append(probeNames, probeName)
+			}
+		}
+		sort.Strings(probeNames)
+
+		for _, probeName := range probeNames {
+			probeA, okA := faultA.probes[probeName]
+			probeB, okB := faultB.probes[probeName]
+
+			probeComparison := &model.ProbeComparison{
+				ProbeName: probeName,
+				IsChanged: !okA || !okB || probeA.verdict != probeB.verdict,
+			}
+			if okA {
+				probeComparison.Mode = &probeA.mode
+				probeComparison.VerdictA = &probeA.verdict
+			}
+			if okB {
+				probeComparison.Mode = &probeB.mode
+				probeComparison.VerdictB = &probeB.verdict
+			}
+			if probeComparison.IsChanged {
+				comparison.IsChanged = true
+			}
+			comparison.Probes = append(comparison.Probes, probeComparison)
+		}
+
+		comparisons = append(comparisons, comparison)
+	}
+
+	return comparisons
+}
+
+// getFaultRunResults extracts the results of all the ChaosEngine nodes of an experiment run
+func getFaultRunResults(execData ExecutionData) map[string]faultRunResult {
+	results := make(map[string]faultRunResult)
+	for _, node := range execData.Nodes {
+		if node.Type != "ChaosEngine" {
+			continue
+		}
+
+		result := faultRunResult{
+			faultName: node.Name,
+			verdict:   node.Phase,
+			duration:  GetDuration(node.StartedAt, node.FinishedAt),
+			probes:    make(map[string]probeRunResult),
+		}
+		if node.ChaosExp != nil {
+			if node.ChaosExp.ExperimentName != "" {
+				result.faultName = node.ChaosExp.ExperimentName
+			}
+			if node.ChaosExp.ExperimentVerdict != "" {
+				result.verdict = node.ChaosExp.ExperimentVerdict
+			}
+			result.probeSuccessPercentage = node.ChaosExp.ProbeSuccessPercentage
+			if node.ChaosExp.ChaosResult != nil {
+				for _, probeStatus := range node.ChaosExp.ChaosResult.Status.ProbeStatuses {
+					result.probes[probeStatus.Name] = probeRunResult{
+						mode:    probeStatus.Mode,
+						verdict: string(probeStatus.Status.Verdict),
+					}
+				}
+			}
+		}
+		results[node.Name] = result
+	}
+	return results
+}
+
+// GetDuration returns the duration in seconds between two unix timestamps reported by the subscriber,
+// nil is returned if the execution has not finished yet
+func GetDuration(startedAt, finishedAt string) *int {
+	start, err := strconv.ParseInt(startedAt, 10, 64)
+	if err != nil || start <= 0 {
+		return nil
+	}
+	finish, err := strconv.ParseInt(finishedAt, 10, 64)
+	if err != nil || finish <= 0 || finish < start {
+		return nil
+	}
+	duration := int(finish - start)
+	return &duration
+}
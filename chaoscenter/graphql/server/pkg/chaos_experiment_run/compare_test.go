@@ -0,0 +1,106 @@
+package chaos_experiment_run
+
+import (
+	"testing"
+
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+)
+
+func newChaosEngineNode(name, verdict, probeVerdict string) Node {
+	return Node{
+		Name:       name,
+		Type:       "ChaosEngine",
+		StartedAt:  "1700000000",
+		FinishedAt: "1700000060",
+		ChaosExp: &ChaosData{
+			ExperimentName:         "pod-delete",
+			ExperimentVerdict:      verdict,
+			ProbeSuccessPercentage: "100",
+			ChaosResult: &chaosTypes.ChaosResult{
+				Status: chaosTypes.ChaosResultStatus{
+					ProbeStatuses: []chaosTypes.ProbeStatuses{
+						{
+							Name: "http-probe",
+							Mode: "Continuous",
+							Status: chaosTypes.ProbeStatus{
+								Verdict: chaosTypes.ProbeVerdict(probeVerdict),
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func TestCompareExecutionData(t *testing.T) {
+	execA := ExecutionData{
+		Nodes: map[string]Node{
+			"run-a-1": newChaosEngineNode("pod-delete-step", "Pass", "Passed"),
+			"run-a-2": newChaosEngineNode("pod-cpu-hog-step", "Pass", "Passed"),
+			"run-a-3": {Name: "install-application", Type: "Pod"},
+		},
+	}
+	execB := ExecutionData{
+		Nodes: map[string]Node{
+			"run-b-1": newChaosEngineNode("pod-delete-step", "Fail", "Failed"),
+			"run-b-2": newChaosEngineNode("pod-cpu-hog-step", "Pass", "Passed"),
+		},
+	}
+
+	got := CompareExecutionData(execA, execB)
+	if len(got) != 2 {
+		t.Fatalf("CompareExecutionData() returned %d faults, want 2", len(got))
+	}
+
+	// faults are sorted by step name
+	if got[0].StepName != "pod-cpu-hog-step" || got[0].IsChanged {
+		t.Errorf("CompareExecutionData() unexpected comparison for %s, changed = %v", got[0].StepName, got[0].IsChanged)
+	}
+	if got[1].StepName != "pod-delete-step" || !got[1].IsChanged {
+		t.Errorf("CompareExecutionData() unexpected comparison for %s, changed = %v", got[1].StepName, got[1].IsChanged)
+	}
+	if *got[1].VerdictA != "Pass" || *got[1].VerdictB != "Fail" {
+		t.Errorf("CompareExecutionData() verdicts = %s, %s, want Pass, Fail", *got[1].VerdictA, *got[1].VerdictB)
+	}
+	if len(got[1].Probes) != 1 || !got[1].Probes[0].IsChanged {
+		t.Errorf("CompareExecutionData() probe status change was not reported")
+	}
+	if got[1].DurationA == nil || *got[1].DurationA != 60 {
+		t.Errorf("CompareExecutionData() fault duration was not calculated")
+	}
+}
+
+func TestGetDuration(t *testing.T) {
+	tests := []struct {
+		name       string
+		startedAt  string
+		finishedAt string
+		want       *int
+	}{
+		{
+			name:       "success: finished execution",
+			startedAt:  "1700000000",
+			finishedAt: "1700000090",
+			want:       func() *int { d := 90; return &d }(),
+		},
+		{
+			name:       "success: execution not finished",
+			startedAt:  "1700000000",
+			finishedAt: "-62135596800",
+		},
+		{
+			name:       "success: invalid timestamp",
+			startedAt:  "",
+			finishedAt: "1700000090",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := GetDuration(tc.startedAt, tc.finishedAt)
+			if (got == nil) != (tc.want == nil) || (got != nil && *got != *tc.want) {
+				t.Errorf("GetDuration() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,217 @@
+package chaos_experiment_run
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const dispatchInterval = 30 * time.Second
+
+// RunDispatcher starts the queued experiment runs of the infras once they have capacity
+type RunDispatcher struct {
+	chaosExperimentRunOperator *dbChaosExperimentRun.Operator
+	chaosInfraOperator         *dbChaosInfra.Operator
+	mongodbOperator            mongodb.MongoOperator
+}
+
+// NewRunDispatcher returns a new instance of the run dispatcher
+func NewRunDispatcher(mongodbOperator mongodb.MongoOperator) *RunDispatcher {
+	return &RunDispatcher{
+		chaosExperimentRunOperator: dbChaosExperimentRun.NewChaosExperimentRunOperator(mongodbOperator),
+		chaosInfraOperator:         dbChaosInfra.NewInfrastructureOperator(mongodbOperator),
+		mongodbOperator:            mongodbOperator,
+	}
+}
+
+// RecurringDispatch dispatches the queued runs of all the infras periodically until the context is cancelled,
+// it releases the runs which could not be started when their infra was inactive or a dispatch failed
+func (d *RunDispatcher) RecurringDispatch(ctx context.Context, r *store.StateData) {
+	for {
+		infraIDs, err := d.getQueuedRunInfraIDs()
+		if err != nil {
+			log.Errorf("failed to get the infras with queued experiment runs, error: %v", err)
+		}
+		for _, infraID := range infraIDs {
+			if err := d.DispatchQueuedRuns(ctx, infraID, r); err != nil {
+				log.Errorf("failed to dispatch the queued experiment runs of infra %s, error: %v", infraID, err)
+			}
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(dispatchInterval):
+		}
+	}
+}
+
+// DispatchQueuedRuns sends the queued runs of the infra to the infra in the order of its queue
+// policy until the maximum number of concurrent runs of the infra is reached. The dispatches may
+// be triggered on every replica at the same time, each run is claimed in the database before it
+// is sent so that it is sent once and the limit holds across the replicas. The runs stay queued while
+// a blackout window of the infra's environment is active
+func (d *RunDispatcher) DispatchQueuedRuns(ctx context.Context, infraID string, r *store.StateData) error {
+	if r == nil {
+		return nil
+	}
+
+	infra, err := d.chaosInfraOperator.GetInfra(infraID)
+	if err != nil {
+		return err
+	}
+	if !infra.IsActive || infra.IsRemoved {
+		return nil
+	}
+
+	queuedRuns, err := d.chaosExperimentRunOperator.GetExperimentRuns(bson.D{
+		{"infra_id", infraID},
+		{"in_queue", true},
+		{"completed", false},
+		{"is_removed", false},
+	})
+	if err != nil || len(queuedRuns) == 0 {
+		return err
+	}
+
+	SortQueuedRuns(queuedRuns, model.RunQueuePolicy(infra.RunQueuePolicy))
+	for _, run := range queuedRuns {
+		// a blackout window may have started since the runs were queued
+		window, err := envHandler.GetInfraBlackoutWindow(ctx, d.mongodbOperator, infra)
+		if err != nil {
+			return err
+		}
+		if window != nil {
+			log.Infof("queued experiment runs of infra %s are kept queued during the blackout window %s", infraID, window.Name)
+			return nil
+		}
+
+		claimed, err := d.claimQueuedRun(ctx, run)
+		if err != nil {
+			return err
+		}
+		// the run has been claimed by another replica in the meantime
+		if !claimed {
+			continue
+		}
+		hasCapacity, err := d.keepQueuedRunClaim(ctx, infra, run)
+		if err != nil {
+			return err
+		}
+		// the infra has no capacity left, the remaining runs stay queued
+		if !hasCapacity {
+			return nil
+		}
+
+		username := run.CreatedBy.Username
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, run.ProjectID, &model.ChaosExperimentRequest{
+			ExperimentID:       &run.ExperimentID,
+			ExperimentManifest: run.QueuedManifest,
+			InfraID:            run.InfraID,
+			ExperimentName:     run.ExperimentName,
+		}, &username, nil, "create", r)
+
+		if err := d.chaosExperimentRunOperator.UpdateExperimentRunWithQuery(ctx, bson.D{
+			{"notify_id", run.NotifyID},
+		}, bson.D{
+			{"$unset", bson.D{
+				{"queued_manifest", ""},
+			}},
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// claimQueuedRun takes the run out of the queue, it returns false if the run has been claimed by another replica
+func (d *RunDispatcher) claimQueuedRun(ctx context.Context, run dbChaosExperimentRun.ChaosExperimentRun) (bool, error) {
+	return d.chaosExperimentRunOperator.ClaimExperimentRun(ctx, bson.D{
+		{"notify_id", run.NotifyID},
+		{"in_queue", true},
+		{"completed", false},
+	}, bson.D{
+		{"$set", bson.D{
+			{"in_queue", false},
+			{"updated_at", time.Now().UnixMilli()},
+		}},
+	})
+}
+
+// keepQueuedRunClaim checks that the other active runs of the infra, the runs claimed by other replicas included,
+// leave room for the claimed run and puts the run back in the queue otherwise. Of the runs claimed at the same time
+// at least the later one sees the other, so the limit is never exceeded, a run put back in the queue is dispatched
+// by a later dispatch
+func (d *RunDispatcher) keepQueuedRunClaim(ctx context.Context, infra dbChaosInfra.ChaosInfra, run dbChaosExperimentRun.ChaosExperimentRun) (bool, error) {
+	if infra.MaxConcurrentRuns <= 0 {
+		return true, nil
+	}
+
+	activeRuns, err := d.chaosExperimentRunOperator.CountExperimentRuns(ctx, bson.D{
+		{"infra_id", infra.InfraID},
+		{"in_queue", bson.D{{"$ne", true}}},
+		{"completed", false},
+		{"is_removed", false},
+		{"notify_id", bson.D{{"$ne", run.NotifyID}}},
+	})
+	if err == nil && int(activeRuns) < infra.MaxConcurrentRuns {
+		return true, nil
+	}
+
+	if _, releaseErr := d.chaosExperimentRunOperator.ClaimExperimentRun(ctx, bson.D{
+		{"notify_id", run.NotifyID},
+		{"in_queue", false},
+		{"completed", false},
+	}, bson.D{
+		{"$set", bson.D{
+			{"in_queue", true},
+		}},
+	}); releaseErr != nil {
+		log.Errorf("failed to put the experiment run %s back in the queue, error: %v", *run.NotifyID, releaseErr)
+	}
+	return false, err
+}
+
+// SortQueuedRuns orders the queued runs in which they are started, runs with a higher priority are started
+// first for the PRIORITY policy and the runs are started in the order they were queued otherwise
+func SortQueuedRuns(runs []dbChaosExperimentRun.ChaosExperimentRun, policy model.RunQueuePolicy) {
+	sort.SliceStable(runs, func(i, j int) bool {
+		if policy == model.RunQueuePolicyPriority && runs[i].Priority != runs[j].Priority {
+			return runs[i].Priority > runs[j].Priority
+		}
+		return runs[i].CreatedAt < runs[j].CreatedAt
+	})
+}
+
+func (d *RunDispatcher) getQueuedRunInfraIDs() ([]string, error) {
+	runs, err := d.chaosExperimentRunOperator.GetExperimentRuns(bson.D{
+		{"in_queue", true},
+		{"completed", false},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var (
+		infraIDs []string
+		seen     = make(map[string]bool)
+	)
+	for _, run := range runs {
+		if !seen[run.InfraID] {
+			seen[run.InfraID] = true
+			infraIDs = append(infraIDs, run.InfraID)
+		}
+	}
+	return infraIDs, nil
+}
@@ -0,0 +1,149 @@
+package chaos_experiment_run
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/mocks"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestSortQueuedRuns(t *testing.T) {
+	newQueuedRun := func(id string, priority int, createdAt int64) dbChaosExperimentRun.ChaosExperimentRun {
+		return dbChaosExperimentRun.ChaosExperimentRun{
+			ExperimentID: id,
+			Priority:     priority,
+			InQueue:      true,
+			Audit:        mongodb.Audit{CreatedAt: createdAt},
+		}
+	}
+
+	tests := []struct {
+		name   string
+		policy model.RunQueuePolicy
+		want   []string
+	}{
+		{
+			name:   "FIFO policy starts the runs in the order they were queued",
+			policy: model.RunQueuePolicyFifo,
+			want:   []string{"first", "second", "third", "fourth"},
+		},
+		{
+			name:   "PRIORITY policy starts the runs with a higher priority first",
+			policy: model.RunQueuePolicyPriority,
+			want:   []string{"third", "second", "fourth", "first"},
+		},
+		{
+			name: "runs are started in the order they were queued without a policy",
+			want: []string{"first", "second", "third", "fourth"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			runs := []dbChaosExperimentRun.ChaosExperimentRun{
+				newQueuedRun("fourth", 5, 4000),
+				newQueuedRun("second", 5, 2000),
+				newQueuedRun("first", 0, 1000),
+				newQueuedRun("third", 10, 3000),
+			}
+			SortQueuedRuns(runs, tc.policy)
+
+			var got []string
+			for _, run := range runs {
+				got = append(got, run.ExperimentID)
+			}
+			if !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("SortQueuedRuns() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRunDispatcher_KeepQueuedRunClaim(t *testing.T) {
+	notifyID := "notify"
+	run := dbChaosExperimentRun.ChaosExperimentRun{NotifyID: &notifyID, InfraID: "infra"}
+
+	tests := []struct {
+		name            string
+		activeRuns      int64
+		wantCapacity    bool
+		wantBackInQueue bool
+	}{
+		{
+			name:         "claim is kept while the infra has capacity",
+			activeRuns:   1,
+			wantCapacity: true,
+		},
+		{
+			name:            "run is put back in the queue once the other active runs reach the limit",
+			activeRuns:      2,
+			wantBackInQueue: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mongodbMockOperator := new(dbMocks.MongoOperator)
+			d := NewRunDispatcher(mongodbMockOperator)
+
+			mongodbMockOperator.On("CountDocuments", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.Anything, mock.Anything).
+				Return(tc.activeRuns, nil).Once()
+			if tc.wantBackInQueue {
+				mongodbMockOperator.On("Update", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.Anything, mock.Anything, mock.Anything).
+					Return(&mongo.UpdateResult{MatchedCount: 1}, nil).Once()
+			}
+
+			hasCapacity, err := d.keepQueuedRunClaim(context.Background(), dbChaosInfra.ChaosInfra{InfraID: "infra", MaxConcurrentRuns: 2}, run)
+			if err != nil {
+				t.Fatalf("keepQueuedRunClaim() error = %v", err)
+			}
+			if hasCapacity != tc.wantCapacity {
+				t.Errorf("keepQueuedRunClaim() = %v, want %v", hasCapacity, tc.wantCapacity)
+			}
+			mongodbMockOperator.AssertExpectations(t)
+		})
+	}
+}
+
+func TestRunDispatcher_DispatchQueuedRuns_BlackoutWindow(t *testing.T) {
+	mongodbMockOperator := new(dbMocks.MongoOperator)
+	d := NewRunDispatcher(mongodbMockOperator)
+
+	now := time.Now().UnixMilli()
+	infra := bson.D{
+		{Key: "infra_id", Value: "infra"},
+		{Key: "project_id", Value: "project"},
+		{Key: "environment_id", Value: "env"},
+		{Key: "is_active", Value: true},
+	}
+	environment := bson.D{
+		{Key: "environment_id", Value: "env"},
+		{Key: "blackout_windows", Value: bson.A{
+			bson.D{{Key: "name", Value: "freeze"}, {Key: "start_time", Value: now - 1000}, {Key: "end_time", Value: now + 60000}},
+		}},
+	}
+	runs, err := mongo.NewCursorFromDocuments([]interface{}{
+		bson.D{{Key: "notify_id", Value: "notify"}, {Key: "infra_id", Value: "infra"}, {Key: "in_queue", Value: true}},
+	}, nil, nil)
+	if err != nil {
+		t.Fatalf("failed to create cursor, error: %v", err)
+	}
+	mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosInfraCollection, mock.Anything).Return(mongo.NewSingleResultFromDocument(infra, nil, nil), nil).Once()
+	mongodbMockOperator.On("List", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.Anything).Return(runs, nil).Once()
+	mongodbMockOperator.On("Get", mock.Anything, mongodb.EnvironmentCollection, mock.Anything).Return(mongo.NewSingleResultFromDocument(environment, nil, nil), nil).Once()
+
+	if err := d.DispatchQueuedRuns(context.Background(), "infra", store.NewStore()); err != nil {
+		t.Fatalf("DispatchQueuedRuns() error = %v", err)
+	}
+	// the run is not claimed during the blackout window
+	mongodbMockOperator.AssertNotCalled(t, "Update", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.Anything, mock.Anything, mock.Anything)
+	mongodbMockOperator.AssertExpectations(t)
+}
@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"context"
+	"errors"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// listExperimentRunsByCursor returns a page of the experiment runs sorted by their last update time. Unlike the
+// offset pagination, the runs of the page are matched with the indexed fields and limited before the details
+// of their experiment and infra are looked up, the filter on the experiment name is resolved to experiment IDs
+func (c *ChaosExperimentRunHandler) listExperimentRunsByCursor(ctx context.Context, projectID string, request model.ListExperimentRunRequest) (*model.ListExperimentRunResponse, error) {
+	if request.Pagination != nil {
+		return nil, errors.New("pagination and cursor can't be used together")
+	}
+	if request.Sort != nil && request.Sort.Field != model.ExperimentSortingFieldTime {
+		return nil, errors.New("experiment runs can only be sorted by time with cursor pagination")
+	}
+	ascending := request.Sort != nil && request.Sort.Ascending != nil && *request.Sort.Ascending
+
+	limit, err := mongodb.GetPageLimit(request.Cursor.Limit)
+	if err != nil {
+		return nil, err
+	}
+
+	query, err := c.getExperimentRunsCursorQuery(ctx, projectID, request)
+	if err != nil {
+		return nil, err
+	}
+	total, err := c.chaosExperimentRunOperator.CountExperimentRuns(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	pageQuery := query
+	if request.Cursor.After != nil && *request.Cursor.After != "" {
+		cursor, err := mongodb.DecodePageCursor(*request.Cursor.After)
+		if err != nil {
+			return nil, err
+		}
+		pageQuery = append(pageQuery, cursor.After(ascending))
+	}
+
+	// One more run is fetched to know if there is a next page
+	pipeline := mongo.Pipeline{
+		{{"$match", pageQuery}},
+		{{"$sort", mongodb.PageCursorSort(ascending)}},
+		{{"$limit", limit + 1}},
+		dbChaosExperimentRun.ExcludeExecutionDataStage(),
+		experimentDetailsLookupStage(),
+		infraDetailsLookupStage(),
+	}
+	runsCursor, err := c.chaosExperimentRunOperator.GetAggregateExperimentRuns(pipeline)
+	if err != nil {
+		return nil, errors.New("DB aggregate stage error: " + err.Error())
+	}
+
+	var runs []dbChaosExperiment.FlattenedExperimentRun
+	if err = runsCursor.All(ctx, &runs); err != nil {
+		return nil, errors.New("error decoding experiment runs cursor: " + err.Error())
+	}
+
+	response := &model.ListExperimentRunResponse{
+		TotalNoOfExperimentRuns: int(total),
+	}
+	if len(runs) > limit {
+		runs = runs[:limit]
+		nextCursor := mongodb.PageCursor{
+			UpdatedAt: runs[limit-1].UpdatedAt,
+			ID:        runs[limit-1].ID,
+		}.Encode()
+		response.NextCursor = &nextCursor
+	}
+	response.ExperimentRuns = getExperimentRuns(runs)
+
+	return response, nil
+}
+
+// getExperimentRunsCursorQuery returns the query matching the runs of the request, the query only contains
+// fields of the runs so that it can be served by the indexes of the collection
+func (c *ChaosExperimentRunHandler) getExperimentRunsCursorQuery(ctx context.Context, projectID string, request model.ListExperimentRunRequest) (bson.D, error) {
+	query := bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	if len(request.ExperimentRunIDs) > 0 {
+		query = append(query, bson.E{Key: "experiment_run_id", Value: bson.D{{"$in", request.ExperimentRunIDs}}})
+	}
+
+	filter := request.Filter
+	var experimentIDs interface{}
+	if len(request.ExperimentIDs) > 0 {
+		experimentIDs = request.ExperimentIDs
+	}
+	if filter != nil && filter.ExperimentName != nil && *filter.ExperimentName != "" {
+		namedExperimentIDs, err := c.getExperimentIDsByName(ctx, projectID, *filter.ExperimentName)
+		if err != nil {
+			return nil, err
+		}
+		experimentIDs = intersectExperimentIDs(request.ExperimentIDs, namedExperimentIDs)
+	}
+	if experimentIDs != nil {
+		query = append(query, bson.E{Key: "experiment_id", Value: bson.D{{"$in", experimentIDs}}})
+	}
+
+	if filter == nil {
+		return query, nil
+	}
+	if filter.ExperimentRunID != nil && *filter.ExperimentRunID != "" {
+		query = append(query, bson.E{Key: "experiment_run_id", Value: bson.D{{"$regex", *filter.ExperimentRunID}}})
+	}
+	if filter.InfraID != nil && *filter.InfraID != "All" && *filter.InfraID != "" {
+		query = append(query, bson.E{Key: "infra_id", Value: *filter.InfraID})
+	}
+	// both the status and the array of statuses filter the phase of the runs
+	var phases bson.A
+	if len(filter.ExperimentRunStatus) > 0 {
+		phases = append(phases, bson.D{{"phase", bson.D{{"$in", filter.ExperimentRunStatus}}}})
+	}
+	if filter.ExperimentStatus != nil && *filter.ExperimentStatus != "All" && *filter.ExperimentStatus != "" {
+		phases = append(phases, bson.D{{"phase", string(*filter.ExperimentStatus)}})
+	}
+	if len(phases) > 0 {
+		query = append(query, bson.E{Key: "$and", Value: phases})
+	}
+	if filter.DateRange != nil {
+		updatedAt, err := mongodb.TimestampRangeQuery(filter.DateRange.StartDate, filter.DateRange.EndDate)
+		if err != nil {
+			return nil, err
+		}
+		query = append(query, bson.E{Key: "updated_at", Value: updatedAt})
+	}
+
+	return query, nil
+}
+
+// getExperimentIDsByName returns the IDs of the experiments of the project whose name matches the pattern
+func (c *ChaosExperimentRunHandler) getExperimentIDsByName(ctx context.Context, projectID string, name string) ([]string, error) {
+	opts := options.Find().SetProjection(bson.D{{"experiment_id", 1}})
+	cursor, err := c.mongodbOperator.List(ctx, mongodb.ChaosExperimentCollection, bson.D{
+		{"project_id", projectID},
+		{"name", bson.D{{"$regex", name}}},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var experiments []struct {
+		ExperimentID string `bson:"experiment_id"`
+	}
+	if err = cursor.All(ctx, &experiments); err != nil {
+		return nil, err
+	}
+	experimentIDs := make([]string, 0, len(experiments))
+	for _, experiment := range experiments {
+		experimentIDs = append(experimentIDs, experiment.ExperimentID)
+	}
+	return experimentIDs, nil
+}
+
+// intersectExperimentIDs returns the experiment IDs matched by the name which are among the requested experiment IDs,
+// all the matched experiment IDs are returned if no experiment ID was requested
+func intersectExperimentIDs(requestedIDs []*string, matchedIDs []string) []string {
+	if len(requestedIDs) == 0 {
+		return matchedIDs
+	}
+
+	requested := make(map[string]bool, len(requestedIDs))
+	for _, id := range requestedIDs {
+		if id != nil {
+			requested[*id] = true
+		}
+	}
+	experimentIDs := []string{}
+	for _, id := range matchedIDs {
+		if requested[id] {
+			experimentIDs = append(experimentIDs, id)
+		}
+	}
+	return experimentIDs
+}
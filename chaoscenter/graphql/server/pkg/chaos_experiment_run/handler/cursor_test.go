@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestIntersectExperimentIDs(t *testing.T) {
+	first, second := "experiment-1", "experiment-2"
+
+	tests := []struct {
+		name      string
+		requested []*string
+		matched   []string
+		want      []string
+	}{
+		{
+			name:    "no requested experiment",
+			matched: []string{first, second},
+			want:    []string{first, second},
+		},
+		{
+			name:      "requested experiments matched by name",
+			requested: []*string{&second},
+			matched:   []string{first, second},
+			want:      []string{second},
+		},
+		{
+			name:      "requested experiments not matched by name",
+			requested: []*string{&first},
+			matched:   []string{second},
+			want:      []string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := intersectExperimentIDs(tc.requested, tc.matched); !reflect.DeepEqual(got, tc.want) {
+				t.Errorf("intersectExperimentIDs() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -33,6 +33,8 @@ import (
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbFaultResult "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/fault_result"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/fault_result"
 
 	"github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
@@ -45,6 +47,7 @@ import (
 
 	"github.com/google/uuid"
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
 )
 
 // ChaosExperimentRunHandler is the handler for chaos experiment
@@ -56,6 +59,20 @@ type ChaosExperimentRunHandler struct {
 	chaosExperimentRunOperator *dbChaosExperimentRun.Operator
 	probeService               probe.Service
 	mongodbOperator            mongodb.MongoOperator
+	faultResultOperator        *dbFaultResult.Operator
+	runDispatcher              *types.RunDispatcher
+	experimentRunListeners     []ExperimentRunListener
+}
+
+// ExperimentRunListener is notified once an experiment run is completed
+type ExperimentRunListener interface {
+	ExperimentRunCompleted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, r *store.StateData)
+}
+
+// ExperimentRunStartListener can be implemented by an ExperimentRunListener to be notified
+// once an experiment run is started on the infra as well
+type ExperimentRunStartListener interface {
+	ExperimentRunStarted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun)
 }
 
 // NewChaosExperimentRunHandler returns a new instance of ChaosWorkflowHandler
@@ -76,9 +93,16 @@ func NewChaosExperimentRunHandler(
 		chaosExperimentRunOperator: chaosExperimentRunOperator,
 		probeService:               probeService,
 		mongodbOperator:            mongodbOperator,
+		faultResultOperator:        dbFaultResult.NewFaultResultOperator(mongodbOperator),
+		runDispatcher:              types.NewRunDispatcher(mongodbOperator),
 	}
 }
 
+// AddExperimentRunListener registers a listener which is notified once an experiment run is completed
+func (c *ChaosExperimentRunHandler) AddExperimentRunListener(listener ExperimentRunListener) {
+	c.experimentRunListeners = append(c.experimentRunListeners, listener)
+}
+
 // GetExperimentRun returns details of a requested experiment run
 func (c *ChaosExperimentRunHandler) GetExperimentRun(ctx context.Context, projectID string, experimentRunID *string, notifyID *string) (*model.ExperimentRun, error) {
 	var pipeline mongo.Pipeline
@@ -114,6 +138,9 @@ func (c *ChaosExperimentRunHandler) GetExperimentRun(ctx context.Context, projec
 		pipeline = append(pipeline, matchIdentifiersStage)
 	}
 
+	// The execution data is loaded separately, only when it is requested
+	pipeline = append(pipeline, dbChaosExperimentRun.ExcludeExecutionDataStage())
+
 	// Adds details of experiment
 	addExperimentDetails := bson.D{
 		{"$lookup",
@@ -256,10 +283,14 @@ func (c *ChaosExperimentRunHandler) GetExperimentRun(ctx context.Context, projec
 			FaultsStopped:      wfRun.FaultsStopped,
 			FaultsNa:           wfRun.FaultsNA,
 			TotalFaults:        wfRun.TotalFaults,
-			ExecutionData:      wfRun.ExecutionData,
 			IsRemoved:          &wfRun.IsRemoved,
 			RunSequence:        int(wfRun.RunSequence),
+			Inputs:             getExperimentRunInputs(wfRun.Inputs),
+			Priority:           &wfRun.Priority,
 
+			ResiliencyScoreStrategy: getExperimentRunScoreStrategy(wfRun.ScoreStrategy),
+			PrunedAt:                getExperimentRunPrunedAt(wfRun.PrunedAt),
+			ArchiveLocation:         getExperimentRunArchiveLocation(wfRun.ArchiveLocation),
 			UpdatedBy: &model.UserDetails{
 				Username: wfRun.UpdatedBy.Username,
 			},
@@ -273,6 +304,10 @@ func (c *ChaosExperimentRunHandler) GetExperimentRun(ctx context.Context, projec
 
 // ListExperimentRun returns all the workflow runs for matching identifiers from the DB
 func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request model.ListExperimentRunRequest) (*model.ListExperimentRunResponse, error) {
+	if request.Cursor != nil {
+		return c.listExperimentRunsByCursor(context.Background(), projectID, request)
+	}
+
 	var pipeline mongo.Pipeline
 
 	// Matching with identifiers
@@ -325,38 +360,10 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 	}
 	pipeline = append(pipeline, matchExpIsRemovedStage)
 
-	addExperimentDetails := bson.D{
-		{
-			"$lookup",
-			bson.D{
-				{"from", "chaosExperiments"},
-				{"let", bson.D{{"experimentID", "$experiment_id"}, {"revID", "$revision_id"}}},
-				{
-					"pipeline", bson.A{
-						bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$experiment_id", "$$experimentID"}}}}}}},
-						bson.D{
-							{"$project", bson.D{
-								{"name", 1},
-								{"experiment_type", 1},
-								{"is_custom_experiment", 1},
-								{"revision", bson.D{{
-									"$filter", bson.D{
-										{"input", "$revision"},
-										{"as", "revs"},
-										{"cond", bson.D{{
-											"$eq", bson.A{"$$revs.revision_id", "$$revID"},
-										}}},
-									},
-								}}},
-							}},
-						},
-					},
-				},
-				{"as", "experiment"},
-			},
-		},
-	}
-	pipeline = append(pipeline, addExperimentDetails)
+	// The execution data is loaded separately, only for the runs it is requested for
+	pipeline = append(pipeline, dbChaosExperimentRun.ExcludeExecutionDataStage())
+
+	pipeline = append(pipeline, experimentDetailsLookupStage())
 
 	// Filtering based on multiple parameters
 	if request.Filter != nil {
@@ -478,35 +485,7 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 		}
 	}
 
-	// fetchKubernetesInfraDetailsStage adds infra details of corresponding experiment_id to each document
-	fetchKubernetesInfraDetailsStage := bson.D{
-		{"$lookup", bson.D{
-			{"from", "chaosInfrastructures"},
-			{"let", bson.M{"infraID": "$infra_id"}},
-			{
-				"pipeline", bson.A{
-					bson.D{
-						{"$match", bson.D{
-							{"$expr", bson.D{
-								{"$eq", bson.A{"$infra_id", "$$infraID"}},
-							}},
-						}},
-					},
-					bson.D{
-						{"$project", bson.D{
-							{"token", 0},
-							{"infra_ns_exists", 0},
-							{"infra_sa_exists", 0},
-							{"access_key", 0},
-						}},
-					},
-				},
-			},
-			{"as", "kubernetesInfraDetails"},
-		}},
-	}
-
-	pipeline = append(pipeline, fetchKubernetesInfraDetailsStage)
+	pipeline = append(pipeline, infraDetailsLookupStage())
 
 	// Pagination or adding a default limit of 15 if pagination not provided
 	paginatedExperiments := bson.A{
@@ -565,7 +544,90 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 		}, nil
 	}
 
-	for _, workflow := range workflows[0].FlattenedExperimentRuns {
+	result = getExperimentRuns(workflows[0].FlattenedExperimentRuns)
+
+	totalFilteredExperimentRunsCounter := 0
+	if len(workflows) > 0 && len(workflows[0].TotalFilteredExperimentRuns) > 0 {
+		totalFilteredExperimentRunsCounter = workflows[0].TotalFilteredExperimentRuns[0].Count
+	}
+
+	output := model.ListExperimentRunResponse{
+		TotalNoOfExperimentRuns: totalFilteredExperimentRunsCounter,
+		ExperimentRuns:          result,
+	}
+
+	return &output, nil
+}
+
+// experimentDetailsLookupStage adds the name, type and revision of its experiment to each run
+func experimentDetailsLookupStage() bson.D {
+	return bson.D{
+		{
+			"$lookup",
+			bson.D{
+				{"from", "chaosExperiments"},
+				{"let", bson.D{{"experimentID", "$experiment_id"}, {"revID", "$revision_id"}}},
+				{
+					"pipeline", bson.A{
+						bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$experiment_id", "$$experimentID"}}}}}}},
+						bson.D{
+							{"$project", bson.D{
+								{"name", 1},
+								{"experiment_type", 1},
+								{"is_custom_experiment", 1},
+								{"revision", bson.D{{
+									"$filter", bson.D{
+										{"input", "$revision"},
+										{"as", "revs"},
+										{"cond", bson.D{{
+											"$eq", bson.A{"$$revs.revision_id", "$$revID"},
+										}}},
+									},
+								}}},
+							}},
+						},
+					},
+				},
+				{"as", "experiment"},
+			},
+		},
+	}
+}
+
+// infraDetailsLookupStage adds the details of its infra to each run, the secrets of the infra are excluded
+func infraDetailsLookupStage() bson.D {
+	return bson.D{
+		{"$lookup", bson.D{
+			{"from", "chaosInfrastructures"},
+			{"let", bson.M{"infraID": "$infra_id"}},
+			{
+				"pipeline", bson.A{
+					bson.D{
+						{"$match", bson.D{
+							{"$expr", bson.D{
+								{"$eq", bson.A{"$infra_id", "$$infraID"}},
+							}},
+						}},
+					},
+					bson.D{
+						{"$project", bson.D{
+							{"token", 0},
+							{"infra_ns_exists", 0},
+							{"infra_sa_exists", 0},
+							{"access_key", 0},
+						}},
+					},
+				},
+			},
+			{"as", "kubernetesInfraDetails"},
+		}},
+	}
+}
+
+// getExperimentRuns converts the runs flattened with the details of their experiment and infra to the GraphQL model
+func getExperimentRuns(runs []dbChaosExperiment.FlattenedExperimentRun) []*model.ExperimentRun {
+	var result []*model.ExperimentRun
+	for _, workflow := range runs {
 		var (
 			weightages          []*model.Weightages
 			workflowRunManifest string
@@ -616,6 +678,7 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 			ExperimentType:     &workflowType,
 			ExperimentID:       workflow.ExperimentID,
 			ExperimentRunID:    workflow.ExperimentRunID,
+			NotifyID:           workflow.NotifyID,
 			Weightages:         weightages,
 			ExperimentManifest: workflowRunManifest,
 			ProjectID:          workflow.ProjectID,
@@ -628,7 +691,6 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 			FaultsStopped:      workflow.FaultsStopped,
 			FaultsNa:           workflow.FaultsNA,
 			TotalFaults:        workflow.TotalFaults,
-			ExecutionData:      workflow.ExecutionData,
 			IsRemoved:          &workflow.IsRemoved,
 			UpdatedBy: &model.UserDetails{
 				Username: workflow.UpdatedBy.Username,
@@ -636,26 +698,40 @@ func (c *ChaosExperimentRunHandler) ListExperimentRun(projectID string, request
 			UpdatedAt:   strconv.FormatInt(workflow.UpdatedAt, 10),
 			CreatedAt:   strconv.FormatInt(workflow.CreatedAt, 10),
 			RunSequence: int(workflow.RunSequence),
+			Inputs:      getExperimentRunInputs(workflow.Inputs),
+			Priority:    &workflow.Priority,
+
+			ResiliencyScoreStrategy: getExperimentRunScoreStrategy(workflow.ScoreStrategy),
+			PrunedAt:                getExperimentRunPrunedAt(workflow.PrunedAt),
+			ArchiveLocation:         getExperimentRunArchiveLocation(workflow.ArchiveLocation),
 		}
 		result = append(result, &newExperimentRun)
 	}
+	return result
+}
 
-	totalFilteredExperimentRunsCounter := 0
-	if len(workflows) > 0 && len(workflows[0].TotalFilteredExperimentRuns) > 0 {
-		totalFilteredExperimentRunsCounter = workflows[0].TotalFilteredExperimentRuns[0].Count
-	}
+// RunChaosWorkFlow sends workflow run request(single run workflow only) to chaos_infra on workflow re-run request,
+// the provided inputs are substituted in the experiment manifest before it is sent to the chaos_infra
+func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, inputs []*model.ExperimentInputValueRequest, priority *int, r *store.StateData) (*model.RunChaosExperimentResponse, error) {
+	return c.runChaosWorkFlow(ctx, projectID, workflow, inputs, priority, "", "", r)
+}
 
-	output := model.ListExperimentRunResponse{
-		TotalNoOfExperimentRuns: totalFilteredExperimentRunsCounter,
-		ExperimentRuns:          result,
+// RunChaosWorkFlowForUser runs a non-cron experiment on behalf of the given user, it is used to
+// run experiments outside a user request, e.g. for the stages of a chaos pipeline. The notify ID
+// of the run is generated if it is not provided
+func (c *ChaosExperimentRunHandler) RunChaosWorkFlowForUser(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, username string, notifyID string, r *store.StateData) (*model.RunChaosExperimentResponse, error) {
+	if username == "" {
+		return nil, errors.New("username not provided")
 	}
-
-	return &output, nil
+	if workflow.ExperimentType == dbChaosExperiment.CronExperiment {
+		return nil, errors.New("cron experiments are scheduled by the infra and can not be run on behalf of a user")
+	}
+	return c.runChaosWorkFlow(ctx, projectID, workflow, nil, nil, username, notifyID, r)
 }
 
-// RunChaosWorkFlow sends workflow run request(single run workflow only) to chaos_infra on workflow re-run request
-func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, r *store.StateData) (*model.RunChaosExperimentResponse, error) {
-	var notifyID string
+// runChaosWorkFlow runs the experiment, the user is taken from the request context if the username is not provided
+// and the notify ID is generated if it is not provided
+func (c *ChaosExperimentRunHandler) runChaosWorkFlow(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, inputs []*model.ExperimentInputValueRequest, priority *int, username string, notifyID string, r *store.StateData) (*model.RunChaosExperimentResponse, error) {
 	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(workflow.InfraID)
 	if err != nil {
 		return nil, err
@@ -680,15 +756,44 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 
 	resKind := gjson.Get(workflow.Revision[0].ExperimentManifest, "kind").String()
 	if strings.ToLower(resKind) == "cronworkflow" {
+		if len(inputs) > 0 {
+			return nil, errors.New("inputs can not be provided for a cron experiment, default values are used instead")
+		}
+		if priority != nil {
+			return nil, errors.New("priority can not be provided for a cron experiment as its runs are scheduled by the infra")
+		}
 		return &model.RunChaosExperimentResponse{NotifyID: notifyID}, c.RunCronExperiment(ctx, projectID, workflow, r)
 	}
-	notifyID = uuid.New().String()
 
-	err = json.Unmarshal([]byte(workflow.Revision[0].ExperimentManifest), &workflowManifest)
+	if err := envHandler.ValidateInfraBlackoutWindow(ctx, c.mongodbOperator, infra); err != nil {
+		return nil, err
+	}
+	if notifyID == "" {
+		notifyID = uuid.New().String()
+	}
+
+	inputValues, err := utils.ResolveExperimentInputs(workflow.Revision[0].ExperimentManifest, inputs)
+	if err != nil {
+		return nil, err
+	}
+	experimentManifest, err := utils.SubstituteExperimentInputs(workflow.Revision[0].ExperimentManifest, inputValues)
+	if err != nil {
+		return nil, err
+	}
+
+	err = json.Unmarshal([]byte(experimentManifest), &workflowManifest)
 	if err != nil {
 		return nil, errors.New("failed to unmarshal workflow manifest")
 	}
 
+	var runInputs []dbChaosExperimentRun.InputValue
+	for _, value := range inputValues {
+		runInputs = append(runInputs, dbChaosExperimentRun.InputValue{
+			Name:  value.Name,
+			Value: value.Value,
+		})
+	}
+
 	var resScore float64 = 0
 
 	if _, found := workflowManifest.Labels["infra_id"]; !found {
@@ -763,6 +868,28 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 		}
 	}
 
+	// Convert updated manifest to string
+	manifestString, err := json.Marshal(workflowManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to marshal experiment manifest, err: %v", err)
+	}
+
+	// Generate Probe in the manifest
+	workflowManifest, err = c.probeService.GenerateExperimentManifestWithProbes(string(manifestString), projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate probes in workflow manifest, err: %v", err)
+	}
+
+	manifest, err := yaml.Marshal(workflowManifest)
+	if err != nil {
+		return nil, err
+	}
+
+	var runPriority int
+	if priority != nil {
+		runPriority = *priority
+	}
+
 	// Updating updated_at field
 	filter := bson.D{
 		{"experiment_id", workflow.ExperimentID},
@@ -798,10 +925,12 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 		txnOpts = options.Transaction().SetWriteConcern(wc).SetReadConcern(rc)
 	)
 
-	tkn := ctx.Value(authorization.AuthKey).(string)
-	username, err := authorization.GetUsername(tkn)
-	if err != nil {
-		return nil, err
+	if username == "" {
+		tkn := ctx.Value(authorization.AuthKey).(string)
+		username, err = authorization.GetUsername(tkn)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	session, err := mongodb.MgoClient.StartSession()
@@ -862,12 +991,14 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 			logrus.Error("Failed to update experiment collection")
 		}
 
+		// The run is queued and sent to the infra by the dispatcher once the infra has capacity
 		err = c.chaosExperimentRunOperator.CreateExperimentRun(sessionContext, dbChaosExperimentRun.ChaosExperimentRun{
-			InfraID:      workflow.InfraID,
-			ExperimentID: workflow.ExperimentID,
-			Phase:        string(model.ExperimentRunStatusQueued),
-			RevisionID:   workflow.Revision[0].RevisionID,
-			ProjectID:    projectID,
+			InfraID:        workflow.InfraID,
+			ExperimentID:   workflow.ExperimentID,
+			ExperimentName: workflow.Name,
+			Phase:          string(model.ExperimentRunStatusQueued),
+			RevisionID:     workflow.Revision[0].RevisionID,
+			ProjectID:      projectID,
 			Audit: mongodb.Audit{
 				IsRemoved: false,
 				CreatedAt: currentTime,
@@ -885,6 +1016,10 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 			ExecutionData:   string(parsedData),
 			RunSequence:     workflow.TotalExperimentRuns + 1,
 			Probes:          probes,
+			Inputs:          runInputs,
+			Priority:        runPriority,
+			InQueue:         true,
+			QueuedManifest:  string(manifest),
 		})
 		if err != nil {
 			logrus.Error("Failed to create run operation in db")
@@ -908,28 +1043,8 @@ func (c *ChaosExperimentRunHandler) RunChaosWorkFlow(ctx context.Context, projec
 
 	session.EndSession(ctx)
 
-	// Convert updated manifest to string
-	manifestString, err := json.Marshal(workflowManifest)
-	if err != nil {
-		return nil, fmt.Errorf("failed to marshal experiment manifest, err: %v", err)
-	}
-
-	// Generate Probe in the manifest
-	workflowManifest, err = c.probeService.GenerateExperimentManifestWithProbes(string(manifestString), projectID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to generate probes in workflow manifest, err: %v", err)
-	}
-
-	manifest, err := yaml.Marshal(workflowManifest)
-	if err != nil {
-		return nil, err
-	}
-	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, &model.ChaosExperimentRequest{
-			ExperimentID:       &workflow.ExperimentID,
-			ExperimentManifest: string(manifest),
-			InfraID:            workflow.InfraID,
-		}, &username, nil, "create", r)
+	if err := c.runDispatcher.DispatchQueuedRuns(ctx, workflow.InfraID, r); err != nil {
+		logrus.Errorf("failed to dispatch the queued experiment runs of infra %s, error: %v", workflow.InfraID, err)
 	}
 	return &model.RunChaosExperimentResponse{
 		NotifyID: notifyID,
@@ -944,11 +1059,26 @@ func (c *ChaosExperimentRunHandler) RunCronExperiment(ctx context.Context, proje
 	if len(workflow.Revision) == 0 {
 		return errors.New("no revisions found")
 	}
+
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(workflow.InfraID)
+	if err != nil {
+		return err
+	}
+	if err := envHandler.ValidateInfraBlackoutWindow(ctx, c.mongodbOperator, infra); err != nil {
+		return err
+	}
+
 	sort.Slice(workflow.Revision, func(i, j int) bool {
 		return workflow.Revision[i].UpdatedAt > workflow.Revision[j].UpdatedAt
 	})
 
-	cronExperimentManifest, err := c.probeService.GenerateCronExperimentManifestWithProbes(workflow.Revision[0].ExperimentManifest, workflow.ProjectID)
+	// Cron experiments are scheduled by the chaos_infra, hence the default values of the inputs are used
+	experimentManifest, err := utils.ApplyDefaultExperimentInputs(workflow.Revision[0].ExperimentManifest)
+	if err != nil {
+		return err
+	}
+
+	cronExperimentManifest, err = c.probeService.GenerateCronExperimentManifestWithProbes(experimentManifest, workflow.ProjectID)
 	if err != nil {
 		return errors.New("failed to unmarshal experiment manifest")
 	}
@@ -1009,7 +1139,7 @@ func (c *ChaosExperimentRunHandler) RunCronExperiment(ctx context.Context, proje
 	}
 
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, &model.ChaosExperimentRequest{
 			ExperimentID:       &workflow.ExperimentID,
 			ExperimentManifest: string(manifest),
 			InfraID:            workflow.InfraID,
@@ -1078,7 +1208,9 @@ func (c *ChaosExperimentRunHandler) GetExperimentRunStats(ctx context.Context, p
 	}, nil
 }
 
-func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.ExperimentRunRequest) (string, error) {
+// ChaosExperimentRunEvent processes the experiment run updates sent by the chaos infrastructure
+// and publishes the latest state of the run to the clients subscribed to it
+func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.ExperimentRunRequest, r *store.StateData) (string, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
@@ -1130,6 +1262,24 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 		}
 	}
 
+	// Runs of cron experiments are started by the chaos infrastructure, hence the runs
+	// started during a blackout window of the environment are stopped and recorded as skipped
+	if experiment.ExperimentType == dbChaosExperiment.CronExperiment && event.NotifyID == nil && !event.Completed {
+		skipped, err := c.skipBlackoutExperimentRun(ctx, experiment, event, r)
+		if err != nil {
+			return "", err
+		}
+		if skipped {
+			logrus.WithFields(logFields).Info("experiment run skipped due to an active blackout window")
+			executionData.Phase = string(model.ExperimentRunStatusSkipped)
+			exeData, err = json.Marshal(executionData)
+			if err != nil {
+				return "", err
+			}
+			event.Completed = true
+		}
+	}
+
 	var workflowRunMetrics types.ExperimentRunMetrics
 	// Resiliency Score will be calculated only if workflow execution is completed
 	if event.Completed {
@@ -1155,8 +1305,9 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 	}
 	//
 	var (
-		isRemoved   = false
-		currentTime = time.Now()
+		isRemoved    = false
+		isRunStarted = false
+		currentTime  = time.Now()
 	)
 
 	err = mongo.WithSession(ctx, session, func(sessionContext mongo.SessionContext) error {
@@ -1181,6 +1332,17 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 		if err != nil {
 			return err
 		}
+
+		// The run is started by the first event of the infra, the runs triggered from the
+		// server are created beforehand without the experiment run ID of the infra
+		isRunStarted = experimentRunCount == 0
+		if experimentRunCount > 0 && event.NotifyID != nil {
+			existingRun, err := c.chaosExperimentRunOperator.GetExperimentRun(query)
+			if err != nil {
+				return err
+			}
+			isRunStarted = existingRun.ExperimentRunID == ""
+		}
 		updatedBy, err := base64.RawURLEncoding.DecodeString(event.UpdatedBy)
 		if err != nil {
 			logrus.Fatalf("Failed to parse updated by field %v", err)
@@ -1280,6 +1442,7 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 			FaultsStopped:   &workflowRunMetrics.ExperimentsStopped,
 			FaultsNA:        &workflowRunMetrics.ExperimentsNA,
 			TotalFaults:     &workflowRunMetrics.TotalExperiments,
+			ScoreStrategy:   workflowRunMetrics.ResiliencyScoreStrategy,
 			ExecutionData:   string(exeData),
 			RevisionID:      event.RevisionID,
 			Completed:       event.Completed,
@@ -1323,5 +1486,307 @@ func (c *ChaosExperimentRunHandler) ChaosExperimentRunEvent(event model.Experime
 
 	session.EndSession(ctx)
 
+	if r != nil {
+		c.PublishExperimentRunEvent(ctx, experiment.ProjectID, event.ExperimentRunID, event.NotifyID, event.Completed, r)
+	}
+
+	if isRunStarted && executionData.Phase != string(model.ExperimentRunStatusSkipped) {
+		startedRun := dbChaosExperimentRun.ChaosExperimentRun{
+			ProjectID:       experiment.ProjectID,
+			InfraID:         experiment.InfraID,
+			ExperimentRunID: event.ExperimentRunID,
+			ExperimentID:    event.ExperimentID,
+			ExperimentName:  experiment.Name,
+			NotifyID:        event.NotifyID,
+			Phase:           executionData.Phase,
+		}
+		for _, listener := range c.experimentRunListeners {
+			if startListener, ok := listener.(ExperimentRunStartListener); ok {
+				startListener.ExperimentRunStarted(ctx, startedRun)
+			}
+		}
+	}
+
+	// A completed run frees a slot of the infra for its queued runs
+	if event.Completed {
+		if err := c.runDispatcher.DispatchQueuedRuns(ctx, experiment.InfraID, r); err != nil {
+			logrus.WithFields(logFields).Errorf("failed to dispatch the queued experiment runs, error: %v", err)
+		}
+
+		completedRun := dbChaosExperimentRun.ChaosExperimentRun{
+			ProjectID:       experiment.ProjectID,
+			InfraID:         experiment.InfraID,
+			ExperimentRunID: event.ExperimentRunID,
+			ExperimentID:    event.ExperimentID,
+			ExperimentName:  experiment.Name,
+			NotifyID:        event.NotifyID,
+			Phase:           executionData.Phase,
+			ResiliencyScore: &workflowRunMetrics.ResiliencyScore,
+			FaultsPassed:    &workflowRunMetrics.ExperimentsPassed,
+			FaultsFailed:    &workflowRunMetrics.ExperimentsFailed,
+			FaultsAwaited:   &workflowRunMetrics.ExperimentsAwaited,
+			FaultsStopped:   &workflowRunMetrics.ExperimentsStopped,
+			FaultsNA:        &workflowRunMetrics.ExperimentsNA,
+			TotalFaults:     &workflowRunMetrics.TotalExperiments,
+			ScoreStrategy:   workflowRunMetrics.ResiliencyScoreStrategy,
+			Completed:       true,
+		}
+
+		// The results of the faults are only extracted once the run is completed, failing to store them
+		// doesn't discard the run as they are only used by the fault analytics
+		faultResults := fault_result.ExtractFaultResults(completedRun, executionData, currentTime.UnixMilli())
+		if err := c.faultResultOperator.UpsertFaultResults(ctx, faultResults); err != nil {
+			logrus.WithFields(logFields).Errorf("failed to store the fault results of the experiment run, error: %v", err)
+		}
+
+		for _, listener := range c.experimentRunListeners {
+			listener.ExperimentRunCompleted(ctx, completedRun, r)
+		}
+	}
+
 	return fmt.Sprintf("Experiment run received for for ExperimentID: %s, ExperimentRunID: %s", event.ExperimentID, event.ExperimentRunID), nil
 }
+
+// skipBlackoutExperimentRun stops a new experiment run if a blackout window of the infra's environment is active
+func (c *ChaosExperimentRunHandler) skipBlackoutExperimentRun(ctx context.Context, experiment dbChaosExperiment.ChaosExperimentRequest, event model.ExperimentRunRequest, r *store.StateData) (bool, error) {
+	count, err := c.chaosExperimentRunOperator.CountExperimentRuns(ctx, bson.D{
+		{"experiment_id", event.ExperimentID},
+		{"experiment_run_id", event.ExperimentRunID},
+	})
+	if err != nil || count > 0 {
+		return false, err
+	}
+
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(experiment.InfraID)
+	if err != nil {
+		return false, err
+	}
+	window, err := envHandler.GetInfraBlackoutWindow(ctx, c.mongodbOperator, infra)
+	if err != nil || window == nil {
+		return false, err
+	}
+
+	if r != nil {
+		username := envHandler.BlackoutWindowUsername
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, experiment.ProjectID, &model.ChaosExperimentRequest{
+			InfraID: experiment.InfraID,
+		}, &username, &event.ExperimentRunID, "workflow_run_stop", r)
+	}
+	return true, nil
+}
+
+// PublishExperimentRunEvent sends the latest state of an experiment run to all the clients subscribed to it
+// either by experiment run ID or by notify ID, the subscriptions are closed once the run is completed
+func (c *ChaosExperimentRunHandler) PublishExperimentRunEvent(ctx context.Context, projectID string, experimentRunID string, notifyID *string, completed bool, r *store.StateData) {
+	var keys []string
+	if experimentRunID != "" {
+		keys = append(keys, store.ExperimentRunEventKey(projectID, experimentRunID))
+	}
+	if notifyID != nil && *notifyID != "" {
+		keys = append(keys, store.ExperimentRunEventKey(projectID, *notifyID))
+	}
+
+	// The subscribers of the other replicas are unknown, the run is only skipped when it can't reach them
+	if !r.PubSub.Distributed() {
+		r.Mutex.Lock()
+		hasObservers := false
+		for _, key := range keys {
+			if len(r.ExperimentEventPublish[key]) > 0 {
+				hasObservers = true
+			}
+		}
+		r.Mutex.Unlock()
+
+		if !hasObservers {
+			return
+		}
+	}
+
+	logFields := logrus.Fields{
+		"projectID":       projectID,
+		"experimentRunID": experimentRunID,
+	}
+	// the runs which haven't been started by the infra yet are only known by their notify ID
+	expRun, err := c.GetExperimentRun(ctx, projectID, &experimentRunID, notifyID)
+	if err != nil {
+		logrus.WithFields(logFields).Warnf("failed to fetch experiment run for subscribers, error: %v", err)
+		return
+	}
+
+	for _, key := range keys {
+		if err := r.PublishExperimentRun(ctx, key, expRun, completed); err != nil {
+			logrus.WithFields(logFields).Warnf("failed to publish experiment run to subscribers, error: %v", err)
+		}
+	}
+}
+
+// CompareExperimentRuns compares two experiment runs of a project fault by fault along with the
+// differences between the manifests of the revisions used by them
+func (c *ChaosExperimentRunHandler) CompareExperimentRuns(ctx context.Context, projectID string, runA string, runB string) (*model.CompareExperimentRunsResponse, error) {
+	expRunA, execDataA, err := c.getExperimentRunWithExecutionData(projectID, runA)
+	if err != nil {
+		return nil, err
+	}
+	expRunB, execDataB, err := c.getExperimentRunWithExecutionData(projectID, runB)
+	if err != nil {
+		return nil, err
+	}
+
+	summaryA := getExperimentRunComparisonSummary(expRunA, execDataA)
+	summaryB := getExperimentRunComparisonSummary(expRunB, execDataB)
+
+	response := &model.CompareExperimentRunsResponse{
+		RunA:              summaryA,
+		RunB:              summaryB,
+		Faults:            types.CompareExecutionData(execDataA, execDataB),
+		IsRevisionChanged: expRunA.ExperimentID != expRunB.ExperimentID || expRunA.RevisionID != expRunB.RevisionID,
+		ManifestDiff:      []*model.ManifestDiff{},
+	}
+	if summaryA.ResiliencyScore != nil && summaryB.ResiliencyScore != nil {
+		scoreDifference := utils.Truncate(*summaryB.ResiliencyScore - *summaryA.ResiliencyScore)
+		response.ResiliencyScoreDifference = &scoreDifference
+	}
+	if summaryA.Duration != nil && summaryB.Duration != nil {
+		durationDifference := *summaryB.Duration - *summaryA.Duration
+		response.DurationDifference = &durationDifference
+	}
+
+	if response.IsRevisionChanged {
+		manifestA, err := c.getRevisionManifest(ctx, expRunA.ExperimentID, expRunA.RevisionID)
+		if err != nil {
+			return nil, err
+		}
+		manifestB, err := c.getRevisionManifest(ctx, expRunB.ExperimentID, expRunB.RevisionID)
+		if err != nil {
+			return nil, err
+		}
+		diff, err := utils.DiffManifests(manifestA, manifestB)
+		if err != nil {
+			return nil, err
+		}
+		if diff != nil {
+			response.ManifestDiff = diff
+		}
+	}
+
+	return response, nil
+}
+
+// getExperimentRunWithExecutionData fetches an experiment run along with its parsed execution data
+func (c *ChaosExperimentRunHandler) getExperimentRunWithExecutionData(projectID string, experimentRunID string) (dbChaosExperimentRun.ChaosExperimentRun, types.ExecutionData, error) {
+	var executionData types.ExecutionData
+
+	expRun, err := c.chaosExperimentRunOperator.GetExperimentRun(bson.D{
+		{"experiment_run_id", experimentRunID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return expRun, executionData, fmt.Errorf("failed to get experiment run %s, error: %v", experimentRunID, err)
+	}
+
+	data, err := expRun.GetExecutionData()
+	if err != nil {
+		return expRun, executionData, fmt.Errorf("failed to decode execution data of experiment run %s, error: %v", experimentRunID, err)
+	}
+	if data != "" {
+		if err = json.Unmarshal([]byte(data), &executionData); err != nil {
+			return expRun, executionData, fmt.Errorf("failed to parse execution data of experiment run %s, error: %v", experimentRunID, err)
+		}
+	}
+
+	return expRun, executionData, nil
+}
+
+// GetExperimentRunExecutionData returns the execution data of an experiment run, the run is identified by its
+// experiment run ID or by its notify ID if it was not started by the infra yet
+func (c *ChaosExperimentRunHandler) GetExperimentRunExecutionData(ctx context.Context, projectID string, experimentRunID string, notifyID *string) (string, error) {
+	query := bson.D{
+		{"project_id", projectID},
+		{"experiment_run_id", experimentRunID},
+	}
+	if experimentRunID == "" {
+		if notifyID == nil || *notifyID == "" {
+			return "", nil
+		}
+		query = bson.D{
+			{"project_id", projectID},
+			{"notify_id", notifyID},
+		}
+	}
+
+	executionData, err := c.chaosExperimentRunOperator.GetExperimentRunExecutionData(ctx, query)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return "", nil
+	}
+	return executionData, err
+}
+
+// getRevisionManifest returns the manifest of the given revision of an experiment
+func (c *ChaosExperimentRunHandler) getRevisionManifest(ctx context.Context, experimentID string, revisionID string) (string, error) {
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentID},
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to get experiment %s, error: %v", experimentID, err)
+	}
+	for _, revision := range experiment.Revision {
+		if revision.RevisionID == revisionID {
+			return revision.ExperimentManifest, nil
+		}
+	}
+	return "", fmt.Errorf("revision %s not found for experiment %s", revisionID, experimentID)
+}
+
+func getExperimentRunComparisonSummary(expRun dbChaosExperimentRun.ChaosExperimentRun, executionData types.ExecutionData) *model.ExperimentRunComparisonSummary {
+	return &model.ExperimentRunComparisonSummary{
+		ExperimentRunID: expRun.ExperimentRunID,
+		ExperimentID:    expRun.ExperimentID,
+		RevisionID:      expRun.RevisionID,
+		Phase:           expRun.Phase,
+		ResiliencyScore: expRun.ResiliencyScore,
+		FaultsPassed:    expRun.FaultsPassed,
+		FaultsFailed:    expRun.FaultsFailed,
+		Duration:        types.GetDuration(executionData.StartedAt, executionData.FinishedAt),
+		RunSequence:     expRun.RunSequence,
+		CreatedAt:       strconv.FormatInt(expRun.CreatedAt, 10),
+	}
+}
+
+func getExperimentRunInputs(inputs []dbChaosExperimentRun.InputValue) []*model.ExperimentInputValue {
+	var result []*model.ExperimentInputValue
+	for _, input := range inputs {
+		result = append(result, &model.ExperimentInputValue{
+			Name:  input.Name,
+			Value: input.Value,
+		})
+	}
+	return result
+}
+
+// getExperimentRunScoreStrategy returns the resiliency score strategy recorded on the experiment run,
+// the runs completed before the strategies were introduced don't have one
+func getExperimentRunScoreStrategy(strategy string) *model.ResiliencyScoreStrategy {
+	if strategy == "" {
+		return nil
+	}
+	scoreStrategy := model.ResiliencyScoreStrategy(strategy)
+	return &scoreStrategy
+}
+
+// getExperimentRunPrunedAt returns the time at which the execution data of the run was pruned, if it was
+func getExperimentRunPrunedAt(prunedAt int64) *string {
+	if prunedAt == 0 {
+		return nil
+	}
+	value := strconv.FormatInt(prunedAt, 10)
+	return &value
+}
+
+// getExperimentRunArchiveLocation returns the location the run was archived to before being pruned, if it was
+func getExperimentRunArchiveLocation(location string) *string {
+	if location == "" {
+		return nil
+	}
+	return &location
+}
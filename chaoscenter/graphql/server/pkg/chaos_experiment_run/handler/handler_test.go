@@ -21,11 +21,13 @@ import (
 	choasExperimentRunMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run/model/mocks"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
 	chaosInfraMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure/model/mocks"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
 	dbOperationsChaosExpRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
 	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
 	dbInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbFaultResult "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/fault_result"
 	dbMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/mocks"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
 	dbGitOpsMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops/model/mocks"
@@ -87,6 +89,8 @@ func TestNewChaosExperimentRunHandler(t *testing.T) {
 				chaosExperimentRunOperator: chaosExperimentRunOperator,
 				probeService:               probeService,
 				mongodbOperator:            mongodbMockOperator,
+				faultResultOperator:        dbFaultResult.NewFaultResultOperator(mongodbMockOperator),
+				runDispatcher:              choas_experiment_run.NewRunDispatcher(mongodbMockOperator),
 			},
 		},
 	}
@@ -446,3 +450,82 @@ func TestChaosExperimentRunHandler_GetExperimentRunStats(t *testing.T) {
 		})
 	}
 }
+
+func TestChaosExperimentRunHandler_PublishExperimentRunEvent(t *testing.T) {
+	projectId := uuid.NewString()
+	experimentRunId := uuid.NewString()
+	notifyId := uuid.NewString()
+	infraId := uuid.NewString()
+	tests := []struct {
+		name       string
+		completed  bool
+		given      func()
+		wantClosed bool
+	}{
+		{
+			name:      "success: run update is published to subscribers",
+			completed: false,
+		},
+		{
+			name:       "success: subscriptions are closed once the run is completed",
+			completed:  true,
+			wantClosed: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			findResult := []interface{}{
+				bson.D{
+					{Key: "experiment_run_id", Value: experimentRunId},
+					{Key: "project_id", Value: projectId},
+					{Key: "infra_id", Value: infraId},
+					{Key: "phase", Value: string(model.ExperimentRunStatusRunning)},
+					{Key: "kubernetesInfraDetails", Value: []dbInfra.ChaosInfra{
+						{
+							InfraID: infraId,
+						},
+					}},
+					{Key: "experiment", Value: []dbChaosExperiment.ExperimentDetails{
+						{
+							Revision: []dbOperationsChaosExpRun.ExperimentRevision{
+								{
+									RevisionID: uuid.NewString(),
+								},
+							},
+						},
+					}},
+				},
+			}
+			cursor, _ := mongo.NewCursorFromDocuments(findResult, nil, nil)
+			mongodbMockOperator.On("Aggregate", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.Anything, mock.Anything).Return(cursor, nil).Once()
+
+			r := store.NewStore()
+			runObserver := make(chan *model.ExperimentRun, 1)
+			notifyObserver := make(chan *model.ExperimentRun, 1)
+			r.ExperimentEventPublish[store.ExperimentRunEventKey(projectId, experimentRunId)] = []chan *model.ExperimentRun{runObserver}
+			r.ExperimentEventPublish[store.ExperimentRunEventKey(projectId, notifyId)] = []chan *model.ExperimentRun{notifyObserver}
+
+			chaosExperimentRunHandler.PublishExperimentRunEvent(context.Background(), projectId, experimentRunId, &notifyId, tc.completed, r)
+
+			for _, observer := range []chan *model.ExperimentRun{runObserver, notifyObserver} {
+				expRun, ok := <-observer
+				if !ok || expRun.ExperimentRunID != experimentRunId {
+					t.Errorf("ChaosExperimentRunHandler.PublishExperimentRunEvent() did not publish the experiment run")
+				}
+				select {
+				case _, ok := <-observer:
+					if ok || !tc.wantClosed {
+						t.Errorf("ChaosExperimentRunHandler.PublishExperimentRunEvent() unexpected observer state, closed = %v", !ok)
+					}
+				default:
+					if tc.wantClosed {
+						t.Errorf("ChaosExperimentRunHandler.PublishExperimentRunEvent() observer was not closed")
+					}
+				}
+			}
+			if _, ok := r.ExperimentEventPublish[store.ExperimentRunEventKey(projectId, experimentRunId)]; ok == tc.wantClosed {
+				t.Errorf("ChaosExperimentRunHandler.PublishExperimentRunEvent() unexpected subscriber registration state")
+			}
+		})
+	}
+}
@@ -0,0 +1,256 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	reconcileInterval = time.Minute
+
+	// ReconcilerUsername is recorded as the user for the experiment runs completed by the reconciler
+	ReconcilerUsername = "run-reconciler"
+)
+
+// RecurringTimeoutReconcile marks the experiment runs which aren't updated by their infra within the timeout
+// as timed out periodically until the context is cancelled
+func (c *ChaosExperimentRunHandler) RecurringTimeoutReconcile(ctx context.Context, timeout time.Duration, r *store.StateData) {
+	for {
+		if err := c.ReconcileTimedOutRuns(ctx, timeout, r); err != nil {
+			logrus.Errorf("failed to reconcile the timed out experiment runs, error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconcileInterval):
+		}
+	}
+}
+
+// ReconcileTimedOutRuns completes the experiment runs sent to their infra which haven't been updated within
+// the timeout with the Timeout status, the queued runs are not considered as they are waiting for the dispatcher
+func (c *ChaosExperimentRunHandler) ReconcileTimedOutRuns(ctx context.Context, timeout time.Duration, r *store.StateData) error {
+	cutoff := time.Now().Add(-timeout).UnixMilli()
+	runs, err := c.listActiveExperimentRuns(ctx, bson.D{
+		{"in_queue", bson.D{{"$ne", true}}},
+		{"updated_at", bson.D{{"$lt", cutoff}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, run := range runs {
+		// the run is left as is if an event of the infra is received meanwhile
+		if err := c.closeExperimentRun(ctx, run, lastSeenExperimentRunQuery(run), model.ExperimentRunStatusTimeout, r); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReconcileInfraExperimentRuns terminates the experiment runs started on the infra whose workflow no longer exists
+// on it, the infra reports the IDs of the experiment runs it knows once it is connected
+func (c *ChaosExperimentRunHandler) ReconcileInfraExperimentRuns(ctx context.Context, infraID string, experimentRunIDs []string, r *store.StateData) (string, error) {
+	experimentIDs, err := c.getWorkflowExperimentIDs(ctx, infraID)
+	if err != nil {
+		return "", err
+	}
+
+	runs, err := c.listActiveExperimentRuns(ctx, bson.D{
+		{"infra_id", infraID},
+		{"experiment_id", bson.D{{"$in", experimentIDs}}},
+	})
+	if err != nil {
+		return "", err
+	}
+
+	orphanedRuns := getOrphanedExperimentRuns(runs, experimentRunIDs)
+	for _, run := range orphanedRuns {
+		if err := c.closeExperimentRun(ctx, run, lastSeenExperimentRunQuery(run), model.ExperimentRunStatusTerminated, r); err != nil {
+			return "", err
+		}
+	}
+
+	return fmt.Sprintf("%d experiment runs of infra %s reconciled", len(orphanedRuns), infraID), nil
+}
+
+// getOrphanedExperimentRuns returns the runs started on the infra which aren't among the runs known by the infra,
+// the runs which haven't been started on the infra yet are never orphaned
+func getOrphanedExperimentRuns(runs []dbChaosExperimentRun.ChaosExperimentRun, experimentRunIDs []string) []dbChaosExperimentRun.ChaosExperimentRun {
+	known := make(map[string]bool, len(experimentRunIDs))
+	for _, id := range experimentRunIDs {
+		known[id] = true
+	}
+
+	var orphanedRuns []dbChaosExperimentRun.ChaosExperimentRun
+	for _, run := range runs {
+		if run.ExperimentRunID != "" && !known[run.ExperimentRunID] {
+			orphanedRuns = append(orphanedRuns, run)
+		}
+	}
+	return orphanedRuns
+}
+
+// listActiveExperimentRuns returns the experiment runs which aren't completed yet matching the query without their
+// execution data
+func (c *ChaosExperimentRunHandler) listActiveExperimentRuns(ctx context.Context, query bson.D) ([]dbChaosExperimentRun.ChaosExperimentRun, error) {
+	query = append(bson.D{
+		{"completed", false},
+		{"is_removed", false},
+	}, query...)
+	opts := options.Find().SetProjection(bson.D{
+		{"execution_data", 0},
+		{"compressed_execution_data", 0},
+		{"queued_manifest", 0},
+	})
+
+	cursor, err := c.mongodbOperator.List(ctx, mongodb.ChaosExperimentRunsCollection, query, opts)
+	if err != nil {
+		return nil, err
+	}
+	var runs []dbChaosExperimentRun.ChaosExperimentRun
+	if err = cursor.All(ctx, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// getWorkflowExperimentIDs returns the IDs of the experiments of the infra which are run as workflows,
+// the standalone chaos engines aren't watched by the workflow watcher of the infra
+func (c *ChaosExperimentRunHandler) getWorkflowExperimentIDs(ctx context.Context, infraID string) ([]string, error) {
+	opts := options.Find().SetProjection(bson.D{{"experiment_id", 1}})
+	cursor, err := c.mongodbOperator.List(ctx, mongodb.ChaosExperimentCollection, bson.D{
+		{"infra_id", infraID},
+		{"experiment_type", bson.D{{"$ne", dbChaosExperiment.ChaosEngine}}},
+	}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var experiments []struct {
+		ExperimentID string `bson:"experiment_id"`
+	}
+	if err = cursor.All(ctx, &experiments); err != nil {
+		return nil, err
+	}
+	experimentIDs := make([]string, 0, len(experiments))
+	for _, experiment := range experiments {
+		experimentIDs = append(experimentIDs, experiment.ExperimentID)
+	}
+	return experimentIDs, nil
+}
+
+// experimentRunQuery returns the query matching the run if it isn't completed, the runs triggered from the
+// server are matched by their notify ID as they don't have an experiment run ID until the infra starts them
+func experimentRunQuery(run dbChaosExperimentRun.ChaosExperimentRun) bson.D {
+	if run.NotifyID != nil {
+		return bson.D{
+			{"experiment_id", run.ExperimentID},
+			{"notify_id", run.NotifyID},
+			{"completed", false},
+		}
+	}
+	return bson.D{
+		{"experiment_id", run.ExperimentID},
+		{"experiment_run_id", run.ExperimentRunID},
+		{"completed", false},
+	}
+}
+
+// lastSeenExperimentRunQuery returns the query matching the run only if it is still in the phase it was listed in,
+// so that the reconciler never overwrites an event of the infra handled after the run was listed
+func lastSeenExperimentRunQuery(run dbChaosExperimentRun.ChaosExperimentRun) bson.D {
+	return append(experimentRunQuery(run),
+		bson.E{Key: "phase", Value: run.Phase},
+		bson.E{Key: "updated_at", Value: run.UpdatedAt},
+	)
+}
+
+// stopExperimentRunWorkflow stops the workflow of the run on the infra, the workflow of a run which isn't started
+// on the infra yet is matched by the notify ID of the run
+func stopExperimentRunWorkflow(ctx context.Context, run dbChaosExperimentRun.ChaosExperimentRun, r *store.StateData) {
+	workflowRunID := run.ExperimentRunID
+	if workflowRunID == "" && run.NotifyID != nil {
+		workflowRunID = *run.NotifyID
+	}
+	if r == nil || workflowRunID == "" {
+		return
+	}
+	username := ReconcilerUsername
+	chaos_infrastructure.SendExperimentToSubscriber(ctx, run.ProjectID, &model.ChaosExperimentRequest{
+		InfraID: run.InfraID,
+	}, &username, &workflowRunID, "workflow_run_stop", r)
+}
+
+// closeExperimentRun completes the run with the phase along with its details in the recent runs of the experiment,
+// the clients and the listeners are notified the same way as for a run completed by the infra
+func (c *ChaosExperimentRunHandler) closeExperimentRun(ctx context.Context, run dbChaosExperimentRun.ChaosExperimentRun, query bson.D, phase model.ExperimentRunStatus, r *store.StateData) error {
+	logFields := logrus.Fields{
+		"projectID":       run.ProjectID,
+		"experimentID":    run.ExperimentID,
+		"experimentRunID": run.ExperimentRunID,
+		"infraID":         run.InfraID,
+	}
+
+	updatedBy := mongodb.UserDetailResponse{
+		Username: ReconcilerUsername,
+	}
+	closed, err := c.chaosExperimentRunOperator.CloseExperimentRun(ctx, query, string(phase), updatedBy)
+	if err != nil || !closed {
+		return err
+	}
+	logrus.WithFields(logFields).Infof("experiment run marked as %s by the reconciler", phase)
+
+	// The workflow of a timed out run may still be injecting chaos on the infra
+	if phase == model.ExperimentRunStatusTimeout {
+		stopExperimentRunWorkflow(ctx, run, r)
+	}
+
+	runDetail := bson.D{{"completed", false}}
+	if run.NotifyID != nil {
+		runDetail = append(runDetail, bson.E{Key: "notify_id", Value: run.NotifyID})
+	} else {
+		runDetail = append(runDetail, bson.E{Key: "experiment_run_id", Value: run.ExperimentRunID})
+	}
+	err = c.chaosExperimentOperator.UpdateChaosExperiment(ctx, bson.D{
+		{"experiment_id", run.ExperimentID},
+		{"recent_experiment_run_details", bson.D{{"$elemMatch", runDetail}}},
+	}, bson.D{
+		{"$set", bson.D{
+			{"recent_experiment_run_details.$.phase", string(phase)},
+			{"recent_experiment_run_details.$.completed", true},
+			{"recent_experiment_run_details.$.updated_at", time.Now().UnixMilli()},
+			{"recent_experiment_run_details.$.updated_by", updatedBy},
+		}},
+	})
+	if err != nil {
+		logrus.WithFields(logFields).Errorf("failed to update the recent experiment runs of the experiment, error: %v", err)
+	}
+
+	if r != nil {
+		c.PublishExperimentRunEvent(ctx, run.ProjectID, run.ExperimentRunID, run.NotifyID, true, r)
+	}
+
+	// A completed run frees a slot of the infra for its queued runs
+	if err := c.runDispatcher.DispatchQueuedRuns(ctx, run.InfraID, r); err != nil {
+		logrus.WithFields(logFields).Errorf("failed to dispatch the queued experiment runs, error: %v", err)
+	}
+
+	run.Phase = string(phase)
+	run.Completed = true
+	for _, listener := range c.experimentRunListeners {
+		listener.ExperimentRunCompleted(ctx, run, r)
+	}
+	return nil
+}
@@ -0,0 +1,112 @@
+package handler
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type completedRunRecorder struct {
+	runs []dbChaosExperimentRun.ChaosExperimentRun
+}
+
+func (c *completedRunRecorder) ExperimentRunCompleted(_ context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, _ *store.StateData) {
+	c.runs = append(c.runs, experimentRun)
+}
+
+func TestGetOrphanedExperimentRuns(t *testing.T) {
+	notifyID := uuid.NewString()
+	runs := []dbChaosExperimentRun.ChaosExperimentRun{
+		{ExperimentRunID: "known"},
+		{ExperimentRunID: "orphaned"},
+		{NotifyID: &notifyID},
+	}
+
+	orphanedRuns := getOrphanedExperimentRuns(runs, []string{"known"})
+	if len(orphanedRuns) != 1 || orphanedRuns[0].ExperimentRunID != "orphaned" {
+		t.Errorf("getOrphanedExperimentRuns() = %v, want only the orphaned run", orphanedRuns)
+	}
+	if orphanedRuns := getOrphanedExperimentRuns(runs, []string{"known", "orphaned"}); len(orphanedRuns) != 0 {
+		t.Errorf("getOrphanedExperimentRuns() = %v, want no runs", orphanedRuns)
+	}
+}
+
+func TestChaosExperimentRunHandler_ReconcileTimedOutRuns(t *testing.T) {
+	projectID := uuid.NewString()
+	experimentID := uuid.NewString()
+	experimentRunID := uuid.NewString()
+	tests := []struct {
+		name          string
+		matchedCount  int64
+		wantCompleted bool
+	}{
+		{
+			name:          "success: run without updates is timed out",
+			matchedCount:  1,
+			wantCompleted: true,
+		},
+		{
+			name:         "success: run updated by the infra meanwhile is left as is",
+			matchedCount: 0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			recorder := &completedRunRecorder{}
+			handler := NewChaosExperimentRunHandler(chaosExperimentRunService, infrastructureService, gitOpsService, chaosExperimentOperator, chaosExperimentRunOperator, probeService, mongodbMockOperator)
+			handler.AddExperimentRunListener(recorder)
+
+			updatedAt := time.Now().Add(-2 * time.Hour).UnixMilli()
+			findResult := []interface{}{
+				bson.D{
+					{Key: "project_id", Value: projectID},
+					{Key: "experiment_id", Value: experimentID},
+					{Key: "experiment_run_id", Value: experimentRunID},
+					{Key: "phase", Value: string(model.ExperimentRunStatusRunning)},
+					{Key: "updated_at", Value: updatedAt},
+				},
+			}
+			cursor, _ := mongo.NewCursorFromDocuments(findResult, nil, nil)
+			mongodbMockOperator.On("List", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.Anything).Return(cursor, nil).Once()
+			// the run is only closed in the phase and at the time it was listed in
+			lastSeenQuery := mock.MatchedBy(func(query bson.D) bool {
+				fields := query.Map()
+				return fields["phase"] == string(model.ExperimentRunStatusRunning) && fields["updated_at"] == updatedAt
+			})
+			mongodbMockOperator.On("Update", mock.Anything, mongodb.ChaosExperimentRunsCollection, lastSeenQuery, mock.Anything, mock.Anything).
+				Return(&mongo.UpdateResult{MatchedCount: tc.matchedCount}, nil).Once()
+			if tc.wantCompleted {
+				mongodbMockOperator.On("Update", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything, mock.Anything, mock.Anything).
+					Return(&mongo.UpdateResult{MatchedCount: 1}, nil).Once()
+			}
+
+			if err := handler.ReconcileTimedOutRuns(context.Background(), time.Hour, nil); err != nil {
+				t.Errorf("ChaosExperimentRunHandler.ReconcileTimedOutRuns() error = %v", err)
+				return
+			}
+
+			if !tc.wantCompleted {
+				if len(recorder.runs) != 0 {
+					t.Errorf("ChaosExperimentRunHandler.ReconcileTimedOutRuns() notified %d completed runs, want none", len(recorder.runs))
+				}
+				return
+			}
+			if len(recorder.runs) != 1 {
+				t.Fatalf("ChaosExperimentRunHandler.ReconcileTimedOutRuns() notified %d completed runs, want 1", len(recorder.runs))
+			}
+			run := recorder.runs[0]
+			if run.ExperimentRunID != experimentRunID || run.Phase != string(model.ExperimentRunStatusTimeout) || !run.Completed {
+				t.Errorf("ChaosExperimentRunHandler.ReconcileTimedOutRuns() completed run = %+v, want a timed out run", run)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	dayMilliseconds  = int64(24 * time.Hour / time.Millisecond)
+	weekMilliseconds = 7 * dayMilliseconds
+	// weekStartOffset aligns the week buckets to Mondays, the unix epoch was on a Thursday
+	weekStartOffset = 4 * dayMilliseconds
+	// maxTrendBuckets limits the time range of the trends
+	maxTrendBuckets = 400
+)
+
+// failedRunPhases are the phases of the experiment runs counted as failed in the trends
+var failedRunPhases = []string{
+	model.ExperimentRunStatusCompletedWithError.String(),
+	model.ExperimentRunStatusError.String(),
+	model.ExperimentRunStatusTimeout.String(),
+	model.ExperimentRunStatusTerminated.String(),
+}
+
+// GetResiliencyTrends returns the time bucketed resiliency score, pass/fail and run volume
+// of the completed experiment runs of a project
+func (c *ChaosExperimentRunHandler) GetResiliencyTrends(ctx context.Context, projectID string, request model.ResiliencyTrendRequest) (*model.ResiliencyTrendResponse, error) {
+	startDate, err := strconv.ParseInt(request.DateRange.StartDate, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid start date, it should be in unix milliseconds")
+	}
+	endDate := time.Now().UnixMilli()
+	if request.DateRange.EndDate != nil {
+		endDate, err = strconv.ParseInt(*request.DateRange.EndDate, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid end date, it should be in unix milliseconds")
+		}
+	}
+	if endDate < startDate {
+		return nil, errors.New("end date should be after the start date")
+	}
+	if (getTrendBucketStart(endDate, request.Granularity)-getTrendBucketStart(startDate, request.Granularity))/getTrendBucketSize(request.Granularity) >= maxTrendBuckets {
+		return nil, errors.New("date range is too large for the granularity of the trends")
+	}
+
+	pipeline := getResiliencyTrendsPipeline(projectID, startDate, endDate, request)
+	trendCursor, err := c.chaosExperimentRunOperator.GetAggregateExperimentRuns(pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var trends []dbChaosExperimentRun.ExperimentRunTrend
+	if err = trendCursor.All(ctx, &trends); err != nil {
+		return nil, err
+	}
+
+	return &model.ResiliencyTrendResponse{
+		Granularity: request.Granularity,
+		Buckets:     getResiliencyTrendBuckets(trends, startDate, endDate, request.Granularity),
+	}, nil
+}
+
+// getResiliencyTrendsPipeline returns the pipeline to group the completed experiment runs into time buckets
+func getResiliencyTrendsPipeline(projectID string, startDate, endDate int64, request model.ResiliencyTrendRequest) mongo.Pipeline {
+	matchRunsStage := bson.D{
+		{"project_id", projectID},
+		{"completed", true},
+		{"is_removed", false},
+		{"created_at", bson.D{
+			{"$gte", startDate},
+			{"$lte", endDate},
+		}},
+	}
+
+	filter := request.Filter
+	if filter != nil && len(filter.ExperimentIDs) > 0 {
+		matchRunsStage = append(matchRunsStage, bson.E{Key: "experiment_id", Value: bson.D{{"$in", filter.ExperimentIDs}}})
+	}
+	if filter != nil && len(filter.InfraIDs) > 0 {
+		matchRunsStage = append(matchRunsStage, bson.E{Key: "infra_id", Value: bson.D{{"$in", filter.InfraIDs}}})
+	}
+
+	pipeline := mongo.Pipeline{
+		{{"$match", matchRunsStage}},
+	}
+
+	// Filtering based on the tags of the experiments
+	if filter != nil && len(filter.Tags) > 0 {
+		pipeline = append(pipeline,
+			bson.D{{"$lookup", bson.D{
+				{"from", "chaosExperiments"},
+				{"let", bson.D{{"experimentID", "$experiment_id"}}},
+				{"pipeline", bson.A{
+					bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$experiment_id", "$$experimentID"}}}}}}},
+					bson.D{{"$project", bson.D{{"tags", 1}}}},
+				}},
+				{"as", "experiment"},
+			}}},
+			bson.D{{"$match", bson.D{{"experiment.tags", bson.D{{"$in", filter.Tags}}}}}},
+		)
+	}
+
+	// Filtering based on the environments of the infras
+	if filter != nil && len(filter.EnvironmentIDs) > 0 {
+		pipeline = append(pipeline,
+			bson.D{{"$lookup", bson.D{
+				{"from", "chaosInfrastructures"},
+				{"let", bson.D{{"infraID", "$infra_id"}}},
+				{"pipeline", bson.A{
+					bson.D{{"$match", bson.D{{"$expr", bson.D{{"$eq", bson.A{"$infra_id", "$$infraID"}}}}}}},
+					bson.D{{"$project", bson.D{{"environment_id", 1}}}},
+				}},
+				{"as", "infra"},
+			}}},
+			bson.D{{"$match", bson.D{{"infra.environment_id", bson.D{{"$in", filter.EnvironmentIDs}}}}}},
+		)
+	}
+
+	// Group the runs by the start of their time bucket, buckets are calculated the same way as getTrendBucketStart
+	bucketOffset := interface{}("$created_at")
+	if request.Granularity == model.TrendGranularityWeek {
+		bucketOffset = bson.D{{"$subtract", bson.A{"$created_at", weekStartOffset}}}
+	}
+	groupByBucketStage := bson.D{
+		{"$group", bson.D{
+			{"_id", bson.D{{"$subtract", bson.A{
+				"$created_at",
+				bson.D{{"$mod", bson.A{bucketOffset, getTrendBucketSize(request.Granularity)}}},
+			}}}},
+			{"avg_resiliency_score", bson.D{{"$avg", "$resiliency_score"}}},
+			{"total_runs", bson.D{{"$sum", 1}}},
+			{"passed_runs", bson.D{{"$sum", bson.D{{"$cond", bson.A{
+				bson.D{{"$eq", bson.A{"$phase", model.ExperimentRunStatusCompleted.String()}}}, 1, 0,
+			}}}}}},
+			{"failed_runs", bson.D{{"$sum", bson.D{{"$cond", bson.A{
+				bson.D{{"$in", bson.A{"$phase", failedRunPhases}}}, 1, 0,
+			}}}}}},
+			{"faults_passed", bson.D{{"$sum", "$faults_passed"}}},
+			{"faults_failed", bson.D{{"$sum", "$faults_failed"}}},
+		}},
+	}
+
+	return append(pipeline, groupByBucketStage, bson.D{{"$sort", bson.D{{"_id", 1}}}})
+}
+
+// getResiliencyTrendBuckets returns all the buckets of the date range, the buckets without runs have zero counts
+func getResiliencyTrendBuckets(trends []dbChaosExperimentRun.ExperimentRunTrend, startDate, endDate int64, granularity model.TrendGranularity) []*model.ResiliencyTrendBucket {
+	trendMap := make(map[int64]dbChaosExperimentRun.ExperimentRunTrend)
+	for _, trend := range trends {
+		trendMap[trend.BucketStart] = trend
+	}
+
+	buckets := []*model.ResiliencyTrendBucket{}
+	for bucketStart := getTrendBucketStart(startDate, granularity); bucketStart <= endDate; bucketStart += getTrendBucketSize(granularity) {
+		bucket := &model.ResiliencyTrendBucket{
+			StartTime: strconv.FormatInt(bucketStart, 10),
+		}
+		if trend, ok := trendMap[bucketStart]; ok {
+			if trend.AvgResScore != nil {
+				avgResScore := utils.Truncate(*trend.AvgResScore)
+				bucket.AverageResiliencyScore = &avgResScore
+			}
+			bucket.TotalRuns = trend.TotalRuns
+			bucket.PassedRuns = trend.PassedRuns
+			bucket.FailedRuns = trend.FailedRuns
+			bucket.FaultsPassed = trend.FaultsPassed
+			bucket.FaultsFailed = trend.FaultsFailed
+		}
+		buckets = append(buckets, bucket)
+	}
+	return buckets
+}
+
+// getTrendBucketStart returns the start of the UTC day or week (starting on Monday) of the timestamp
+func getTrendBucketStart(timestamp int64, granularity model.TrendGranularity) int64 {
+	if granularity == model.TrendGranularityWeek {
+		return timestamp - (timestamp-weekStartOffset)%weekMilliseconds
+	}
+	return timestamp - timestamp%dayMilliseconds
+}
+
+func getTrendBucketSize(granularity model.TrendGranularity) int64 {
+	if granularity == model.TrendGranularityWeek {
+		return weekMilliseconds
+	}
+	return dayMilliseconds
+}
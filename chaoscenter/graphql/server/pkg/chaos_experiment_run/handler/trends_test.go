@@ -0,0 +1,75 @@
+package handler
+
+import (
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+)
+
+func TestGetTrendBucketStart(t *testing.T) {
+	// 2024-01-03 is a Wednesday
+	timestamp := time.Date(2024, 1, 3, 15, 30, 0, 0, time.UTC).UnixMilli()
+
+	tests := []struct {
+		name        string
+		granularity model.TrendGranularity
+		want        time.Time
+	}{
+		{
+			name:        "day bucket starts at midnight UTC",
+			granularity: model.TrendGranularityDay,
+			want:        time.Date(2024, 1, 3, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:        "week bucket starts on Monday",
+			granularity: model.TrendGranularityWeek,
+			want:        time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := getTrendBucketStart(timestamp, tc.granularity); got != tc.want.UnixMilli() {
+				t.Errorf("getTrendBucketStart() = %v, want %v", time.UnixMilli(got).UTC(), tc.want)
+			}
+		})
+	}
+}
+
+func TestGetResiliencyTrendBuckets(t *testing.T) {
+	startDate := time.Date(2024, 1, 1, 10, 0, 0, 0, time.UTC).UnixMilli()
+	endDate := time.Date(2024, 1, 3, 10, 0, 0, 0, time.UTC).UnixMilli()
+	secondDay := time.Date(2024, 1, 2, 0, 0, 0, 0, time.UTC).UnixMilli()
+	avgResScore := 75.555
+
+	trends := []dbChaosExperimentRun.ExperimentRunTrend{
+		{
+			BucketStart:  secondDay,
+			AvgResScore:  &avgResScore,
+			TotalRuns:    3,
+			PassedRuns:   2,
+			FailedRuns:   1,
+			FaultsPassed: 5,
+			FaultsFailed: 1,
+		},
+	}
+
+	buckets := getResiliencyTrendBuckets(trends, startDate, endDate, model.TrendGranularityDay)
+	if len(buckets) != 3 {
+		t.Fatalf("getResiliencyTrendBuckets() returned %d buckets, want 3", len(buckets))
+	}
+	if buckets[0].TotalRuns != 0 || buckets[0].AverageResiliencyScore != nil {
+		t.Errorf("getResiliencyTrendBuckets() bucket without runs = %+v, want zero counts", buckets[0])
+	}
+	if buckets[1].StartTime != strconv.FormatInt(secondDay, 10) {
+		t.Errorf("getResiliencyTrendBuckets() start time = %v, want %v", buckets[1].StartTime, secondDay)
+	}
+	if buckets[1].TotalRuns != 3 || buckets[1].PassedRuns != 2 || buckets[1].FailedRuns != 1 {
+		t.Errorf("getResiliencyTrendBuckets() bucket with runs = %+v, want the aggregated counts", buckets[1])
+	}
+	if buckets[1].AverageResiliencyScore == nil || *buckets[1].AverageResiliencyScore != 75.55 {
+		t.Errorf("getResiliencyTrendBuckets() average resiliency score = %v, want 75.55", buckets[1].AverageResiliencyScore)
+	}
+}
@@ -0,0 +1,124 @@
+package chaos_experiment_run
+
+import (
+	"strconv"
+
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// generatedNameSuffixLength is the length of the random suffix appended by kubernetes to the generateName of the chaos engines
+const generatedNameSuffixLength = 5
+
+// FaultResult is the result of a fault of an experiment run along with the weightage of the fault
+type FaultResult struct {
+	FaultName string
+	Weightage int
+	ChaosData *ChaosData
+}
+
+// ResiliencyScoreStrategy calculates the resiliency score of an experiment run from the results of its faults,
+// weightSum is the sum of the weightages of all the faults of the experiment including the ones without a result
+type ResiliencyScoreStrategy interface {
+	ResiliencyScore(faults []FaultResult, weightSum int) float64
+}
+
+// resiliencyScoreStrategies are the strategies which can be chosen for an experiment
+var resiliencyScoreStrategies = map[model.ResiliencyScoreStrategy]ResiliencyScoreStrategy{
+	model.ResiliencyScoreStrategyWeightedProbeSuccess: weightedProbeSuccessStrategy{},
+	model.ResiliencyScoreStrategyVerdict:              verdictStrategy{},
+	model.ResiliencyScoreStrategyCriticalFault:        criticalFaultStrategy{},
+	model.ResiliencyScoreStrategyProbeModeWeighted:    probeModeWeightedStrategy{},
+}
+
+// probeModeWeights are the weights of the probes by their mode, the probes running throughout the chaos
+// observe the application for longer and weigh more than the probes running only once
+var probeModeWeights = map[string]int{
+	string(model.ModeSot):        1,
+	string(model.ModeEot):        1,
+	string(model.ModeEdge):       2,
+	string(model.ModeOnChaos):    3,
+	string(model.ModeContinuous): 3,
+}
+
+// GetResiliencyScoreStrategy returns the resiliency score strategy of an experiment, the weighted
+// probe success strategy is used for the experiments created before the strategies were introduced
+func GetResiliencyScoreStrategy(strategy string) (model.ResiliencyScoreStrategy, ResiliencyScoreStrategy) {
+	if scoreStrategy, ok := resiliencyScoreStrategies[model.ResiliencyScoreStrategy(strategy)]; ok {
+		return model.ResiliencyScoreStrategy(strategy), scoreStrategy
+	}
+	return model.ResiliencyScoreStrategyWeightedProbeSuccess, resiliencyScoreStrategies[model.ResiliencyScoreStrategyWeightedProbeSuccess]
+}
+
+// weightedProbeSuccessStrategy averages the probe success percentages of the faults weighted by their weightages
+type weightedProbeSuccessStrategy struct{}
+
+func (weightedProbeSuccessStrategy) ResiliencyScore(faults []FaultResult, weightSum int) float64 {
+	return getWeightedScore(faults, weightSum, getProbeSuccessPercentage)
+}
+
+// verdictStrategy scores the faults with a passed verdict as 100 and the rest as 0
+type verdictStrategy struct{}
+
+func (verdictStrategy) ResiliencyScore(faults []FaultResult, weightSum int) float64 {
+	return getWeightedScore(faults, weightSum, func(chaosData *ChaosData) float64 {
+		if chaosData.ExperimentVerdict == "Pass" {
+			return 100
+		}
+		return 0
+	})
+}
+
+// criticalFaultStrategy treats every fault as critical, a single failed fault makes the score 0
+type criticalFaultStrategy struct{}
+
+func (criticalFaultStrategy) ResiliencyScore(faults []FaultResult, weightSum int) float64 {
+	for _, fault := range faults {
+		if fault.ChaosData.ExperimentVerdict == "Fail" || fault.ChaosData.ExperimentVerdict == "Stopped" {
+			return 0
+		}
+	}
+	return getWeightedScore(faults, weightSum, getProbeSuccessPercentage)
+}
+
+// probeModeWeightedStrategy scores the faults by their passed probes weighted by the probe modes
+type probeModeWeightedStrategy struct{}
+
+func (probeModeWeightedStrategy) ResiliencyScore(faults []FaultResult, weightSum int) float64 {
+	return getWeightedScore(faults, weightSum, func(chaosData *ChaosData) float64 {
+		if chaosData.ChaosResult == nil || len(chaosData.ChaosResult.Status.ProbeStatuses) == 0 {
+			return getProbeSuccessPercentage(chaosData)
+		}
+
+		passedWeight, totalWeight := 0, 0
+		for _, probeStatus := range chaosData.ChaosResult.Status.ProbeStatuses {
+			weight, ok := probeModeWeights[probeStatus.Mode]
+			if !ok {
+				weight = 1
+			}
+			totalWeight += weight
+			if probeStatus.Status.Verdict == chaosTypes.ProbeVerdictPassed {
+				passedWeight += weight
+			}
+		}
+		return float64(passedWeight*100) / float64(totalWeight)
+	})
+}
+
+// getWeightedScore averages the scores of the faults weighted by their weightages
+func getWeightedScore(faults []FaultResult, weightSum int, faultScore func(chaosData *ChaosData) float64) float64 {
+	if weightSum == 0 {
+		return 0
+	}
+
+	var totalScore float64
+	for _, fault := range faults {
+		totalScore += float64(fault.Weightage) * faultScore(fault.ChaosData)
+	}
+	return totalScore / float64(weightSum)
+}
+
+func getProbeSuccessPercentage(chaosData *ChaosData) float64 {
+	probeSuccessPercentage, _ := strconv.Atoi(chaosData.ProbeSuccessPercentage)
+	return float64(probeSuccessPercentage)
+}
@@ -0,0 +1,151 @@
+package chaos_experiment_run
+
+import (
+	"testing"
+
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+func newFaultResult(weightage int, verdict, probeSuccessPercentage string, probeVerdicts map[string]string) FaultResult {
+	var probeStatuses []chaosTypes.ProbeStatuses
+	for mode, probeVerdict := range probeVerdicts {
+		probeStatuses = append(probeStatuses, chaosTypes.ProbeStatuses{
+			Name: mode + "-probe",
+			Mode: mode,
+			Status: chaosTypes.ProbeStatus{
+				Verdict: chaosTypes.ProbeVerdict(probeVerdict),
+			},
+		})
+	}
+
+	return FaultResult{
+		Weightage: weightage,
+		ChaosData: &ChaosData{
+			ExperimentVerdict:      verdict,
+			ProbeSuccessPercentage: probeSuccessPercentage,
+			ChaosResult: &chaosTypes.ChaosResult{
+				Status: chaosTypes.ChaosResultStatus{
+					ProbeStatuses: probeStatuses,
+				},
+			},
+		},
+	}
+}
+
+func TestResiliencyScoreStrategies(t *testing.T) {
+	passedFault := newFaultResult(10, "Pass", "100", map[string]string{"SOT": "Passed", "Continuous": "Passed"})
+	failedFault := newFaultResult(10, "Fail", "50", map[string]string{"SOT": "Passed", "Continuous": "Failed"})
+
+	tests := []struct {
+		name      string
+		strategy  string
+		faults    []FaultResult
+		weightSum int
+		want      float64
+	}{
+		{
+			name:      "weighted probe success averages the probe success percentages",
+			strategy:  model.ResiliencyScoreStrategyWeightedProbeSuccess.String(),
+			faults:    []FaultResult{passedFault, failedFault},
+			weightSum: 20,
+			want:      75,
+		},
+		{
+			name:      "experiments without a strategy use weighted probe success",
+			strategy:  "",
+			faults:    []FaultResult{passedFault, failedFault},
+			weightSum: 20,
+			want:      75,
+		},
+		{
+			name:      "verdict only counts the passed faults",
+			strategy:  model.ResiliencyScoreStrategyVerdict.String(),
+			faults:    []FaultResult{passedFault, failedFault},
+			weightSum: 20,
+			want:      50,
+		},
+		{
+			name:      "critical fault gives 0 if any fault failed",
+			strategy:  model.ResiliencyScoreStrategyCriticalFault.String(),
+			faults:    []FaultResult{passedFault, failedFault},
+			weightSum: 20,
+			want:      0,
+		},
+		{
+			name:      "critical fault without failures uses the probe success percentages",
+			strategy:  model.ResiliencyScoreStrategyCriticalFault.String(),
+			faults:    []FaultResult{passedFault},
+			weightSum: 20,
+			want:      50,
+		},
+		{
+			name:      "probe mode weighted weighs the continuous probes more",
+			strategy:  model.ResiliencyScoreStrategyProbeModeWeighted.String(),
+			faults:    []FaultResult{passedFault, failedFault},
+			weightSum: 20,
+			want:      62.5,
+		},
+		{
+			name:      "no weightages gives 0",
+			strategy:  model.ResiliencyScoreStrategyWeightedProbeSuccess.String(),
+			faults:    []FaultResult{passedFault},
+			weightSum: 0,
+			want:      0,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, scoreStrategy := GetResiliencyScoreStrategy(tc.strategy)
+			if got := scoreStrategy.ResiliencyScore(tc.faults, tc.weightSum); got != tc.want {
+				t.Errorf("ResiliencyScore() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetFaultName(t *testing.T) {
+	weightMap := map[string]int{
+		"pod-delete":       10,
+		"pod-delete-extra": 5,
+	}
+
+	tests := []struct {
+		name       string
+		node       Node
+		wantFault  string
+		wantExists bool
+	}{
+		{
+			name:       "node name matches the fault",
+			node:       Node{Name: "pod-delete", ChaosExp: &ChaosData{EngineName: "pod-delete-ab12c"}},
+			wantFault:  "pod-delete",
+			wantExists: true,
+		},
+		{
+			name:       "engine name matches the fault",
+			node:       Node{Name: "run-step", ChaosExp: &ChaosData{EngineName: "pod-delete-extra"}},
+			wantFault:  "pod-delete-extra",
+			wantExists: true,
+		},
+		{
+			name:       "generated engine name matches the fault",
+			node:       Node{Name: "run-step", ChaosExp: &ChaosData{EngineName: "pod-delete-extraxk9tz"}},
+			wantFault:  "pod-delete-extra",
+			wantExists: true,
+		},
+		{
+			name:       "fault containing another fault's name is not matched",
+			node:       Node{Name: "run-step", ChaosExp: &ChaosData{EngineName: "pod-delete-network"}},
+			wantExists: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotFault, gotExists := getFaultName(tc.node, weightMap)
+			if gotFault != tc.wantFault || gotExists != tc.wantExists {
+				t.Errorf("getFaultName() = (%v, %v), want (%v, %v)", gotFault, gotExists, tc.wantFault, tc.wantExists)
+			}
+		})
+	}
+}
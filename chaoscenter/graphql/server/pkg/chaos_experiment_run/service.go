@@ -3,8 +3,6 @@ package chaos_experiment_run
 import (
 	"context"
 	"fmt"
-	"strconv"
-	"strings"
 	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
@@ -63,7 +61,7 @@ func (c *chaosExperimentRunService) ProcessExperimentRunDelete(ctx context.Conte
 		return err
 	}
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(experimentRun.ProjectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, experimentRun.ProjectID, &model.ChaosExperimentRequest{
 			InfraID: workflow.InfraID,
 		}, &username, workflowRunID, "workflow_run_delete", r)
 	}
@@ -87,7 +85,7 @@ func (c *chaosExperimentRunService) ProcessExperimentRunStop(ctx context.Context
 		return err
 	}
 	if r != nil {
-		chaos_infrastructure.SendExperimentToSubscriber(projectID, &model.ChaosExperimentRequest{
+		chaos_infrastructure.SendExperimentToSubscriber(ctx, projectID, &model.ChaosExperimentRequest{
 			InfraID: experiment.InfraID,
 		}, &username, experimentRunID, "workflow_run_stop", r)
 	}
@@ -95,10 +93,13 @@ func (c *chaosExperimentRunService) ProcessExperimentRunStop(ctx context.Context
 	return nil
 }
 
-// ProcessCompletedExperimentRun calculates the Resiliency Score and returns the updated ExecutionData
+// ProcessCompletedExperimentRun calculates the Resiliency Score with the strategy of the experiment and returns the updated ExecutionData
 func (c *chaosExperimentRunService) ProcessCompletedExperimentRun(execData ExecutionData, wfID string, runID string) (ExperimentRunMetrics, error) {
-	weightSum, totalTestResult := 0, 0
-	var result ExperimentRunMetrics
+	weightSum := 0
+	var (
+		result       ExperimentRunMetrics
+		faultResults []FaultResult
+	)
 	weightMap := map[string]int{}
 
 	chaosWorkflows, err := c.chaosExperimentOperator.GetExperiment(context.TODO(), bson.D{
@@ -120,21 +121,17 @@ func (c *chaosExperimentRunService) ProcessCompletedExperimentRun(execData Execu
 	result.TotalExperiments = len(weightMap)
 	for _, value := range execData.Nodes {
 		if value.Type == "ChaosEngine" {
-			experimentName := ""
 			if value.ChaosExp == nil {
 				continue
 			}
 
-			for expName := range weightMap {
-				if strings.Contains(value.ChaosExp.EngineName, expName) {
-					experimentName = expName
-				}
-			}
-			weight, ok := weightMap[experimentName]
 			// probeSuccessPercentage will be included only if chaosData is present
-			if ok {
-				x, _ := strconv.Atoi(value.ChaosExp.ProbeSuccessPercentage)
-				totalTestResult += weight * x
+			if faultName, ok := getFaultName(value, weightMap); ok {
+				faultResults = append(faultResults, FaultResult{
+					FaultName: faultName,
+					Weightage: weightMap[faultName],
+					ChaosData: value.ChaosExp,
+				})
 			}
 			if value.ChaosExp.ExperimentVerdict == "Pass" {
 				result.ExperimentsPassed += 1
@@ -153,9 +150,32 @@ func (c *chaosExperimentRunService) ProcessCompletedExperimentRun(execData Execu
 			}
 		}
 	}
+
+	strategy, scoreStrategy := GetResiliencyScoreStrategy(chaosWorkflows.ResiliencyScoreStrategy)
+	result.ResiliencyScoreStrategy = string(strategy)
 	if weightSum != 0 {
-		result.ResiliencyScore = utils.Truncate(float64(totalTestResult) / float64(weightSum))
+		result.ResiliencyScore = utils.Truncate(scoreStrategy.ResiliencyScore(faultResults, weightSum))
 	}
 
 	return result, nil
 }
+
+// getFaultName returns the name of the fault of a chaos engine node as used in the weightages of the experiment.
+// The node of a workflow step is named after the fault, the chaos engine is named after the fault with the
+// suffix generated by kubernetes for its generateName
+func getFaultName(node Node, weightMap map[string]int) (string, bool) {
+	if _, ok := weightMap[node.Name]; ok {
+		return node.Name, true
+	}
+
+	engineName := node.ChaosExp.EngineName
+	if _, ok := weightMap[engineName]; ok {
+		return engineName, true
+	}
+	if len(engineName) > generatedNameSuffixLength {
+		if _, ok := weightMap[engineName[:len(engineName)-generatedNameSuffixLength]]; ok {
+			return engineName[:len(engineName)-generatedNameSuffixLength], true
+		}
+	}
+	return "", false
+}
@@ -5,13 +5,14 @@ import (
 )
 
 type ExperimentRunMetrics struct {
-	ResiliencyScore    float64 `json:"resiliency_score"`
-	ExperimentsPassed  int     `json:"experiments_passed"`
-	ExperimentsFailed  int     `json:"experiments_failed"`
-	ExperimentsAwaited int     `json:"experiments_awaited"`
-	ExperimentsStopped int     `json:"experiments_stopped"`
-	ExperimentsNA      int     `json:"experiments_na"`
-	TotalExperiments   int     `json:"total_experiments"`
+	ResiliencyScore         float64 `json:"resiliency_score"`
+	ExperimentsPassed       int     `json:"experiments_passed"`
+	ExperimentsFailed       int     `json:"experiments_failed"`
+	ExperimentsAwaited      int     `json:"experiments_awaited"`
+	ExperimentsStopped      int     `json:"experiments_stopped"`
+	ExperimentsNA           int     `json:"experiments_na"`
+	TotalExperiments        int     `json:"total_experiments"`
+	ResiliencyScoreStrategy string  `json:"resiliency_score_strategy"`
 }
 
 type ExecutionData struct {
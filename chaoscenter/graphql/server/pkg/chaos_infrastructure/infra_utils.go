@@ -1,6 +1,7 @@
 package chaos_infrastructure
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"strings"
@@ -9,6 +10,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 	log "github.com/sirupsen/logrus"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -202,7 +204,10 @@ func ManifestParser(infra dbChaosInfra.ChaosInfra, rootPath string, config *Subs
 }
 
 // SendRequestToSubscriber sends events from the graphQL server to the subscribers listening for the requests
-func SendRequestToSubscriber(subscriberRequest SubscriberRequests, r store.StateData) {
+func SendRequestToSubscriber(ctx context.Context, subscriberRequest SubscriberRequests, r store.StateData) {
+	span, traceContext := tracing.StartSubscriberRequestSpan(ctx, subscriberRequest.InfraID, subscriberRequest.RequestType)
+	defer span.End()
+
 	newAction := &model.InfraActionResponse{
 		ProjectID: subscriberRequest.ProjectID,
 		Action: &model.ActionPayload{
@@ -211,18 +216,17 @@ func SendRequestToSubscriber(subscriberRequest SubscriberRequests, r store.State
 			RequestType:  subscriberRequest.RequestType,
 			ExternalData: subscriberRequest.ExternalData,
 			Username:     subscriberRequest.Username,
+			TraceContext: traceContext,
 		},
 	}
 
-	r.Mutex.Lock()
-	if observer, ok := r.ConnectedInfra[subscriberRequest.InfraID]; ok {
-		observer <- newAction
+	if err := r.SendInfraAction(ctx, subscriberRequest.InfraID, newAction); err != nil {
+		log.WithField("infraID", subscriberRequest.InfraID).Errorf("failed to send the request to the subscriber, error: %v", err)
 	}
-	r.Mutex.Unlock()
 }
 
 // SendExperimentToSubscriber sends the workflow to the subscriber to be handled
-func SendExperimentToSubscriber(projectID string, workflow *model.ChaosExperimentRequest, username *string, externalData *string, reqType string, r *store.StateData) {
+func SendExperimentToSubscriber(ctx context.Context, projectID string, workflow *model.ChaosExperimentRequest, username *string, externalData *string, reqType string, r *store.StateData) {
 
 	var workflowObj unstructured.Unstructured
 	err := yaml.Unmarshal([]byte(workflow.ExperimentManifest), &workflowObj)
@@ -231,7 +235,7 @@ func SendExperimentToSubscriber(projectID string, workflow *model.ChaosExperimen
 		return
 	}
 
-	SendRequestToSubscriber(SubscriberRequests{
+	SendRequestToSubscriber(ctx, SubscriberRequests{
 		K8sManifest:  workflow.ExperimentManifest,
 		RequestType:  reqType,
 		ProjectID:    projectID,
@@ -34,6 +34,11 @@ func (s *InfraService) DeleteInfra(ctx context.Context, projectID string, infraI
 	return args.String(0), args.Error(1)
 }
 
+func (s *InfraService) UpdateInfraRunLimit(ctx context.Context, projectID string, infraID string, request model.InfraRunLimitRequest) (string, error) {
+	args := s.Called(ctx, projectID, infraID, request)
+	return args.String(0), args.Error(1)
+}
+
 func (s *InfraService) ListInfras(projectID string, request *model.ListInfraRequest) (*model.ListInfraResponse, error) {
 	args := s.Called(projectID, request)
 	return args.Get(0).(*model.ListInfraResponse), args.Error(1)
@@ -13,6 +13,7 @@ import (
 	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/cloudevents"
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/config"
 	dbEnvironments "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
@@ -41,6 +42,7 @@ type Service interface {
 	ConfirmInfraRegistration(request model.InfraIdentity, r store.StateData) (*model.ConfirmInfraRegistrationResponse, error)
 	VerifyInfra(identity model.InfraIdentity) (*dbChaosInfra.ChaosInfra, error)
 	DeleteInfra(ctx context.Context, projectID string, infraId string, r store.StateData) (string, error)
+	UpdateInfraRunLimit(ctx context.Context, projectID string, infraID string, request model.InfraRunLimitRequest) (string, error)
 	ListInfras(projectID string, request *model.ListInfraRequest) (*model.ListInfraResponse, error)
 	GetInfraDetails(ctx context.Context, infraID string, projectID string) (*model.Infra, error)
 	SendInfraEvent(eventType, eventName, description string, infra model.Infra, r store.StateData)
@@ -289,7 +291,7 @@ func (in *infraService) DeleteInfra(ctx context.Context, projectID string, infra
 	}
 
 	for _, request := range requests {
-		SendRequestToSubscriber(SubscriberRequests{
+		SendRequestToSubscriber(ctx, SubscriberRequests{
 			K8sManifest: request,
 			RequestType: "delete",
 			ProjectID:   infra.ProjectID,
@@ -302,6 +304,55 @@ func (in *infraService) DeleteInfra(ctx context.Context, projectID string, infra
 	return "infra deleted successfully", nil
 }
 
+// UpdateInfraRunLimit updates the maximum number of concurrent experiment runs of the infra and the order of its run queue
+func (in *infraService) UpdateInfraRunLimit(ctx context.Context, projectID string, infraID string, request model.InfraRunLimitRequest) (string, error) {
+	tkn := ctx.Value(authorization.AuthKey).(string)
+	username, err := authorization.GetUsername(tkn)
+	if err != nil {
+		return "", err
+	}
+
+	if request.MaxConcurrentRuns < 0 {
+		return "", fmt.Errorf("maximum number of concurrent runs can not be negative")
+	}
+	policy := model.RunQueuePolicyFifo
+	if request.RunQueuePolicy != nil {
+		if !request.RunQueuePolicy.IsValid() {
+			return "", fmt.Errorf("invalid run queue policy %s", *request.RunQueuePolicy)
+		}
+		policy = *request.RunQueuePolicy
+	}
+
+	infra, err := in.infraOperator.GetInfraDetails(ctx, infraID, projectID)
+	if err != nil {
+		return "", err
+	}
+	if infra.IsRemoved {
+		return "", fmt.Errorf("infra %s is removed", infraID)
+	}
+
+	query := bson.D{
+		{"infra_id", infraID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	update := bson.D{
+		{"$set", bson.D{
+			{"max_concurrent_runs", request.MaxConcurrentRuns},
+			{"run_queue_policy", string(policy)},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", mongodb.UserDetailResponse{
+				Username: username,
+			}},
+		}},
+	}
+	if err = in.infraOperator.UpdateInfra(ctx, query, update); err != nil {
+		return "", err
+	}
+
+	return "infra run limit updated successfully", nil
+}
+
 // GetInfra returns details of the requested infra
 func (in *infraService) GetInfra(ctx context.Context, projectID string, infraID string) (*model.Infra, error) {
 
@@ -387,23 +438,25 @@ func (in *infraService) GetInfra(ctx context.Context, projectID string, infraID
 		description := infra.Description
 
 		infraResponse = &model.Infra{
-			InfraID:          infra.InfraID,
-			Name:             infra.Name,
-			EnvironmentID:    infra.EnvironmentID,
-			Description:      &description,
-			PlatformName:     infra.PlatformName,
-			IsActive:         infra.IsActive,
-			IsInfraConfirmed: infra.IsInfraConfirmed,
-			UpdatedAt:        strconv.FormatInt(infra.UpdatedAt, 10),
-			CreatedAt:        strconv.FormatInt(infra.CreatedAt, 10),
-			Token:            infra.Token,
-			InfraNamespace:   infra.InfraNamespace,
-			ServiceAccount:   infra.ServiceAccount,
-			InfraScope:       infra.InfraScope,
-			StartTime:        infra.StartTime,
-			Version:          infra.Version,
-			Tags:             infra.Tags,
-			CreatedBy:        &model.UserDetails{Username: username},
+			InfraID:           infra.InfraID,
+			Name:              infra.Name,
+			EnvironmentID:     infra.EnvironmentID,
+			Description:       &description,
+			PlatformName:      infra.PlatformName,
+			IsActive:          infra.IsActive,
+			IsInfraConfirmed:  infra.IsInfraConfirmed,
+			UpdatedAt:         strconv.FormatInt(infra.UpdatedAt, 10),
+			CreatedAt:         strconv.FormatInt(infra.CreatedAt, 10),
+			Token:             infra.Token,
+			InfraNamespace:    infra.InfraNamespace,
+			ServiceAccount:    infra.ServiceAccount,
+			InfraScope:        infra.InfraScope,
+			StartTime:         infra.StartTime,
+			Version:           infra.Version,
+			Tags:              infra.Tags,
+			CreatedBy:         &model.UserDetails{Username: username},
+			MaxConcurrentRuns: getInfraMaxConcurrentRuns(infra.MaxConcurrentRuns),
+			RunQueuePolicy:    getInfraRunQueuePolicy(infra.RunQueuePolicy),
 			UpdatedBy: &model.UserDetails{
 				Username: username,
 			},
@@ -693,24 +746,26 @@ func (in *infraService) ListInfras(projectID string, request *model.ListInfraReq
 		description := infra.Description
 
 		newInfra := model.Infra{
-			InfraID:          infra.InfraID,
-			ProjectID:        infra.ProjectID,
-			Name:             infra.Name,
-			EnvironmentID:    infra.EnvironmentID,
-			Description:      &description,
-			PlatformName:     infra.PlatformName,
-			IsActive:         infra.IsActive,
-			IsInfraConfirmed: infra.IsInfraConfirmed,
-			UpdatedAt:        strconv.FormatInt(infra.UpdatedAt, 10),
-			CreatedAt:        strconv.FormatInt(infra.CreatedAt, 10),
-			Token:            infra.Token,
-			InfraNamespace:   infra.InfraNamespace,
-			ServiceAccount:   infra.ServiceAccount,
-			InfraScope:       infra.InfraScope,
-			StartTime:        infra.StartTime,
-			Version:          infra.Version,
-			Tags:             infra.Tags,
-			IsRemoved:        infra.IsRemoved,
+			InfraID:           infra.InfraID,
+			ProjectID:         infra.ProjectID,
+			Name:              infra.Name,
+			EnvironmentID:     infra.EnvironmentID,
+			Description:       &description,
+			PlatformName:      infra.PlatformName,
+			IsActive:          infra.IsActive,
+			IsInfraConfirmed:  infra.IsInfraConfirmed,
+			UpdatedAt:         strconv.FormatInt(infra.UpdatedAt, 10),
+			CreatedAt:         strconv.FormatInt(infra.CreatedAt, 10),
+			Token:             infra.Token,
+			InfraNamespace:    infra.InfraNamespace,
+			ServiceAccount:    infra.ServiceAccount,
+			InfraScope:        infra.InfraScope,
+			StartTime:         infra.StartTime,
+			Version:           infra.Version,
+			Tags:              infra.Tags,
+			IsRemoved:         infra.IsRemoved,
+			MaxConcurrentRuns: getInfraMaxConcurrentRuns(infra.MaxConcurrentRuns),
+			RunQueuePolicy:    getInfraRunQueuePolicy(infra.RunQueuePolicy),
 		}
 
 		if len(infra.ExperimentRunDetails) > 0 {
@@ -967,18 +1022,17 @@ func (in *infraService) PodLog(request model.PodLog, r store.StateData) (string,
 		log.Print("ERROR", err)
 		return "", err
 	}
-	if reqChan, ok := r.ExperimentLog[request.RequestID]; ok {
-		resp := model.PodLogResponse{
-			PodName:         request.PodName,
-			ExperimentRunID: request.ExperimentRunID,
-			PodType:         request.PodType,
-			Log:             request.Log,
-		}
-		reqChan <- &resp
-		close(reqChan)
-		return "LOGS SENT SUCCESSFULLY", nil
+	// the client of the request may be connected to another replica, the log is published to all of them
+	resp := model.PodLogResponse{
+		PodName:         request.PodName,
+		ExperimentRunID: request.ExperimentRunID,
+		PodType:         request.PodType,
+		Log:             request.Log,
+	}
+	if err = r.PublishPodLog(context.Background(), request.RequestID, &resp); err != nil {
+		return "", err
 	}
-	return "LOG REQUEST CANCELLED", nil
+	return "LOGS SENT SUCCESSFULLY", nil
 }
 
 // KubeObj receives Kubernetes Object data from subscriber
@@ -988,20 +1042,18 @@ func (in *infraService) KubeObj(request model.KubeObjectData, r store.StateData)
 		log.Print("Error", err)
 		return "", err
 	}
-	if reqChan, ok := r.KubeObjectData[request.RequestID]; ok {
-		var kubeObjData *model.KubeObject
-		err = json.Unmarshal([]byte(request.KubeObj), &kubeObjData)
-		if err != nil {
-			return "", fmt.Errorf("failed to unmarshal kubeObj data %w", err)
-		}
+	var kubeObjData *model.KubeObject
+	err = json.Unmarshal([]byte(request.KubeObj), &kubeObjData)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal kubeObj data %w", err)
+	}
 
-		resp := model.KubeObjectResponse{
-			InfraID: request.InfraID.InfraID,
-			KubeObj: kubeObjData,
-		}
-		reqChan <- &resp
-		close(reqChan)
-		return "KubeData sent successfully", nil
+	resp := model.KubeObjectResponse{
+		InfraID: request.InfraID.InfraID,
+		KubeObj: kubeObjData,
+	}
+	if err = r.PublishKubeObject(context.Background(), request.RequestID, &resp); err != nil {
+		return "", err
 	}
 	return "KubeData sent successfully", nil
 }
@@ -1013,20 +1065,18 @@ func (in *infraService) KubeNamespace(request model.KubeNamespaceData, r store.S
 		log.Print("Error", err)
 		return "", err
 	}
-	if reqChan, ok := r.KubeNamespaceData[request.RequestID]; ok {
-		var kubeNamespaceData []*model.KubeNamespace
-		err = json.Unmarshal([]byte(request.KubeNamespace), &kubeNamespaceData)
-		if err != nil {
-			return "", fmt.Errorf("failed to unmarshal kubeNamespace data %w", err)
-		}
+	var kubeNamespaceData []*model.KubeNamespace
+	err = json.Unmarshal([]byte(request.KubeNamespace), &kubeNamespaceData)
+	if err != nil {
+		return "", fmt.Errorf("failed to unmarshal kubeNamespace data %w", err)
+	}
 
-		resp := model.KubeNamespaceResponse{
-			InfraID:       request.InfraID.InfraID,
-			KubeNamespace: kubeNamespaceData,
-		}
-		reqChan <- &resp
-		close(reqChan)
-		return "KubeData sent successfully", nil
+	resp := model.KubeNamespaceResponse{
+		InfraID:       request.InfraID.InfraID,
+		KubeNamespace: kubeNamespaceData,
+	}
+	if err = r.PublishKubeNamespace(context.Background(), request.RequestID, &resp); err != nil {
+		return "", err
 	}
 	return "KubeData sent successfully", nil
 }
@@ -1040,13 +1090,28 @@ func (in *infraService) SendInfraEvent(eventType, eventName, description string,
 		Description: description,
 		Infra:       &infra,
 	}
-	r.Mutex.Lock()
-	if r.InfraEventPublish != nil {
-		for _, observer := range r.InfraEventPublish[infra.ProjectID] {
-			observer <- &newEvent
+	if r.PubSub != nil {
+		if err := r.PublishInfraEvent(context.Background(), infra.ProjectID, &newEvent); err != nil {
+			logrus.WithField("infraID", infra.InfraID).Errorf("failed to publish infra event, error: %v", err)
 		}
 	}
-	r.Mutex.Unlock()
+
+	if cloudEventType, ok := getInfraCloudEventType(eventType, eventName); ok {
+		cloudevents.Emit(cloudevents.NewInfraEvent(cloudEventType, description, infra))
+	}
+}
+
+// getInfraCloudEventType returns the type of the cloudevent emitted for an infra event
+func getInfraCloudEventType(eventType, eventName string) (string, bool) {
+	switch {
+	case eventType == "infra-registration":
+		return cloudevents.InfraRegistered, true
+	case eventType == "infra-status" && eventName == "Infra Live":
+		return cloudevents.InfraConnected, true
+	case eventType == "infra-status" && eventName == "Infra Offline":
+		return cloudevents.InfraDisconnected, true
+	}
+	return "", false
 }
 
 // ConfirmInfraRegistration takes the cluster_id and access_key from the subscriber and validates it, if validated generates and sends new access_key
@@ -1159,3 +1224,18 @@ func (c *infraService) UpdateInfra(query bson.D, update bson.D) error {
 func (c *infraService) GetDBInfra(infraID string) (dbChaosInfra.ChaosInfra, error) {
 	return c.infraOperator.GetInfra(infraID)
 }
+
+func getInfraMaxConcurrentRuns(maxConcurrentRuns int) *int {
+	if maxConcurrentRuns <= 0 {
+		return nil
+	}
+	return &maxConcurrentRuns
+}
+
+func getInfraRunQueuePolicy(policy string) *model.RunQueuePolicy {
+	if policy == "" {
+		return nil
+	}
+	runQueuePolicy := model.RunQueuePolicy(policy)
+	return &runQueuePolicy
+}
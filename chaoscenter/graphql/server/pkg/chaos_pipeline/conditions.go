@@ -0,0 +1,68 @@
+package chaos_pipeline
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosPipeline "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_pipeline"
+)
+
+// failedRunPhases are the phases of the experiment runs which fail the stage of a pipeline
+var failedRunPhases = map[string]bool{
+	string(model.ExperimentRunStatusError):      true,
+	string(model.ExperimentRunStatusStopped):    true,
+	string(model.ExperimentRunStatusTerminated): true,
+	string(model.ExperimentRunStatusTimeout):    true,
+	string(model.ExperimentRunStatusSkipped):    true,
+}
+
+// EvaluatePipelineConditions checks if all the conditions are met by the experiment run, the
+// returned message describes the first condition which is not met
+func EvaluatePipelineConditions(conditions []dbChaosPipeline.PipelineCondition, experimentRun dbChaosExperimentRun.ChaosExperimentRun) (bool, string) {
+	for _, condition := range conditions {
+		value := getConditionFieldValue(condition.Field, experimentRun)
+		if !compareConditionValue(value, condition.Operator, condition.Value) {
+			return false, fmt.Sprintf("condition %s %s %s is not met, the value is %s", condition.Field, condition.Operator,
+				strconv.FormatFloat(condition.Value, 'f', -1, 64), strconv.FormatFloat(value, 'f', -1, 64))
+		}
+	}
+	return true, ""
+}
+
+func getConditionFieldValue(field model.PipelineConditionField, experimentRun dbChaosExperimentRun.ChaosExperimentRun) float64 {
+	switch field {
+	case model.PipelineConditionFieldResiliencyScore:
+		if experimentRun.ResiliencyScore != nil {
+			return *experimentRun.ResiliencyScore
+		}
+	case model.PipelineConditionFieldFaultsPassed:
+		if experimentRun.FaultsPassed != nil {
+			return float64(*experimentRun.FaultsPassed)
+		}
+	case model.PipelineConditionFieldFaultsFailed:
+		if experimentRun.FaultsFailed != nil {
+			return float64(*experimentRun.FaultsFailed)
+		}
+	}
+	return 0
+}
+
+func compareConditionValue(value float64, operator model.PipelineConditionOperator, expected float64) bool {
+	switch operator {
+	case model.PipelineConditionOperatorEqual:
+		return value == expected
+	case model.PipelineConditionOperatorNotEqual:
+		return value != expected
+	case model.PipelineConditionOperatorGreaterThan:
+		return value > expected
+	case model.PipelineConditionOperatorGreaterThanOrEqual:
+		return value >= expected
+	case model.PipelineConditionOperatorLessThan:
+		return value < expected
+	case model.PipelineConditionOperatorLessThanOrEqual:
+		return value <= expected
+	}
+	return false
+}
@@ -0,0 +1,72 @@
+package chaos_pipeline
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosPipeline "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_pipeline"
+)
+
+func TestEvaluatePipelineConditions(t *testing.T) {
+	resiliencyScore := 80.0
+	faultsPassed := 3
+	faultsFailed := 1
+	experimentRun := dbChaosExperimentRun.ChaosExperimentRun{
+		ResiliencyScore: &resiliencyScore,
+		FaultsPassed:    &faultsPassed,
+		FaultsFailed:    &faultsFailed,
+	}
+
+	tests := []struct {
+		name          string
+		conditions    []dbChaosPipeline.PipelineCondition
+		experimentRun dbChaosExperimentRun.ChaosExperimentRun
+		want          bool
+	}{
+		{
+			name:          "stage without conditions is always started",
+			experimentRun: experimentRun,
+			want:          true,
+		},
+		{
+			name: "all the conditions are met",
+			conditions: []dbChaosPipeline.PipelineCondition{
+				{Field: model.PipelineConditionFieldResiliencyScore, Operator: model.PipelineConditionOperatorGreaterThanOrEqual, Value: 80},
+				{Field: model.PipelineConditionFieldFaultsPassed, Operator: model.PipelineConditionOperatorGreaterThan, Value: 2},
+				{Field: model.PipelineConditionFieldFaultsFailed, Operator: model.PipelineConditionOperatorLessThanOrEqual, Value: 1},
+			},
+			experimentRun: experimentRun,
+			want:          true,
+		},
+		{
+			name: "one of the conditions is not met",
+			conditions: []dbChaosPipeline.PipelineCondition{
+				{Field: model.PipelineConditionFieldResiliencyScore, Operator: model.PipelineConditionOperatorGreaterThan, Value: 50},
+				{Field: model.PipelineConditionFieldFaultsFailed, Operator: model.PipelineConditionOperatorEqual, Value: 0},
+			},
+			experimentRun: experimentRun,
+			want:          false,
+		},
+		{
+			name: "missing metrics of the experiment run are treated as zero",
+			conditions: []dbChaosPipeline.PipelineCondition{
+				{Field: model.PipelineConditionFieldResiliencyScore, Operator: model.PipelineConditionOperatorLessThan, Value: 1},
+				{Field: model.PipelineConditionFieldFaultsPassed, Operator: model.PipelineConditionOperatorNotEqual, Value: 1},
+			},
+			experimentRun: dbChaosExperimentRun.ChaosExperimentRun{},
+			want:          true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, message := EvaluatePipelineConditions(tc.conditions, tc.experimentRun)
+			if got != tc.want {
+				t.Errorf("EvaluatePipelineConditions() = %v, want %v", got, tc.want)
+			}
+			if !got && message == "" {
+				t.Errorf("EvaluatePipelineConditions() returned no message for an unmet condition")
+			}
+		})
+	}
+}
@@ -0,0 +1,668 @@
+package chaos_pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbChaosPipeline "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_pipeline"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Service is the interface for the chaos pipeline service
+type Service interface {
+	CreatePipeline(ctx context.Context, projectID string, request model.CreateChaosPipelineRequest, username string) (*model.ChaosPipeline, error)
+	UpdatePipeline(ctx context.Context, projectID string, request model.UpdateChaosPipelineRequest, username string) (*model.ChaosPipeline, error)
+	DeletePipeline(ctx context.Context, projectID string, pipelineID string, username string) (bool, error)
+	GetPipeline(ctx context.Context, projectID string, pipelineID string) (*model.ChaosPipeline, error)
+	ListPipelines(ctx context.Context, projectID string) ([]*model.ChaosPipeline, error)
+	RunPipeline(ctx context.Context, projectID string, pipelineID string, username string, r *store.StateData) (*model.PipelineExecution, error)
+	StopPipelineExecution(ctx context.Context, projectID string, pipelineExecutionID string, username string, r *store.StateData) (bool, error)
+	GetPipelineExecution(ctx context.Context, projectID string, pipelineExecutionID string) (*model.PipelineExecution, error)
+	ListPipelineExecutions(ctx context.Context, projectID string, pipelineID string) ([]*model.PipelineExecution, error)
+	ExperimentRunCompleted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, r *store.StateData)
+}
+
+// ExperimentRunner runs the experiments of the pipeline stages
+type ExperimentRunner interface {
+	RunChaosWorkFlowForUser(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, username string, notifyID string, r *store.StateData) (*model.RunChaosExperimentResponse, error)
+}
+
+// ExperimentStopper stops the experiment runs of the stopped pipeline executions
+type ExperimentStopper interface {
+	StopExperimentRun(ctx context.Context, projectID string, notifyID string, r *store.StateData, username string) (bool, error)
+}
+
+// chaosPipelineService is the implementation of the chaos pipeline service
+type chaosPipelineService struct {
+	chaosPipelineOperator   *dbChaosPipeline.Operator
+	chaosExperimentOperator *dbChaosExperiment.Operator
+	experimentRunner        ExperimentRunner
+	experimentStopper       ExperimentStopper
+	mongodbOperator         mongodb.MongoOperator
+}
+
+// NewChaosPipelineService returns a new instance of the chaos pipeline service
+func NewChaosPipelineService(chaosPipelineOperator *dbChaosPipeline.Operator, chaosExperimentOperator *dbChaosExperiment.Operator, experimentRunner ExperimentRunner, experimentStopper ExperimentStopper, mongodbOperator mongodb.MongoOperator) Service {
+	return &chaosPipelineService{
+		chaosPipelineOperator:   chaosPipelineOperator,
+		chaosExperimentOperator: chaosExperimentOperator,
+		experimentRunner:        experimentRunner,
+		experimentStopper:       experimentStopper,
+		mongodbOperator:         mongodbOperator,
+	}
+}
+
+// CreatePipeline validates the stages of the pipeline and stores it in the database
+func (c *chaosPipelineService) CreatePipeline(ctx context.Context, projectID string, request model.CreateChaosPipelineRequest, username string) (*model.ChaosPipeline, error) {
+	stages, err := c.getPipelineStages(ctx, projectID, request.Stages)
+	if err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if request.Description != nil {
+		description = *request.Description
+	}
+	currentTime := time.Now().UnixMilli()
+	pipeline := dbChaosPipeline.ChaosPipeline{
+		ResourceDetails: mongodb.ResourceDetails{
+			Name:        request.Name,
+			Description: description,
+			Tags:        request.Tags,
+		},
+		Audit: mongodb.Audit{
+			CreatedAt: currentTime,
+			UpdatedAt: currentTime,
+			CreatedBy: mongodb.UserDetailResponse{
+				Username: username,
+			},
+			UpdatedBy: mongodb.UserDetailResponse{
+				Username: username,
+			},
+		},
+		ProjectID:  projectID,
+		PipelineID: uuid.NewString(),
+		Stages:     stages,
+	}
+	if err := c.chaosPipelineOperator.InsertPipeline(ctx, pipeline); err != nil {
+		return nil, err
+	}
+
+	return getModelPipeline(pipeline), nil
+}
+
+// UpdatePipeline replaces the details and the stages of the pipeline, running executions keep their stages
+func (c *chaosPipelineService) UpdatePipeline(ctx context.Context, projectID string, request model.UpdateChaosPipelineRequest, username string) (*model.ChaosPipeline, error) {
+	stages, err := c.getPipelineStages(ctx, projectID, request.Stages)
+	if err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if request.Description != nil {
+		description = *request.Description
+	}
+	query := bson.D{
+		{"pipeline_id", request.PipelineID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	update := bson.D{
+		{"$set", bson.D{
+			{"name", request.Name},
+			{"description", description},
+			{"tags", request.Tags},
+			{"stages", stages},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", mongodb.UserDetailResponse{
+				Username: username,
+			}},
+		}},
+	}
+	matched, err := c.chaosPipelineOperator.UpdatePipeline(ctx, query, update)
+	if err != nil {
+		return nil, err
+	}
+	if matched == 0 {
+		return nil, fmt.Errorf("pipeline %s not found", request.PipelineID)
+	}
+
+	return c.GetPipeline(ctx, projectID, request.PipelineID)
+}
+
+// DeletePipeline marks the pipeline as removed, running executions are not affected
+func (c *chaosPipelineService) DeletePipeline(ctx context.Context, projectID string, pipelineID string, username string) (bool, error) {
+	query := bson.D{
+		{"pipeline_id", pipelineID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}
+	update := bson.D{
+		{"$set", bson.D{
+			{"is_removed", true},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", mongodb.UserDetailResponse{
+				Username: username,
+			}},
+		}},
+	}
+	matched, err := c.chaosPipelineOperator.UpdatePipeline(ctx, query, update)
+	if err != nil {
+		return false, err
+	}
+	if matched == 0 {
+		return false, fmt.Errorf("pipeline %s not found", pipelineID)
+	}
+
+	return true, nil
+}
+
+// GetPipeline returns the details of the pipeline
+func (c *chaosPipelineService) GetPipeline(ctx context.Context, projectID string, pipelineID string) (*model.ChaosPipeline, error) {
+	pipeline, err := c.chaosPipelineOperator.GetPipeline(ctx, bson.D{
+		{"pipeline_id", pipelineID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("pipeline %s not found", pipelineID)
+		}
+		return nil, err
+	}
+
+	return getModelPipeline(pipeline), nil
+}
+
+// ListPipelines returns the pipelines of the project
+func (c *chaosPipelineService) ListPipelines(ctx context.Context, projectID string) ([]*model.ChaosPipeline, error) {
+	pipelines, err := c.chaosPipelineOperator.GetPipelines(ctx, bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.ChaosPipeline{}
+	for _, pipeline := range pipelines {
+		result = append(result, getModelPipeline(pipeline))
+	}
+	return result, nil
+}
+
+// RunPipeline starts an execution of the pipeline by running its first stage, the next stages
+// are run once the experiment run of the previous stage is completed and their conditions are met.
+// Pipelines with stages on environments which require run approvals are not run
+func (c *chaosPipelineService) RunPipeline(ctx context.Context, projectID string, pipelineID string, username string, r *store.StateData) (*model.PipelineExecution, error) {
+	pipeline, err := c.chaosPipelineOperator.GetPipeline(ctx, bson.D{
+		{"pipeline_id", pipelineID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("pipeline %s not found", pipelineID)
+		}
+		return nil, err
+	}
+	for _, stage := range pipeline.Stages {
+		experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+			{"experiment_id", stage.ExperimentID},
+			{"project_id", projectID},
+			{"is_removed", false},
+		})
+		if err != nil {
+			return nil, fmt.Errorf("failed to get experiment %s of stage %s, error: %v", stage.ExperimentID, stage.Name, err)
+		}
+		if err := c.validateStageEnvironment(ctx, experiment, stage.Name); err != nil {
+			return nil, err
+		}
+	}
+
+	execution := dbChaosPipeline.PipelineExecution{
+		ProjectID:           projectID,
+		PipelineExecutionID: uuid.NewString(),
+		PipelineID:          pipeline.PipelineID,
+		PipelineName:        pipeline.Name,
+		Status:              model.PipelineExecutionStatusRunning,
+		StartedAt:           time.Now().UnixMilli(),
+		StartedBy: mongodb.UserDetailResponse{
+			Username: username,
+		},
+	}
+	for _, stage := range pipeline.Stages {
+		execution.Stages = append(execution.Stages, dbChaosPipeline.PipelineStageExecution{
+			PipelineStage: stage,
+			Status:        model.PipelineStageStatusPending,
+		})
+	}
+	if err := c.chaosPipelineOperator.InsertPipelineExecution(ctx, execution); err != nil {
+		return nil, err
+	}
+
+	if err := c.startPipelineStage(ctx, execution, 0, r); err != nil {
+		return nil, err
+	}
+	return c.GetPipelineExecution(ctx, projectID, execution.PipelineExecutionID)
+}
+
+// StopPipelineExecution stops the execution so that its remaining stages are not run and stops the experiment run
+// of its current stage, the result of the stopped run is recorded on the stage once it completes
+func (c *chaosPipelineService) StopPipelineExecution(ctx context.Context, projectID string, pipelineExecutionID string, username string, r *store.StateData) (bool, error) {
+	execution, err := c.chaosPipelineOperator.GetPipelineExecution(ctx, bson.D{
+		{"pipeline_execution_id", pipelineExecutionID},
+		{"project_id", projectID},
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return false, fmt.Errorf("pipeline execution %s not found", pipelineExecutionID)
+		}
+		return false, err
+	}
+	if execution.Status != model.PipelineExecutionStatusRunning {
+		return false, fmt.Errorf("pipeline execution %s is not running", pipelineExecutionID)
+	}
+
+	set := bson.D{
+		{"status", model.PipelineExecutionStatusStopped},
+		{"completed_at", time.Now().UnixMilli()},
+	}
+	set = append(set, getSkippedStagesUpdate(execution, execution.CurrentStage+1, "pipeline execution is stopped")...)
+	matched, err := c.chaosPipelineOperator.UpdatePipelineExecution(ctx, bson.D{
+		{"pipeline_execution_id", pipelineExecutionID},
+		{"status", model.PipelineExecutionStatusRunning},
+	}, bson.D{{"$set", set}})
+	if err != nil || matched == 0 {
+		return false, err
+	}
+
+	// The execution is stopped first so that the stopped run doesn't fail it
+	if execution.CurrentStage >= len(execution.Stages) {
+		return true, nil
+	}
+	if stage := execution.Stages[execution.CurrentStage]; stage.Status == model.PipelineStageStatusRunning && stage.NotifyID != "" {
+		if _, err := c.experimentStopper.StopExperimentRun(ctx, projectID, stage.NotifyID, r, username); err != nil {
+			logrus.WithFields(logrus.Fields{
+				"pipelineExecutionId": pipelineExecutionID,
+				"notifyID":            stage.NotifyID,
+			}).Errorf("failed to stop the experiment run of the current stage, error: %v", err)
+		}
+	}
+
+	return true, nil
+}
+
+// GetPipelineExecution returns the status of the pipeline execution
+func (c *chaosPipelineService) GetPipelineExecution(ctx context.Context, projectID string, pipelineExecutionID string) (*model.PipelineExecution, error) {
+	execution, err := c.chaosPipelineOperator.GetPipelineExecution(ctx, bson.D{
+		{"pipeline_execution_id", pipelineExecutionID},
+		{"project_id", projectID},
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("pipeline execution %s not found", pipelineExecutionID)
+		}
+		return nil, err
+	}
+
+	return getModelPipelineExecution(execution), nil
+}
+
+// ListPipelineExecutions returns the executions of the pipeline, the latest execution first
+func (c *chaosPipelineService) ListPipelineExecutions(ctx context.Context, projectID string, pipelineID string) ([]*model.PipelineExecution, error) {
+	executions, err := c.chaosPipelineOperator.GetPipelineExecutions(ctx, bson.D{
+		{"pipeline_id", pipelineID},
+		{"project_id", projectID},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.PipelineExecution{}
+	for _, execution := range executions {
+		result = append(result, getModelPipelineExecution(execution))
+	}
+	return result, nil
+}
+
+// ExperimentRunCompleted records the result of the experiment run on the stage which started it and
+// runs the next stage of the pipeline execution if its conditions are met by the experiment run
+func (c *chaosPipelineService) ExperimentRunCompleted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, r *store.StateData) {
+	if experimentRun.NotifyID == nil {
+		return
+	}
+	logFields := logrus.Fields{
+		"chaosExperimentId": experimentRun.ExperimentID,
+		"notifyID":          *experimentRun.NotifyID,
+	}
+
+	execution, err := c.chaosPipelineOperator.GetPipelineExecution(ctx, bson.D{
+		{"stages.notify_id", *experimentRun.NotifyID},
+	})
+	if err != nil {
+		if !errors.Is(err, mongo.ErrNoDocuments) {
+			logrus.WithFields(logFields).Errorf("failed to get pipeline execution of experiment run, error: %v", err)
+		}
+		return
+	}
+
+	index := -1
+	for i, stage := range execution.Stages {
+		if stage.NotifyID == *experimentRun.NotifyID {
+			index = i
+		}
+	}
+	if index < 0 || execution.Stages[index].Status != model.PipelineStageStatusRunning {
+		return
+	}
+
+	currentTime := time.Now().UnixMilli()
+	stageKey := "stages." + strconv.Itoa(index) + "."
+	set := bson.D{
+		{stageKey + "experiment_run_id", experimentRun.ExperimentRunID},
+		{stageKey + "phase", experimentRun.Phase},
+		{stageKey + "resiliency_score", experimentRun.ResiliencyScore},
+		{stageKey + "faults_passed", experimentRun.FaultsPassed},
+		{stageKey + "faults_failed", experimentRun.FaultsFailed},
+		{stageKey + "completed_at", currentTime},
+	}
+
+	startNextStage := false
+	if failedRunPhases[experimentRun.Phase] {
+		set = append(set,
+			bson.E{Key: stageKey + "status", Value: model.PipelineStageStatusFailed},
+			bson.E{Key: stageKey + "message", Value: "experiment run ended with phase " + experimentRun.Phase},
+		)
+	} else {
+		set = append(set, bson.E{Key: stageKey + "status", Value: model.PipelineStageStatusCompleted})
+	}
+
+	// Stopped executions only record the result of the running stage
+	if execution.Status == model.PipelineExecutionStatusRunning {
+		switch {
+		case failedRunPhases[experimentRun.Phase]:
+			set = append(set,
+				bson.E{Key: "status", Value: model.PipelineExecutionStatusFailed},
+				bson.E{Key: "completed_at", Value: currentTime},
+			)
+			set = append(set, getSkippedStagesUpdate(execution, index+1, "previous stage failed")...)
+		case index+1 == len(execution.Stages):
+			set = append(set,
+				bson.E{Key: "status", Value: model.PipelineExecutionStatusCompleted},
+				bson.E{Key: "completed_at", Value: currentTime},
+			)
+		default:
+			if met, message := EvaluatePipelineConditions(execution.Stages[index+1].Conditions, experimentRun); !met {
+				set = append(set,
+					bson.E{Key: "status", Value: model.PipelineExecutionStatusConditionNotMet},
+					bson.E{Key: "completed_at", Value: currentTime},
+				)
+				set = append(set, getSkippedStagesUpdate(execution, index+1, message)...)
+			} else {
+				startNextStage = true
+			}
+		}
+	}
+
+	matched, err := c.chaosPipelineOperator.UpdatePipelineExecution(ctx, bson.D{
+		{"pipeline_execution_id", execution.PipelineExecutionID},
+		{stageKey + "status", model.PipelineStageStatusRunning},
+	}, bson.D{{"$set", set}})
+	if err != nil {
+		logrus.WithFields(logFields).Errorf("failed to update pipeline execution %s, error: %v", execution.PipelineExecutionID, err)
+		return
+	}
+	if matched == 0 || !startNextStage {
+		return
+	}
+
+	if err := c.startPipelineStage(ctx, execution, index+1, r); err != nil {
+		logrus.WithFields(logFields).Errorf("failed to start stage %d of pipeline execution %s, error: %v", index+1, execution.PipelineExecutionID, err)
+	}
+}
+
+// startPipelineStage runs the experiment of the stage, the execution fails if the experiment can't be run. The notify
+// ID of the run is recorded on the stage before the run is started so that the completion of the run always finds it
+func (c *chaosPipelineService) startPipelineStage(ctx context.Context, execution dbChaosPipeline.PipelineExecution, index int, r *store.StateData) error {
+	stage := execution.Stages[index]
+	stageKey := "stages." + strconv.Itoa(index) + "."
+	query := bson.D{
+		{"pipeline_execution_id", execution.PipelineExecutionID},
+		{"status", model.PipelineExecutionStatusRunning},
+	}
+
+	notifyID := uuid.NewString()
+	matched, err := c.chaosPipelineOperator.UpdatePipelineExecution(ctx, query, bson.D{
+		{"$set", bson.D{
+			{"current_stage", index},
+			{stageKey + "status", model.PipelineStageStatusRunning},
+			{stageKey + "notify_id", notifyID},
+			{stageKey + "started_at", time.Now().UnixMilli()},
+		}},
+	})
+	// the execution has been stopped in the meantime
+	if err != nil || matched == 0 {
+		return err
+	}
+
+	if err := c.runStageExperiment(ctx, execution, stage, notifyID, r); err != nil {
+		currentTime := time.Now().UnixMilli()
+		set := bson.D{
+			{"status", model.PipelineExecutionStatusFailed},
+			{"completed_at", currentTime},
+			{stageKey + "status", model.PipelineStageStatusFailed},
+			{stageKey + "message", err.Error()},
+			{stageKey + "completed_at", currentTime},
+		}
+		set = append(set, getSkippedStagesUpdate(execution, index+1, "previous stage failed")...)
+		if _, updateErr := c.chaosPipelineOperator.UpdatePipelineExecution(ctx, query, bson.D{{"$set", set}}); updateErr != nil {
+			return updateErr
+		}
+	}
+	return nil
+}
+
+func (c *chaosPipelineService) runStageExperiment(ctx context.Context, execution dbChaosPipeline.PipelineExecution, stage dbChaosPipeline.PipelineStageExecution, notifyID string, r *store.StateData) error {
+	experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", stage.ExperimentID},
+		{"project_id", execution.ProjectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to get experiment %s, error: %v", stage.ExperimentID, err)
+	}
+	// The environment may require run approvals since the execution started
+	if err := c.validateStageEnvironment(ctx, experiment, stage.Name); err != nil {
+		return err
+	}
+
+	_, err = c.experimentRunner.RunChaosWorkFlowForUser(ctx, execution.ProjectID, experiment, execution.StartedBy.Username, notifyID, r)
+	return err
+}
+
+// validateStageEnvironment checks that the experiment of the stage can be run without an approval, the stages
+// are run without waiting for approvals so experiments on environments which require them are refused
+func (c *chaosPipelineService) validateStageEnvironment(ctx context.Context, experiment dbChaosExperiment.ChaosExperimentRequest, stageName string) error {
+	infra, err := dbChaosInfra.NewInfrastructureOperator(c.mongodbOperator).GetInfra(experiment.InfraID)
+	if err != nil {
+		return fmt.Errorf("failed to get infra %s of stage %s, error: %v", experiment.InfraID, stageName, err)
+	}
+	required, err := envHandler.InfraRequiresRunApproval(ctx, c.mongodbOperator, infra)
+	if err != nil {
+		return err
+	}
+	if required {
+		return fmt.Errorf("experiment %s of stage %s runs on an environment which requires run approvals", experiment.ExperimentID, stageName)
+	}
+	return nil
+}
+
+// getPipelineStages validates the requested stages, the experiments of the stages must be non-cron experiments of the project
+// which don't require run approvals
+func (c *chaosPipelineService) getPipelineStages(ctx context.Context, projectID string, request []*model.PipelineStageInput) ([]dbChaosPipeline.PipelineStage, error) {
+	if len(request) == 0 {
+		return nil, errors.New("pipeline must have at least one stage")
+	}
+
+	var stages []dbChaosPipeline.PipelineStage
+	for i, stageRequest := range request {
+		if stageRequest.Name == "" {
+			return nil, fmt.Errorf("name of stage %d is not provided", i+1)
+		}
+		experiment, err := c.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+			{"experiment_id", stageRequest.ExperimentID},
+			{"project_id", projectID},
+			{"is_removed", false},
+		})
+		if err != nil {
+			if errors.Is(err, mongo.ErrNoDocuments) {
+				return nil, fmt.Errorf("experiment %s of stage %s not found", stageRequest.ExperimentID, stageRequest.Name)
+			}
+			return nil, err
+		}
+		if experiment.ExperimentType == dbChaosExperiment.CronExperiment {
+			return nil, fmt.Errorf("cron experiment %s can not be used in stage %s", stageRequest.ExperimentID, stageRequest.Name)
+		}
+		if err := c.validateStageEnvironment(ctx, experiment, stageRequest.Name); err != nil {
+			return nil, err
+		}
+
+		stage := dbChaosPipeline.PipelineStage{
+			Name:         stageRequest.Name,
+			ExperimentID: stageRequest.ExperimentID,
+		}
+		for _, condition := range stageRequest.Conditions {
+			if !condition.Field.IsValid() || !condition.Operator.IsValid() {
+				return nil, fmt.Errorf("invalid condition of stage %s", stageRequest.Name)
+			}
+			stage.Conditions = append(stage.Conditions, dbChaosPipeline.PipelineCondition{
+				Field:    condition.Field,
+				Operator: condition.Operator,
+				Value:    condition.Value,
+			})
+		}
+		stages = append(stages, stage)
+	}
+	return stages, nil
+}
+
+// getSkippedStagesUpdate returns the update which marks the stages from the given index as skipped
+func getSkippedStagesUpdate(execution dbChaosPipeline.PipelineExecution, from int, message string) bson.D {
+	var set bson.D
+	for i := from; i < len(execution.Stages); i++ {
+		if execution.Stages[i].Status != model.PipelineStageStatusPending {
+			continue
+		}
+		stageKey := "stages." + strconv.Itoa(i) + "."
+		set = append(set,
+			bson.E{Key: stageKey + "status", Value: model.PipelineStageStatusSkipped},
+			bson.E{Key: stageKey + "message", Value: message},
+		)
+	}
+	return set
+}
+
+func getModelPipeline(pipeline dbChaosPipeline.ChaosPipeline) *model.ChaosPipeline {
+	description := pipeline.Description
+	result := &model.ChaosPipeline{
+		ProjectID:   pipeline.ProjectID,
+		PipelineID:  pipeline.PipelineID,
+		Name:        pipeline.Name,
+		Description: &description,
+		Tags:        pipeline.Tags,
+		CreatedAt:   strconv.FormatInt(pipeline.CreatedAt, 10),
+		UpdatedAt:   strconv.FormatInt(pipeline.UpdatedAt, 10),
+		CreatedBy: &model.UserDetails{
+			Username: pipeline.CreatedBy.Username,
+		},
+		UpdatedBy: &model.UserDetails{
+			Username: pipeline.UpdatedBy.Username,
+		},
+		IsRemoved: pipeline.IsRemoved,
+	}
+	for _, stage := range pipeline.Stages {
+		result.Stages = append(result.Stages, &model.PipelineStage{
+			Name:         stage.Name,
+			ExperimentID: stage.ExperimentID,
+			Conditions:   getModelPipelineConditions(stage.Conditions),
+		})
+	}
+	return result
+}
+
+func getModelPipelineConditions(conditions []dbChaosPipeline.PipelineCondition) []*model.PipelineCondition {
+	var result []*model.PipelineCondition
+	for _, condition := range conditions {
+		result = append(result, &model.PipelineCondition{
+			Field:    condition.Field,
+			Operator: condition.Operator,
+			Value:    condition.Value,
+		})
+	}
+	return result
+}
+
+func getModelPipelineExecution(execution dbChaosPipeline.PipelineExecution) *model.PipelineExecution {
+	result := &model.PipelineExecution{
+		ProjectID:           execution.ProjectID,
+		PipelineExecutionID: execution.PipelineExecutionID,
+		PipelineID:          execution.PipelineID,
+		PipelineName:        execution.PipelineName,
+		Status:              execution.Status,
+		CurrentStage:        execution.CurrentStage,
+		StartedAt:           strconv.FormatInt(execution.StartedAt, 10),
+		CompletedAt:         getOptionalTimestamp(execution.CompletedAt),
+		StartedBy: &model.UserDetails{
+			Username: execution.StartedBy.Username,
+		},
+		Stages: []*model.PipelineStageExecution{},
+	}
+	for _, stage := range execution.Stages {
+		stageExecution := &model.PipelineStageExecution{
+			Name:            stage.Name,
+			ExperimentID:    stage.ExperimentID,
+			Status:          stage.Status,
+			ResiliencyScore: stage.ResiliencyScore,
+			FaultsPassed:    stage.FaultsPassed,
+			FaultsFailed:    stage.FaultsFailed,
+			StartedAt:       getOptionalTimestamp(stage.StartedAt),
+			CompletedAt:     getOptionalTimestamp(stage.CompletedAt),
+		}
+		if stage.NotifyID != "" {
+			stageExecution.NotifyID = &stage.NotifyID
+		}
+		if stage.ExperimentRunID != "" {
+			stageExecution.ExperimentRunID = &stage.ExperimentRunID
+		}
+		if stage.Phase != "" {
+			stageExecution.Phase = &stage.Phase
+		}
+		if stage.Message != "" {
+			stageExecution.Message = &stage.Message
+		}
+		result.Stages = append(result.Stages, stageExecution)
+	}
+	return result
+}
+
+func getOptionalTimestamp(timestamp int64) *string {
+	if timestamp == 0 {
+		return nil
+	}
+	formatted := strconv.FormatInt(timestamp, 10)
+	return &formatted
+}
@@ -0,0 +1,175 @@
+package chaos_pipeline
+
+import (
+	"context"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosPipeline "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_pipeline"
+	dbMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/mocks"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type experimentStopRecorder struct {
+	notifyIDs []string
+}
+
+func (e *experimentStopRecorder) StopExperimentRun(_ context.Context, _ string, notifyID string, _ *store.StateData, _ string) (bool, error) {
+	e.notifyIDs = append(e.notifyIDs, notifyID)
+	return true, nil
+}
+
+// experimentRunRecorder records the runs and whether the notify IDs of the runs were recorded on the stages before
+type experimentRunRecorder struct {
+	runs              int
+	unrecordedRuns    int
+	recordedNotifyIDs map[string]bool
+}
+
+func (e *experimentRunRecorder) RunChaosWorkFlowForUser(_ context.Context, _ string, _ dbChaosExperiment.ChaosExperimentRequest, _ string, notifyID string, _ *store.StateData) (*model.RunChaosExperimentResponse, error) {
+	e.runs++
+	if !e.recordedNotifyIDs[notifyID] {
+		e.unrecordedRuns++
+	}
+	return &model.RunChaosExperimentResponse{NotifyID: notifyID}, nil
+}
+
+func TestChaosPipelineService_StopPipelineExecution(t *testing.T) {
+	tests := []struct {
+		name          string
+		matchedCount  int64
+		wantStopped   bool
+		wantNotifyIDs int
+	}{
+		{
+			name:          "success: experiment run of the current stage is stopped",
+			matchedCount:  1,
+			wantStopped:   true,
+			wantNotifyIDs: 1,
+		},
+		{
+			name: "success: execution stopped in the meantime is left as is",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mongodbMockOperator := new(dbMocks.MongoOperator)
+			stopper := &experimentStopRecorder{}
+			service := NewChaosPipelineService(dbChaosPipeline.NewChaosPipelineOperator(mongodbMockOperator), dbChaosExperiment.NewChaosExperimentOperator(mongodbMockOperator), nil, stopper, mongodbMockOperator)
+
+			execution := mongo.NewSingleResultFromDocument(bson.D{
+				{Key: "pipeline_execution_id", Value: "execution"},
+				{Key: "project_id", Value: "project"},
+				{Key: "status", Value: model.PipelineExecutionStatusRunning},
+				{Key: "current_stage", Value: 0},
+				{Key: "stages", Value: bson.A{
+					bson.D{{Key: "status", Value: model.PipelineStageStatusRunning}, {Key: "notify_id", Value: "notify"}},
+					bson.D{{Key: "status", Value: model.PipelineStageStatusPending}},
+				}},
+			}, nil, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosPipelineExecutionCollection, mock.Anything).Return(execution, nil).Once()
+			mongodbMockOperator.On("Update", mock.Anything, mongodb.ChaosPipelineExecutionCollection, mock.Anything, mock.Anything, mock.Anything).
+				Return(&mongo.UpdateResult{MatchedCount: tc.matchedCount}, nil).Once()
+
+			stopped, err := service.StopPipelineExecution(context.Background(), "project", "execution", "owner", nil)
+			if err != nil {
+				t.Fatalf("StopPipelineExecution() error = %v", err)
+			}
+			if stopped != tc.wantStopped {
+				t.Errorf("StopPipelineExecution() = %v, want %v", stopped, tc.wantStopped)
+			}
+			if len(stopper.notifyIDs) != tc.wantNotifyIDs {
+				t.Errorf("StopPipelineExecution() stopped the runs %v, want %d runs", stopper.notifyIDs, tc.wantNotifyIDs)
+			}
+		})
+	}
+}
+
+func TestChaosPipelineService_RunPipeline(t *testing.T) {
+	tests := []struct {
+		name               string
+		requireRunApproval bool
+		wantErr            bool
+		wantRuns           int
+	}{
+		{
+			name:               "failure: stage on an environment which requires run approvals is refused",
+			requireRunApproval: true,
+			wantErr:            true,
+		},
+		{
+			name:     "success: stages on environments which don't require run approvals are run",
+			wantRuns: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mongodbMockOperator := new(dbMocks.MongoOperator)
+			runner := &experimentRunRecorder{recordedNotifyIDs: map[string]bool{}}
+			service := NewChaosPipelineService(dbChaosPipeline.NewChaosPipelineOperator(mongodbMockOperator), dbChaosExperiment.NewChaosExperimentOperator(mongodbMockOperator), runner, nil, mongodbMockOperator)
+
+			pipeline := mongo.NewSingleResultFromDocument(bson.D{
+				{Key: "pipeline_id", Value: "pipeline"},
+				{Key: "project_id", Value: "project"},
+				{Key: "stages", Value: bson.A{
+					bson.D{{Key: "name", Value: "stage"}, {Key: "experiment_id", Value: "experiment"}},
+				}},
+			}, nil, nil)
+			experiment := mongo.NewSingleResultFromDocument(bson.D{
+				{Key: "experiment_id", Value: "experiment"},
+				{Key: "infra_id", Value: "infra"},
+			}, nil, nil)
+			infra := mongo.NewSingleResultFromDocument(bson.D{
+				{Key: "infra_id", Value: "infra"},
+				{Key: "project_id", Value: "project"},
+				{Key: "environment_id", Value: "prod"},
+			}, nil, nil)
+			environment := mongo.NewSingleResultFromDocument(bson.D{
+				{Key: "environment_id", Value: "prod"},
+				{Key: "type", Value: model.EnvironmentTypeProd},
+				{Key: "require_run_approval", Value: tc.requireRunApproval},
+			}, nil, nil)
+			execution := mongo.NewSingleResultFromDocument(bson.D{
+				{Key: "pipeline_execution_id", Value: "execution"},
+				{Key: "project_id", Value: "project"},
+				{Key: "status", Value: model.PipelineExecutionStatusRunning},
+			}, nil, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosPipelineCollection, mock.Anything).Return(pipeline, nil).Once()
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(experiment, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosInfraCollection, mock.Anything).Return(infra, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.EnvironmentCollection, mock.Anything).Return(environment, nil)
+			mongodbMockOperator.On("Create", mock.Anything, mongodb.ChaosPipelineExecutionCollection, mock.Anything).Return(nil).Maybe()
+			mongodbMockOperator.On("Update", mock.Anything, mongodb.ChaosPipelineExecutionCollection, mock.Anything, mock.Anything, mock.Anything).
+				Return(&mongo.UpdateResult{MatchedCount: 1}, nil).Maybe().
+				Run(func(args mock.Arguments) {
+					for _, operation := range args.Get(3).(bson.D) {
+						for _, field := range operation.Value.(bson.D) {
+							if field.Key == "stages.0.notify_id" {
+								runner.recordedNotifyIDs[field.Value.(string)] = true
+							}
+						}
+					}
+				})
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosPipelineExecutionCollection, mock.Anything).Return(execution, nil).Maybe()
+
+			_, err := service.RunPipeline(context.Background(), "project", "pipeline", "executor", nil)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RunPipeline() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				mongodbMockOperator.AssertNotCalled(t, "Create", mock.Anything, mongodb.ChaosPipelineExecutionCollection, mock.Anything)
+			}
+			if runner.runs != tc.wantRuns {
+				t.Errorf("RunPipeline() ran %d experiments, want %d", runner.runs, tc.wantRuns)
+			}
+			if runner.unrecordedRuns != 0 {
+				t.Errorf("RunPipeline() started %d runs before recording their notify ID on the stage", runner.unrecordedRuns)
+			}
+		})
+	}
+}
@@ -14,8 +14,10 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub/handler"
 	chaosHubOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub/ops"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/cloudevents"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/bson"
@@ -44,8 +46,8 @@ type Service interface {
 	GetPredefinedExperiment(ctx context.Context, hubID string, experiment []string, projectID string) ([]*model.PredefinedExperimentList, error)
 	IsChaosHubAvailable(ctx context.Context, name string, projectID string) (bool, error)
 	GetAllHubs(ctx context.Context) ([]*model.ChaosHub, error)
-	RecurringHubSync()
-	SyncDefaultChaosHubs()
+	RecurringHubSync(ctx context.Context)
+	SyncDefaultChaosHubs(ctx context.Context)
 	GetChaosHubStats(ctx context.Context, projectID string) (*model.GetChaosHubStatsResponse, error)
 }
 
@@ -286,20 +288,27 @@ func (c *chaosHubService) SyncChaosHub(ctx context.Context, hubID string, projec
 		IsDefault:     false,
 	}
 
-	time := time.Now().UnixMilli()
+	syncStart := time.Now()
+	time := syncStart.UnixMilli()
 	query := bson.D{{"hub_id", hubID}, {"is_removed", false}}
 	update := bson.D{{"$set", bson.D{{"last_synced_at", time}}}}
 
 	if chaosHub.HubType == string(model.HubTypeRemote) {
 		err = handler.SyncRemoteRepo(syncHubInput, projectID)
-		if err != nil {
-			return "", err
-		}
 	} else {
 		err = chaosHubOps.GitSyncHandlerForProjects(syncHubInput, projectID)
-		if err != nil {
-			return "", err
-		}
+	}
+	metrics.ObserveChaosHubSync(projectID, chaosHub.HubType, syncStart, err)
+	cloudevents.Emit(cloudevents.NewChaosHubSyncEvent(cloudevents.ChaosHubSyncData{
+		ProjectID:  projectID,
+		HubID:      hubID,
+		Name:       chaosHub.Name,
+		HubType:    chaosHub.HubType,
+		RepoURL:    chaosHub.RepoURL,
+		RepoBranch: chaosHub.RepoBranch,
+	}, err))
+	if err != nil {
+		return "", err
 	}
 	// Updating the last_synced_at time using hubID
 	err = c.chaosHubOperator.UpdateChaosHub(ctx, query, update)
@@ -879,11 +888,11 @@ func (c *chaosHubService) GetAllHubs(ctx context.Context) ([]*model.ChaosHub, er
 	return outputChaosHubs, nil
 }
 
-// RecurringHubSync is used for syncing
-func (c *chaosHubService) RecurringHubSync() {
+// RecurringHubSync is used for syncing, it returns once the context is cancelled
+func (c *chaosHubService) RecurringHubSync(ctx context.Context) {
 	for {
 		// Started Syncing of hubs
-		chaosHubs, _ := c.GetAllHubs(context.Background())
+		chaosHubs, _ := c.GetAllHubs(ctx)
 
 		for _, chaosHub := range chaosHubs {
 			if !chaosHub.IsRemoved {
@@ -900,22 +909,34 @@ func (c *chaosHubService) RecurringHubSync() {
 					SSHPrivateKey: chaosHub.SSHPrivateKey,
 					IsDefault:     false,
 				}
+				var err error
+				syncStart := time.Now()
 				if chaosHub.HubType != model.HubTypeRemote {
-					err := chaosHubOps.GitSyncHandlerForProjects(chartsInput, chaosHub.ProjectID)
-					if err != nil {
-						log.Error(err)
-					}
+					err = chaosHubOps.GitSyncHandlerForProjects(chartsInput, chaosHub.ProjectID)
 				} else {
-					err := handler.SyncRemoteRepo(chartsInput, chaosHub.ProjectID)
-					if err != nil {
-						log.Error(err)
-					}
+					err = handler.SyncRemoteRepo(chartsInput, chaosHub.ProjectID)
 				}
+				metrics.ObserveChaosHubSync(chaosHub.ProjectID, string(chaosHub.HubType), syncStart, err)
+				if err != nil {
+					log.Error(err)
+				}
+				cloudevents.Emit(cloudevents.NewChaosHubSyncEvent(cloudevents.ChaosHubSyncData{
+					ProjectID:  chaosHub.ProjectID,
+					HubID:      chaosHub.ID,
+					Name:       chaosHub.Name,
+					HubType:    string(chaosHub.HubType),
+					RepoURL:    chaosHub.RepoURL,
+					RepoBranch: chaosHub.RepoBranch,
+				}, err))
 			}
 		}
 
 		// Syncing Completed
-		time.Sleep(timeInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(timeInterval):
+		}
 	}
 }
 
@@ -971,7 +992,8 @@ func (c *chaosHubService) listDefaultHubs() *model.ChaosHub {
 	return defaultHubs
 }
 
-func (c *chaosHubService) SyncDefaultChaosHubs() {
+// SyncDefaultChaosHubs syncs the default chaos hub periodically, it returns once the context is cancelled
+func (c *chaosHubService) SyncDefaultChaosHubs(ctx context.Context) {
 	log.Infof("syncing default chaos hub directories")
 	for {
 		defaultHub := c.listDefaultHubs()
@@ -991,6 +1013,10 @@ func (c *chaosHubService) SyncDefaultChaosHubs() {
 			}).WithError(err).Error("failed to sync default chaos hubs")
 		}
 		// Syncing Completed
-		time.Sleep(DefaultHubSyncTimeInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(DefaultHubSyncTimeInterval):
+		}
 	}
 }
@@ -0,0 +1,150 @@
+package cloudevents
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+)
+
+// ExperimentRunData is the data of the experiment run events, described by schemas/experimentrun.json
+type ExperimentRunData struct {
+	ProjectID       string   `json:"projectID"`
+	ExperimentID    string   `json:"experimentID"`
+	ExperimentName  string   `json:"experimentName"`
+	ExperimentRunID string   `json:"experimentRunID,omitempty"`
+	NotifyID        string   `json:"notifyID,omitempty"`
+	InfraID         string   `json:"infraID"`
+	Phase           string   `json:"phase"`
+	RunSequence     int      `json:"runSequence"`
+	ResiliencyScore *float64 `json:"resiliencyScore,omitempty"`
+	FaultsPassed    *int     `json:"faultsPassed,omitempty"`
+	FaultsFailed    *int     `json:"faultsFailed,omitempty"`
+	TotalFaults     *int     `json:"totalFaults,omitempty"`
+}
+
+// InfraData is the data of the infra events, described by schemas/infra.json
+type InfraData struct {
+	ProjectID     string `json:"projectID"`
+	InfraID       string `json:"infraID"`
+	Name          string `json:"name"`
+	EnvironmentID string `json:"environmentID"`
+	PlatformName  string `json:"platformName"`
+	Version       string `json:"version"`
+	Description   string `json:"description"`
+}
+
+// ChaosHubSyncData is the data of the chaos hub sync events, described by schemas/chaoshub.json
+type ChaosHubSyncData struct {
+	ProjectID  string `json:"projectID"`
+	HubID      string `json:"hubID"`
+	Name       string `json:"name"`
+	HubType    string `json:"hubType"`
+	RepoURL    string `json:"repoURL"`
+	RepoBranch string `json:"repoBranch,omitempty"`
+	Succeeded  bool   `json:"succeeded"`
+	Error      string `json:"error,omitempty"`
+}
+
+// GitOpsSyncData is the data of the gitops sync events, described by schemas/gitops.json
+type GitOpsSyncData struct {
+	ProjectID     string `json:"projectID"`
+	RepositoryURL string `json:"repositoryURL"`
+	Branch        string `json:"branch"`
+	LatestCommit  string `json:"latestCommit,omitempty"`
+	Succeeded     bool   `json:"succeeded"`
+	Error         string `json:"error,omitempty"`
+}
+
+// NewExperimentRunEvent returns an experiment run event of the given type
+func NewExperimentRunEvent(eventType string, experimentRun dbChaosExperimentRun.ChaosExperimentRun) Event {
+	data := ExperimentRunData{
+		ProjectID:       experimentRun.ProjectID,
+		ExperimentID:    experimentRun.ExperimentID,
+		ExperimentName:  experimentRun.ExperimentName,
+		ExperimentRunID: experimentRun.ExperimentRunID,
+		InfraID:         experimentRun.InfraID,
+		Phase:           experimentRun.Phase,
+		RunSequence:     experimentRun.RunSequence,
+	}
+	if experimentRun.NotifyID != nil {
+		data.NotifyID = *experimentRun.NotifyID
+	}
+	if eventType != ExperimentRunStarted {
+		data.ResiliencyScore = experimentRun.ResiliencyScore
+		data.FaultsPassed = experimentRun.FaultsPassed
+		data.FaultsFailed = experimentRun.FaultsFailed
+		data.TotalFaults = experimentRun.TotalFaults
+	}
+
+	subject := data.ExperimentRunID
+	if subject == "" {
+		subject = data.NotifyID
+	}
+	return NewEvent(eventType, ExperimentSource(data.ProjectID, data.ExperimentID), subject, data)
+}
+
+// NewInfraEvent returns an infra event of the given type
+func NewInfraEvent(eventType string, description string, infra model.Infra) Event {
+	data := InfraData{
+		ProjectID:     infra.ProjectID,
+		InfraID:       infra.InfraID,
+		Name:          infra.Name,
+		EnvironmentID: infra.EnvironmentID,
+		PlatformName:  infra.PlatformName,
+		Version:       infra.Version,
+		Description:   description,
+	}
+	return NewEvent(eventType, InfraSource(data.ProjectID), data.InfraID, data)
+}
+
+// NewChaosHubSyncEvent returns the event of a sync of a chaos hub, syncErr is the error of a failed sync
+func NewChaosHubSyncEvent(data ChaosHubSyncData, syncErr error) Event {
+	data.Succeeded = syncErr == nil
+	if syncErr != nil {
+		data.Error = syncErr.Error()
+	}
+	return NewEvent(ChaosHubSynced, ChaosHubSource(data.ProjectID), data.HubID, data)
+}
+
+// NewGitOpsSyncEvent returns the event of a sync of the gitops repository of a project, syncErr is the error of a failed sync
+func NewGitOpsSyncEvent(data GitOpsSyncData, syncErr error) Event {
+	data.Succeeded = syncErr == nil
+	if syncErr != nil {
+		data.Error = syncErr.Error()
+	}
+	return NewEvent(GitOpsSynced, GitOpsSource(data.ProjectID), data.RepositoryURL, data)
+}
+
+// GetExperimentRunEventType returns the event type of a completed experiment run from its phase
+func GetExperimentRunEventType(phase string) (string, bool) {
+	switch model.ExperimentRunStatus(phase) {
+	case model.ExperimentRunStatusCompleted:
+		return ExperimentRunCompleted, true
+	case model.ExperimentRunStatusCompletedWithError, model.ExperimentRunStatusError, model.ExperimentRunStatusTerminated:
+		return ExperimentRunFailed, true
+	case model.ExperimentRunStatusTimeout:
+		return ExperimentRunTimedOut, true
+	case model.ExperimentRunStatusStopped:
+		return ExperimentRunStopped, true
+	}
+	return "", false
+}
+
+// ExperimentRunListener emits the events of the experiment runs, it is registered with the experiment and run handlers
+type ExperimentRunListener struct{}
+
+// ExperimentRunStarted emits the start of the experiment run
+func (ExperimentRunListener) ExperimentRunStarted(_ context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun) {
+	Emit(NewExperimentRunEvent(ExperimentRunStarted, experimentRun))
+}
+
+// ExperimentRunCompleted emits the completion of the experiment run, the event type is decided by its phase
+func (ExperimentRunListener) ExperimentRunCompleted(_ context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, _ *store.StateData) {
+	eventType, ok := GetExperimentRunEventType(experimentRun.Phase)
+	if !ok {
+		return
+	}
+	Emit(NewExperimentRunEvent(eventType, experimentRun))
+}
@@ -0,0 +1,40 @@
+// Package cloudevents emits the events of the control plane as CloudEvents 1.0 to the sink
+// configured with CLOUD_EVENTS_SINK_URL, no events are emitted if the sink isn't configured.
+//
+// The events are sent with the HTTP protocol binding in the mode configured with CLOUD_EVENTS_MODE:
+//
+//   - binary (default): the attributes are sent in the ce-* headers and the body is the JSON data
+//   - structured: the body is the JSON encoded event with the application/cloudevents+json content type
+//
+// Every event type is versioned and follows the io.litmuschaos.chaoscenter.<resource>.<action>.v1 scheme.
+// The source identifies where in the project the event was produced and the subject identifies the
+// resource the event is about:
+//
+//	experiment runs
+//	  types:   io.litmuschaos.chaoscenter.experimentrun.{started,completed,failed,timedout,stopped}.v1
+//	  source:  /chaoscenter/projects/{projectID}/experiments/{experimentID}
+//	  subject: experiment run ID, or the notify ID if the run hasn't been assigned an ID yet
+//	  schema:  experimentrun.json
+//
+//	chaos infrastructures
+//	  types:   io.litmuschaos.chaoscenter.infra.{registered,connected,disconnected}.v1
+//	  source:  /chaoscenter/projects/{projectID}/infras
+//	  subject: infra ID
+//	  schema:  infra.json
+//
+//	chaos hubs
+//	  types:   io.litmuschaos.chaoscenter.chaoshub.synced.v1
+//	  source:  /chaoscenter/projects/{projectID}/chaoshubs
+//	  subject: chaos hub ID
+//	  schema:  chaoshub.json
+//
+//	gitops
+//	  types:   io.litmuschaos.chaoscenter.gitops.synced.v1
+//	  source:  /chaoscenter/projects/{projectID}/gitops
+//	  subject: repository URL
+//	  schema:  gitops.json
+//
+// The JSON schemas of the data of the events are in the schemas directory and are served by the server
+// at /cloudevents/schemas/{schema}, the dataschema attribute of the events points to them when
+// CLOUD_EVENTS_SCHEMA_BASE_URL is set to the external URL of the server.
+package cloudevents
@@ -0,0 +1,139 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"github.com/sirupsen/logrus"
+)
+
+// Mode is the content mode of the HTTP protocol binding used to send the events
+type Mode string
+
+const (
+	BinaryMode     Mode = "binary"
+	StructuredMode Mode = "structured"
+
+	StructuredContentType = "application/cloudevents+json"
+
+	emitTimeout = 10 * time.Second
+)
+
+// Emitter sends the events to a sink
+type Emitter struct {
+	sinkURL       string
+	mode          Mode
+	schemaBaseURL string
+}
+
+var (
+	defaultEmitter     *Emitter
+	defaultEmitterOnce sync.Once
+)
+
+// NewEmitter returns an emitter which sends the events to the sink in the given mode
+func NewEmitter(sinkURL string, mode Mode, schemaBaseURL string) (*Emitter, error) {
+	if mode != BinaryMode && mode != StructuredMode {
+		return nil, fmt.Errorf("invalid cloudevents mode %s, supported modes are %s and %s", mode, BinaryMode, StructuredMode)
+	}
+	return &Emitter{
+		sinkURL:       sinkURL,
+		mode:          mode,
+		schemaBaseURL: schemaBaseURL,
+	}, nil
+}
+
+// getDefaultEmitter returns the emitter configured with the environment variables of the server,
+// it is nil if the sink isn't configured
+func getDefaultEmitter() *Emitter {
+	defaultEmitterOnce.Do(func() {
+		if utils.Config.CloudEventsSinkUrl == "" {
+			return
+		}
+		emitter, err := NewEmitter(utils.Config.CloudEventsSinkUrl, Mode(strings.ToLower(utils.Config.CloudEventsMode)), utils.Config.CloudEventsSchemaBaseUrl)
+		if err != nil {
+			logrus.Errorf("cloudevents are disabled, error: %v", err)
+			return
+		}
+		defaultEmitter = emitter
+	})
+	return defaultEmitter
+}
+
+// Emit sends the event to the configured sink in the background, it is a no-op if the sink isn't configured
+func Emit(event Event) {
+	emitter := getDefaultEmitter()
+	if emitter == nil {
+		return
+	}
+	go emitter.Emit(event)
+}
+
+// Emit sends the event to the sink, the failures are logged as the events are sent on a best effort basis
+func (e *Emitter) Emit(event Event) {
+	statusCode, err := e.send(event)
+	if err == nil && (statusCode < 200 || statusCode > 299) {
+		err = fmt.Errorf("sink responded with status code %d", statusCode)
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"eventId":   event.ID,
+			"eventType": event.Type,
+			"source":    event.Source,
+		}).Errorf("failed to emit cloudevent, error: %v", err)
+	}
+}
+
+// send posts the event to the sink and returns the status code of the response
+func (e *Emitter) send(event Event) (int, error) {
+	if event.DataSchema == "" {
+		event.DataSchema = GetDataSchema(e.schemaBaseURL, event.Type)
+	}
+
+	body, headers, err := Encode(event, e.mode)
+	if err != nil {
+		return 0, err
+	}
+	statusCode, _, err := utils.RestCall(http.MethodPost, e.sinkURL, body,
+		utils.WithMaxRetries(1),
+		utils.WithTimeout(emitTimeout),
+		utils.WithHeaders(headers),
+	)
+	return statusCode, err
+}
+
+// Encode returns the body and the headers of the HTTP request carrying the event in the given mode
+func Encode(event Event, mode Mode) ([]byte, map[string]string, error) {
+	if mode == StructuredMode {
+		body, err := json.Marshal(event)
+		if err != nil {
+			return nil, nil, err
+		}
+		return body, map[string]string{"Content-Type": StructuredContentType}, nil
+	}
+
+	body, err := json.Marshal(event.Data)
+	if err != nil {
+		return nil, nil, err
+	}
+	headers := map[string]string{
+		"Content-Type":   event.DataContentType,
+		"ce-specversion": event.SpecVersion,
+		"ce-id":          event.ID,
+		"ce-source":      event.Source,
+		"ce-type":        event.Type,
+		"ce-time":        event.Time,
+	}
+	if event.Subject != "" {
+		headers["ce-subject"] = event.Subject
+	}
+	if event.DataSchema != "" {
+		headers["ce-dataschema"] = event.DataSchema
+	}
+	return body, headers, nil
+}
@@ -0,0 +1,127 @@
+package cloudevents
+
+import (
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+)
+
+func TestEmitterSend(t *testing.T) {
+	notifyID := "notify-id"
+	event := NewExperimentRunEvent(ExperimentRunStarted, dbChaosExperimentRun.ChaosExperimentRun{
+		ProjectID:    "project-id",
+		ExperimentID: "experiment-id",
+		InfraID:      "infra-id",
+		Phase:        "Running",
+		NotifyID:     &notifyID,
+	})
+
+	tests := []struct {
+		name   string
+		mode   Mode
+		verify func(t *testing.T, r *http.Request, body []byte)
+	}{
+		{
+			name: "binary mode sends the attributes in the headers",
+			mode: BinaryMode,
+			verify: func(t *testing.T, r *http.Request, body []byte) {
+				if r.Header.Get("ce-type") != ExperimentRunStarted || r.Header.Get("ce-id") != event.ID {
+					t.Errorf("send() headers = %v, want the attributes of the event", r.Header)
+				}
+				if r.Header.Get("ce-subject") != notifyID || r.Header.Get("ce-source") != "/chaoscenter/projects/project-id/experiments/experiment-id" {
+					t.Errorf("send() headers = %v, want the source and subject of the event", r.Header)
+				}
+				if r.Header.Get("ce-dataschema") != "https://chaoscenter.example.com/cloudevents/schemas/experimentrun.json" {
+					t.Errorf("send() ce-dataschema = %v, want the experiment run schema", r.Header.Get("ce-dataschema"))
+				}
+				if r.Header.Get("Content-Type") != "application/json" {
+					t.Errorf("send() content type = %v, want application/json", r.Header.Get("Content-Type"))
+				}
+				var data ExperimentRunData
+				if err := json.Unmarshal(body, &data); err != nil || data.ExperimentID != "experiment-id" {
+					t.Errorf("send() body = %s, want the data of the event", body)
+				}
+			},
+		},
+		{
+			name: "structured mode sends the event in the body",
+			mode: StructuredMode,
+			verify: func(t *testing.T, r *http.Request, body []byte) {
+				if r.Header.Get("Content-Type") != StructuredContentType {
+					t.Errorf("send() content type = %v, want %v", r.Header.Get("Content-Type"), StructuredContentType)
+				}
+				if r.Header.Get("ce-type") != "" {
+					t.Errorf("send() ce-type header = %v, want no attributes in the headers", r.Header.Get("ce-type"))
+				}
+				var got map[string]interface{}
+				if err := json.Unmarshal(body, &got); err != nil {
+					t.Fatalf("send() body = %s, error: %v", body, err)
+				}
+				if got["specversion"] != SpecVersion || got["type"] != ExperimentRunStarted || got["id"] != event.ID {
+					t.Errorf("send() body = %s, want the attributes of the event", body)
+				}
+				if data, ok := got["data"].(map[string]interface{}); !ok || data["infraID"] != "infra-id" {
+					t.Errorf("send() body = %s, want the data of the event", body)
+				}
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				body, _ := io.ReadAll(r.Body)
+				tc.verify(t, r, body)
+				w.WriteHeader(http.StatusAccepted)
+			}))
+			defer server.Close()
+
+			emitter, err := NewEmitter(server.URL, tc.mode, "https://chaoscenter.example.com/")
+			if err != nil {
+				t.Fatalf("NewEmitter() error = %v", err)
+			}
+			statusCode, err := emitter.send(event)
+			if err != nil || statusCode != http.StatusAccepted {
+				t.Errorf("send() = %v, %v, want %v", statusCode, err, http.StatusAccepted)
+			}
+		})
+	}
+}
+
+func TestNewEmitter(t *testing.T) {
+	if _, err := NewEmitter("http://localhost", Mode("batched"), ""); err == nil {
+		t.Errorf("NewEmitter() error = nil, want an error for an unsupported mode")
+	}
+}
+
+func TestGetExperimentRunEventType(t *testing.T) {
+	tests := map[string]string{
+		"Completed":            ExperimentRunCompleted,
+		"Completed_With_Error": ExperimentRunFailed,
+		"Timeout":              ExperimentRunTimedOut,
+		"Stopped":              ExperimentRunStopped,
+	}
+	for phase, want := range tests {
+		if got, ok := GetExperimentRunEventType(phase); !ok || got != want {
+			t.Errorf("GetExperimentRunEventType(%s) = %v, want %v", phase, got, want)
+		}
+	}
+	if _, ok := GetExperimentRunEventType("Running"); ok {
+		t.Errorf("GetExperimentRunEventType(Running) returned an event type for a running experiment")
+	}
+}
+
+func TestSchemas(t *testing.T) {
+	for eventType, schema := range eventSchemas {
+		data, err := schemas.ReadFile("schemas/" + schema)
+		if err != nil {
+			t.Fatalf("schema %s of %s not found", schema, eventType)
+		}
+		if !json.Valid(data) {
+			t.Errorf("schema %s of %s isn't valid JSON", schema, eventType)
+		}
+	}
+}
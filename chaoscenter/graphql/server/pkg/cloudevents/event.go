@@ -0,0 +1,100 @@
+package cloudevents
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	SpecVersion = "1.0"
+
+	// Event types of the experiment runs
+	ExperimentRunStarted   = "io.litmuschaos.chaoscenter.experimentrun.started.v1"
+	ExperimentRunCompleted = "io.litmuschaos.chaoscenter.experimentrun.completed.v1"
+	ExperimentRunFailed    = "io.litmuschaos.chaoscenter.experimentrun.failed.v1"
+	ExperimentRunTimedOut  = "io.litmuschaos.chaoscenter.experimentrun.timedout.v1"
+	ExperimentRunStopped   = "io.litmuschaos.chaoscenter.experimentrun.stopped.v1"
+
+	// Event types of the chaos infrastructures
+	InfraRegistered   = "io.litmuschaos.chaoscenter.infra.registered.v1"
+	InfraConnected    = "io.litmuschaos.chaoscenter.infra.connected.v1"
+	InfraDisconnected = "io.litmuschaos.chaoscenter.infra.disconnected.v1"
+
+	// Event types of the chaos hub and gitops syncs
+	ChaosHubSynced = "io.litmuschaos.chaoscenter.chaoshub.synced.v1"
+	GitOpsSynced   = "io.litmuschaos.chaoscenter.gitops.synced.v1"
+)
+
+// eventSchemas maps the event types to the JSON schema of their data
+var eventSchemas = map[string]string{
+	ExperimentRunStarted:   "experimentrun.json",
+	ExperimentRunCompleted: "experimentrun.json",
+	ExperimentRunFailed:    "experimentrun.json",
+	ExperimentRunTimedOut:  "experimentrun.json",
+	ExperimentRunStopped:   "experimentrun.json",
+	InfraRegistered:        "infra.json",
+	InfraConnected:         "infra.json",
+	InfraDisconnected:      "infra.json",
+	ChaosHubSynced:         "chaoshub.json",
+	GitOpsSynced:           "gitops.json",
+}
+
+// Event is a CloudEvent with JSON data, the JSON encoding is the structured mode representation of the event
+type Event struct {
+	SpecVersion     string      `json:"specversion"`
+	ID              string      `json:"id"`
+	Source          string      `json:"source"`
+	Type            string      `json:"type"`
+	Subject         string      `json:"subject,omitempty"`
+	Time            string      `json:"time"`
+	DataContentType string      `json:"datacontenttype"`
+	DataSchema      string      `json:"dataschema,omitempty"`
+	Data            interface{} `json:"data"`
+}
+
+// NewEvent returns an event of the given type with a new ID and the current time
+func NewEvent(eventType, source, subject string, data interface{}) Event {
+	return Event{
+		SpecVersion:     SpecVersion,
+		ID:              uuid.NewString(),
+		Source:          source,
+		Type:            eventType,
+		Subject:         subject,
+		Time:            time.Now().UTC().Format(time.RFC3339Nano),
+		DataContentType: "application/json",
+		Data:            data,
+	}
+}
+
+// GetDataSchema returns the URL of the JSON schema of the data of the event type, it is empty
+// if the event type has no schema or the base URL isn't configured
+func GetDataSchema(baseURL, eventType string) string {
+	schema, ok := eventSchemas[eventType]
+	if !ok || baseURL == "" {
+		return ""
+	}
+	return strings.TrimSuffix(baseURL, "/") + "/cloudevents/schemas/" + schema
+}
+
+// ExperimentSource returns the source of the events of the runs of an experiment
+func ExperimentSource(projectID, experimentID string) string {
+	return fmt.Sprintf("/chaoscenter/projects/%s/experiments/%s", projectID, experimentID)
+}
+
+// InfraSource returns the source of the events of the infras of a project
+func InfraSource(projectID string) string {
+	return fmt.Sprintf("/chaoscenter/projects/%s/infras", projectID)
+}
+
+// ChaosHubSource returns the source of the events of the chaos hubs of a project
+func ChaosHubSource(projectID string) string {
+	return fmt.Sprintf("/chaoscenter/projects/%s/chaoshubs", projectID)
+}
+
+// GitOpsSource returns the source of the gitops events of a project
+func GitOpsSource(projectID string) string {
+	return fmt.Sprintf("/chaoscenter/projects/%s/gitops", projectID)
+}
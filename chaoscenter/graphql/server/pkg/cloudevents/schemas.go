@@ -0,0 +1,24 @@
+package cloudevents
+
+import (
+	"embed"
+	"net/http"
+	"path"
+
+	"github.com/gin-gonic/gin"
+)
+
+//go:embed schemas/*.json
+var schemas embed.FS
+
+// SchemaHandler serves the JSON schemas of the data of the events
+func SchemaHandler() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		schema, err := schemas.ReadFile(path.Join("schemas", path.Base(c.Param("schema"))))
+		if err != nil {
+			c.AbortWithStatus(http.StatusNotFound)
+			return
+		}
+		c.Data(http.StatusOK, "application/schema+json", schema)
+	}
+}
@@ -0,0 +1,89 @@
+package data_store
+
+import (
+	"context"
+	"time"
+
+	dbPubSub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/pubsub"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// watchRetryInterval is the delay before the change stream is opened again after it failed
+const watchRetryInterval = 5 * time.Second
+
+// MongoPubSub delivers the messages to every replica of the server through a change stream on the pub/sub
+// message collection, a message is inserted by the publishing replica and received by all the replicas
+type MongoPubSub struct {
+	pubSubOperator *dbPubSub.Operator
+}
+
+// NewMongoPubSub returns a new instance of MongoPubSub
+func NewMongoPubSub(pubSubOperator *dbPubSub.Operator) *MongoPubSub {
+	return &MongoPubSub{
+		pubSubOperator: pubSubOperator,
+	}
+}
+
+// Publish inserts the message in the pub/sub message collection
+func (p *MongoPubSub) Publish(ctx context.Context, message Message) error {
+	return p.pubSubOperator.CreateMessage(ctx, dbPubSub.PubSubMessage{
+		Topic:     string(message.Topic),
+		Key:       message.Key,
+		Payload:   message.Payload,
+		CreatedAt: time.Now(),
+	})
+}
+
+// Subscribe opens the change stream and calls the handler with the inserted messages until the context is
+// cancelled, the stream is resumed after the last received message if it is interrupted
+func (p *MongoPubSub) Subscribe(ctx context.Context, handler func(Message)) error {
+	stream, err := p.pubSubOperator.WatchMessages(ctx, nil)
+	if err != nil {
+		return err
+	}
+
+	go func() {
+		var resumeToken bson.Raw
+		for {
+			for stream.Next(ctx) {
+				resumeToken = stream.ResumeToken()
+
+				var event dbPubSub.PubSubMessageEvent
+				if err := stream.Decode(&event); err != nil {
+					logrus.WithError(err).Error("failed to decode pub/sub message")
+					continue
+				}
+				handler(Message{
+					Topic:   Topic(event.FullDocument.Topic),
+					Key:     event.FullDocument.Key,
+					Payload: event.FullDocument.Payload,
+				})
+			}
+			streamErr := stream.Err()
+			stream.Close(context.Background())
+			if ctx.Err() != nil {
+				return
+			}
+			logrus.WithError(streamErr).Error("pub/sub change stream interrupted")
+
+			for {
+				select {
+				case <-ctx.Done():
+					return
+				case <-time.After(watchRetryInterval):
+				}
+				if stream, err = p.pubSubOperator.WatchMessages(ctx, resumeToken); err == nil {
+					break
+				}
+				logrus.WithError(err).Error("failed to reopen pub/sub change stream")
+			}
+		}
+	}()
+	return nil
+}
+
+// Distributed returns true, the messages reach all the replicas sharing the database
+func (p *MongoPubSub) Distributed() bool {
+	return true
+}
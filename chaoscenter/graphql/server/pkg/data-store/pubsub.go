@@ -0,0 +1,97 @@
+package data_store
+
+import (
+	"context"
+	"sync"
+)
+
+// Topic is the kind of the messages exchanged between the replicas of the server
+type Topic string
+
+const (
+	// InfraActionTopic carries the requests sent to the subscriber of an infra, keyed by infra ID
+	InfraActionTopic Topic = "infra-action"
+	// InfraEventTopic carries the events of the infras, keyed by project ID
+	InfraEventTopic Topic = "infra-event"
+	// ExperimentRunTopic carries the state of the experiment runs, keyed by ExperimentRunEventKey
+	ExperimentRunTopic Topic = "experiment-run"
+	// PodLogTopic carries the pod logs returned by a subscriber, keyed by request ID
+	PodLogTopic Topic = "pod-log"
+	// KubeObjectTopic carries the kubernetes objects returned by a subscriber, keyed by request ID
+	KubeObjectTopic Topic = "kube-object"
+	// KubeNamespaceTopic carries the kubernetes namespaces returned by a subscriber, keyed by request ID
+	KubeNamespaceTopic Topic = "kube-namespace"
+)
+
+// Message is published by a replica of the server and delivered to every replica, the payload is the
+// JSON encoded value sent to the channels registered under the key
+type Message struct {
+	Topic   Topic
+	Key     string
+	Payload []byte
+}
+
+// PubSub delivers the published messages to the handlers subscribed on every replica of the server
+type PubSub interface {
+	// Publish sends the message to the subscribed handlers
+	Publish(ctx context.Context, message Message) error
+	// Subscribe registers the handler of the messages until the context is cancelled, it doesn't block
+	Subscribe(ctx context.Context, handler func(Message)) error
+	// Distributed returns whether the messages reach the other replicas of the server
+	Distributed() bool
+}
+
+// InMemoryPubSub delivers the messages to the handlers of the current process, it is the default
+// implementation when the server runs as a single replica
+type InMemoryPubSub struct {
+	mutex    sync.RWMutex
+	nextID   int
+	handlers map[int]func(Message)
+}
+
+// NewInMemoryPubSub returns a new instance of InMemoryPubSub
+func NewInMemoryPubSub() *InMemoryPubSub {
+	return &InMemoryPubSub{
+		handlers: make(map[int]func(Message)),
+	}
+}
+
+// Publish calls the subscribed handlers synchronously
+func (p *InMemoryPubSub) Publish(_ context.Context, message Message) error {
+	p.mutex.RLock()
+	handlers := make([]func(Message), 0, len(p.handlers))
+	for _, handler := range p.handlers {
+		handlers = append(handlers, handler)
+	}
+	p.mutex.RUnlock()
+
+	for _, handler := range handlers {
+		handler(message)
+	}
+	return nil
+}
+
+// Subscribe registers the handler, it is removed once the context is cancelled
+func (p *InMemoryPubSub) Subscribe(ctx context.Context, handler func(Message)) error {
+	p.mutex.Lock()
+	id := p.nextID
+	p.nextID++
+	p.handlers[id] = handler
+	p.mutex.Unlock()
+
+	if ctx.Done() == nil {
+		return nil
+	}
+	go func() {
+		<-ctx.Done()
+		p.mutex.Lock()
+		delete(p.handlers, id)
+		p.mutex.Unlock()
+	}()
+	return nil
+}
+
+// Distributed returns false, the messages only reach the current process
+func (p *InMemoryPubSub) Distributed() bool {
+	return false
+}
@@ -1,12 +1,21 @@
 package data_store
 
 import (
+	"context"
+	"encoding/json"
 	"sync"
+	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/sirupsen/logrus"
 )
 
-// Application state, contains channels and mutexes used for subscriptions
+// infraActionTimeout is how long an action waits for the subscriber of the infra to receive it
+const infraActionTimeout = 10 * time.Second
+
+// Application state, contains channels and mutexes used for subscriptions. The channels only hold the
+// subscriptions of the current replica, the values sent to them are published through the PubSub so that
+// a subscription is reached whichever replica of the server produces the value
 type StateData struct {
 	InfraEventPublish      map[string][]chan *model.InfraEventResponse
 	ConnectedInfra         map[string]chan *model.InfraActionResponse
@@ -15,10 +24,17 @@ type StateData struct {
 	KubeObjectData         map[string]chan *model.KubeObjectResponse
 	KubeNamespaceData      map[string]chan *model.KubeNamespaceResponse
 	Mutex                  *sync.Mutex
+	PubSub                 PubSub
+}
+
+// experimentRunEvent is the payload of the messages of ExperimentRunTopic
+type experimentRunEvent struct {
+	ExperimentRun *model.ExperimentRun `json:"experimentRun"`
+	Completed     bool                 `json:"completed"`
 }
 
 func NewStore() *StateData {
-	return &StateData{
+	store := &StateData{
 		InfraEventPublish:      make(map[string][]chan *model.InfraEventResponse),
 		ConnectedInfra:         make(map[string]chan *model.InfraActionResponse),
 		ExperimentEventPublish: make(map[string][]chan *model.ExperimentRun),
@@ -26,7 +42,271 @@ func NewStore() *StateData {
 		KubeObjectData:         make(map[string]chan *model.KubeObjectResponse),
 		KubeNamespaceData:      make(map[string]chan *model.KubeNamespaceResponse),
 		Mutex:                  &sync.Mutex{},
+		PubSub:                 NewInMemoryPubSub(),
 	}
+	_ = store.PubSub.Subscribe(context.Background(), store.deliver)
+
+	return store
 }
 
 var Store = NewStore()
+
+// UsePubSub replaces the pub/sub of the store, it should be called at startup before the store is used
+func (r *StateData) UsePubSub(ctx context.Context, pubSub PubSub) error {
+	if err := pubSub.Subscribe(ctx, r.deliver); err != nil {
+		return err
+	}
+	r.PubSub = pubSub
+	return nil
+}
+
+// ExperimentRunEventKey returns the key under which subscribers of an experiment run are registered
+// in ExperimentEventPublish, the identifier can either be the experiment run ID or the notify ID
+func ExperimentRunEventKey(projectID string, identifier string) string {
+	return projectID + "/" + identifier
+}
+
+// IsInfraConnected returns whether the subscriber of the infra is connected to the current replica
+func (r *StateData) IsInfraConnected(infraID string) bool {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	_, ok := r.ConnectedInfra[infraID]
+	return ok
+}
+
+// SendInfraAction sends the action to the subscriber of the infra, whichever replica it is connected to
+func (r *StateData) SendInfraAction(ctx context.Context, infraID string, action *model.InfraActionResponse) error {
+	return r.publish(ctx, InfraActionTopic, infraID, action)
+}
+
+// PublishInfraEvent sends the event to the clients listening for the infra events of the project
+func (r *StateData) PublishInfraEvent(ctx context.Context, projectID string, event *model.InfraEventResponse) error {
+	return r.publish(ctx, InfraEventTopic, projectID, event)
+}
+
+// PublishExperimentRun sends the state of the run to the clients subscribed under the key, the
+// subscriptions are closed once the run is completed
+func (r *StateData) PublishExperimentRun(ctx context.Context, key string, experimentRun *model.ExperimentRun, completed bool) error {
+	return r.publish(ctx, ExperimentRunTopic, key, experimentRunEvent{
+		ExperimentRun: experimentRun,
+		Completed:     completed,
+	})
+}
+
+// PublishPodLog sends the pod log to the client of the request
+func (r *StateData) PublishPodLog(ctx context.Context, requestID string, podLog *model.PodLogResponse) error {
+	return r.publish(ctx, PodLogTopic, requestID, podLog)
+}
+
+// PublishKubeObject sends the kubernetes objects to the client of the request
+func (r *StateData) PublishKubeObject(ctx context.Context, requestID string, kubeObject *model.KubeObjectResponse) error {
+	return r.publish(ctx, KubeObjectTopic, requestID, kubeObject)
+}
+
+// PublishKubeNamespace sends the kubernetes namespaces to the client of the request
+func (r *StateData) PublishKubeNamespace(ctx context.Context, requestID string, kubeNamespace *model.KubeNamespaceResponse) error {
+	return r.publish(ctx, KubeNamespaceTopic, requestID, kubeNamespace)
+}
+
+func (r *StateData) publish(ctx context.Context, topic Topic, key string, value interface{}) error {
+	payload, err := json.Marshal(value)
+	if err != nil {
+		return err
+	}
+
+	return r.PubSub.Publish(ctx, Message{
+		Topic:   topic,
+		Key:     key,
+		Payload: payload,
+	})
+}
+
+// deliver sends the value of the message to the channels of the current replica registered under its key
+func (r *StateData) deliver(message Message) {
+	var err error
+	switch message.Topic {
+	case InfraActionTopic:
+		var action model.InfraActionResponse
+		if err = json.Unmarshal(message.Payload, &action); err != nil {
+			break
+		}
+		// the observers are sent to without the lock, a subscriber which stops reading must not block the
+		// other subscriptions of the replica
+		r.Mutex.Lock()
+		observer, ok := r.ConnectedInfra[message.Key]
+		r.Mutex.Unlock()
+		if ok {
+			sendInfraAction(observer, &action, message.Key)
+		}
+	case InfraEventTopic:
+		var event model.InfraEventResponse
+		if err = json.Unmarshal(message.Payload, &event); err != nil {
+			break
+		}
+		r.Mutex.Lock()
+		observers := append([]chan *model.InfraEventResponse{}, r.InfraEventPublish[message.Key]...)
+		r.Mutex.Unlock()
+		for _, observer := range observers {
+			// infra events are notifications, an event is dropped for a client which doesn't keep up
+			select {
+			case observer <- &event:
+			default:
+			}
+		}
+	case ExperimentRunTopic:
+		var event experimentRunEvent
+		if err = json.Unmarshal(message.Payload, &event); err != nil {
+			break
+		}
+		r.Mutex.Lock()
+		for _, observer := range r.ExperimentEventPublish[message.Key] {
+			SendExperimentRunEvent(observer, event.ExperimentRun)
+			if event.Completed {
+				close(observer)
+			}
+		}
+		if event.Completed {
+			delete(r.ExperimentEventPublish, message.Key)
+		}
+		r.Mutex.Unlock()
+	case PodLogTopic:
+		var podLog model.PodLogResponse
+		if err = json.Unmarshal(message.Payload, &podLog); err != nil {
+			break
+		}
+		// the response channels are answered once, they are removed and answered without the lock as the
+		// client may not read the response anymore
+		r.Mutex.Lock()
+		reqChan, ok := r.ExperimentLog[message.Key]
+		delete(r.ExperimentLog, message.Key)
+		r.Mutex.Unlock()
+		if ok {
+			go respond(reqChan, &podLog)
+		}
+	case KubeObjectTopic:
+		var kubeObject model.KubeObjectResponse
+		if err = json.Unmarshal(message.Payload, &kubeObject); err != nil {
+			break
+		}
+		r.Mutex.Lock()
+		reqChan, ok := r.KubeObjectData[message.Key]
+		delete(r.KubeObjectData, message.Key)
+		r.Mutex.Unlock()
+		if ok {
+			go respond(reqChan, &kubeObject)
+		}
+	case KubeNamespaceTopic:
+		var kubeNamespace model.KubeNamespaceResponse
+		if err = json.Unmarshal(message.Payload, &kubeNamespace); err != nil {
+			break
+		}
+		r.Mutex.Lock()
+		reqChan, ok := r.KubeNamespaceData[message.Key]
+		delete(r.KubeNamespaceData, message.Key)
+		r.Mutex.Unlock()
+		if ok {
+			go respond(reqChan, &kubeNamespace)
+		}
+	}
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"topic": message.Topic,
+			"key":   message.Key,
+		}).WithError(err).Error("failed to decode pub/sub message")
+	}
+}
+
+// SendExperimentRunSnapshot sends the current state of the run to an observer registered under the key, the
+// observer is closed and unregistered if the run is completed. An event published since the state was read is
+// newer, it isn't replaced, and nothing is sent if the publisher has already closed the observer
+func (r *StateData) SendExperimentRunSnapshot(key string, observer chan *model.ExperimentRun, expRun *model.ExperimentRun, completed bool) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	if !r.isExperimentRunObserver(key, observer) {
+		return
+	}
+
+	select {
+	case observer <- expRun:
+	default:
+	}
+	if completed {
+		r.removeExperimentRunObserver(key, observer)
+		close(observer)
+	}
+}
+
+// RemoveExperimentRunObserver unregisters the observer of the runs of the key, it doesn't close the observer
+func (r *StateData) RemoveExperimentRunObserver(key string, observer chan *model.ExperimentRun) {
+	r.Mutex.Lock()
+	defer r.Mutex.Unlock()
+	r.removeExperimentRunObserver(key, observer)
+}
+
+func (r *StateData) isExperimentRunObserver(key string, observer chan *model.ExperimentRun) bool {
+	for _, o := range r.ExperimentEventPublish[key] {
+		if o == observer {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *StateData) removeExperimentRunObserver(key string, observer chan *model.ExperimentRun) {
+	observers := r.ExperimentEventPublish[key]
+	for i, o := range observers {
+		if o == observer {
+			observers = append(observers[:i], observers[i+1:]...)
+			break
+		}
+	}
+	if len(observers) == 0 {
+		delete(r.ExperimentEventPublish, key)
+	} else {
+		r.ExperimentEventPublish[key] = observers
+	}
+}
+
+// sendInfraAction sends the action to the subscriber of the infra, the action is dropped if the
+// subscriber doesn't read it within infraActionTimeout
+func sendInfraAction(observer chan *model.InfraActionResponse, action *model.InfraActionResponse, infraID string) {
+	// the channel of an infra is closed when a second subscriber of the infra forces its disconnect
+	defer func() {
+		if recover() != nil {
+			logrus.WithField("infraID", infraID).Error("infra disconnected before receiving the action, the action is dropped")
+		}
+	}()
+	timer := time.NewTimer(infraActionTimeout)
+	defer timer.Stop()
+	select {
+	case observer <- action:
+	case <-timer.C:
+		logrus.WithField("infraID", infraID).Error("infra didn't receive the action in time, the action is dropped")
+	}
+}
+
+// respond sends the response of a request to its channel and closes it
+func respond[T any](reqChan chan *T, response *T) {
+	reqChan <- response
+	close(reqChan)
+}
+
+// SendExperimentRunEvent pushes the experiment run to the observer without blocking. Every event carries
+// the complete state of the run, so a stale event which is not yet consumed by a slow client is replaced
+func SendExperimentRunEvent(observer chan *model.ExperimentRun, expRun *model.ExperimentRun) {
+	select {
+	case observer <- expRun:
+		return
+	default:
+	}
+
+	select {
+	case <-observer:
+	default:
+	}
+
+	select {
+	case observer <- expRun:
+	default:
+	}
+}
@@ -0,0 +1,187 @@
+package data_store
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+func TestSendExperimentRunEvent(t *testing.T) {
+	observer := make(chan *model.ExperimentRun, 1)
+	SendExperimentRunEvent(observer, &model.ExperimentRun{Phase: model.ExperimentRunStatusRunning})
+	SendExperimentRunEvent(observer, &model.ExperimentRun{Phase: model.ExperimentRunStatusCompleted})
+
+	if got := <-observer; got.Phase != model.ExperimentRunStatusCompleted {
+		t.Errorf("SendExperimentRunEvent() phase = %v, want %v", got.Phase, model.ExperimentRunStatusCompleted)
+	}
+}
+
+func TestStateData_SendInfraAction(t *testing.T) {
+	r := NewStore()
+	connected := make(chan *model.InfraActionResponse, 1)
+	r.ConnectedInfra["connected-infra"] = connected
+
+	requestType := "logs"
+	if err := r.SendInfraAction(context.Background(), "connected-infra", &model.InfraActionResponse{
+		ProjectID: "project",
+		Action:    &model.ActionPayload{RequestType: requestType},
+	}); err != nil {
+		t.Fatalf("SendInfraAction() error = %v", err)
+	}
+	if err := r.SendInfraAction(context.Background(), "other-infra", &model.InfraActionResponse{}); err != nil {
+		t.Fatalf("SendInfraAction() error = %v", err)
+	}
+
+	action := <-connected
+	if action.ProjectID != "project" || action.Action.RequestType != requestType {
+		t.Errorf("SendInfraAction() delivered %+v", action)
+	}
+	if len(connected) != 0 {
+		t.Errorf("SendInfraAction() delivered the action of another infra")
+	}
+}
+
+func TestStateData_PublishInfraEvent(t *testing.T) {
+	r := NewStore()
+	// the client of the first subscription doesn't read its events anymore
+	stalled := make(chan *model.InfraEventResponse, 1)
+	stalled <- &model.InfraEventResponse{}
+	active := make(chan *model.InfraEventResponse, 1)
+	r.InfraEventPublish["project"] = []chan *model.InfraEventResponse{stalled, active}
+
+	published := make(chan error, 1)
+	go func() {
+		published <- r.PublishInfraEvent(context.Background(), "project", &model.InfraEventResponse{EventName: "infra-status"})
+	}()
+	select {
+	case err := <-published:
+		if err != nil {
+			t.Fatalf("PublishInfraEvent() error = %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatalf("PublishInfraEvent() is blocked by a subscriber which doesn't read its events")
+	}
+
+	if event := <-active; event.EventName != "infra-status" {
+		t.Errorf("PublishInfraEvent() delivered %+v", event)
+	}
+}
+
+func TestStateData_PublishPodLog(t *testing.T) {
+	r := NewStore()
+	podLog := make(chan *model.PodLogResponse, 1)
+	r.ExperimentLog["request"] = podLog
+
+	if err := r.PublishPodLog(context.Background(), "request", &model.PodLogResponse{Log: "log"}); err != nil {
+		t.Fatalf("PublishPodLog() error = %v", err)
+	}
+	// the request is answered once, a late response is dropped
+	if err := r.PublishPodLog(context.Background(), "request", &model.PodLogResponse{Log: "late log"}); err != nil {
+		t.Fatalf("PublishPodLog() error = %v", err)
+	}
+
+	if got, ok := <-podLog; !ok || got.Log != "log" {
+		t.Errorf("PublishPodLog() delivered %+v", got)
+	}
+	if _, ok := <-podLog; ok {
+		t.Errorf("PublishPodLog() didn't close the channel of the request")
+	}
+	if _, ok := r.ExperimentLog["request"]; ok {
+		t.Errorf("PublishPodLog() didn't remove the request")
+	}
+}
+
+func TestStateData_PublishExperimentRun(t *testing.T) {
+	tests := []struct {
+		name       string
+		completed  bool
+		wantClosed bool
+	}{
+		{name: "run update is published to subscribers"},
+		{name: "subscriptions are closed once the run is completed", completed: true, wantClosed: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			r := NewStore()
+			key := ExperimentRunEventKey("project", "run")
+			observer := make(chan *model.ExperimentRun, 1)
+			r.ExperimentEventPublish[key] = []chan *model.ExperimentRun{observer}
+
+			err := r.PublishExperimentRun(context.Background(), key, &model.ExperimentRun{ExperimentRunID: "run"}, tc.completed)
+			if err != nil {
+				t.Fatalf("PublishExperimentRun() error = %v", err)
+			}
+
+			if got, ok := <-observer; !ok || got.ExperimentRunID != "run" {
+				t.Errorf("PublishExperimentRun() did not publish the experiment run")
+			}
+			if _, ok := r.ExperimentEventPublish[key]; ok == tc.wantClosed {
+				t.Errorf("PublishExperimentRun() unexpected subscriber registration state")
+			}
+		})
+	}
+}
+
+func TestStateData_SendExperimentRunSnapshot(t *testing.T) {
+	r := NewStore()
+	key := ExperimentRunEventKey("project", "run")
+	observer := make(chan *model.ExperimentRun, 1)
+	r.ExperimentEventPublish[key] = []chan *model.ExperimentRun{observer}
+
+	// the run completed before its current state was read, the publisher has already closed the observer
+	if err := r.PublishExperimentRun(context.Background(), key, &model.ExperimentRun{Phase: model.ExperimentRunStatusCompleted}, true); err != nil {
+		t.Fatalf("PublishExperimentRun() error = %v", err)
+	}
+	r.SendExperimentRunSnapshot(key, observer, &model.ExperimentRun{Phase: model.ExperimentRunStatusCompleted}, true)
+	if got, ok := <-observer; !ok || got.Phase != model.ExperimentRunStatusCompleted {
+		t.Errorf("SendExperimentRunSnapshot() observer did not receive the completed run")
+	}
+	if _, ok := <-observer; ok {
+		t.Errorf("SendExperimentRunSnapshot() observer is not closed")
+	}
+
+	// the snapshot of a completed run closes the observer
+	observer = make(chan *model.ExperimentRun, 1)
+	r.ExperimentEventPublish[key] = []chan *model.ExperimentRun{observer}
+	r.SendExperimentRunSnapshot(key, observer, &model.ExperimentRun{Phase: model.ExperimentRunStatusStopped}, true)
+	if got, ok := <-observer; !ok || got.Phase != model.ExperimentRunStatusStopped {
+		t.Errorf("SendExperimentRunSnapshot() observer did not receive the snapshot")
+	}
+	if _, ok := <-observer; ok {
+		t.Errorf("SendExperimentRunSnapshot() observer is not closed")
+	}
+	if _, ok := r.ExperimentEventPublish[key]; ok {
+		t.Errorf("SendExperimentRunSnapshot() observer is still registered")
+	}
+}
+
+func TestInMemoryPubSub_Subscribe(t *testing.T) {
+	pubSub := NewInMemoryPubSub()
+	ctx, cancel := context.WithCancel(context.Background())
+
+	received := make(chan Message, 2)
+	if err := pubSub.Subscribe(ctx, func(message Message) { received <- message }); err != nil {
+		t.Fatalf("Subscribe() error = %v", err)
+	}
+	_ = pubSub.Publish(context.Background(), Message{Topic: PodLogTopic, Key: "request"})
+	if message := <-received; message.Topic != PodLogTopic || message.Key != "request" {
+		t.Errorf("Publish() delivered %+v", message)
+	}
+
+	cancel()
+	for {
+		pubSub.mutex.RLock()
+		remaining := len(pubSub.handlers)
+		pubSub.mutex.RUnlock()
+		if remaining == 0 {
+			break
+		}
+		time.Sleep(time.Millisecond)
+	}
+	_ = pubSub.Publish(context.Background(), Message{Topic: PodLogTopic, Key: "request"})
+	if len(received) != 0 {
+		t.Errorf("Publish() delivered a message after the subscription was cancelled")
+	}
+}
@@ -0,0 +1,54 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for audit event collection, the collection is append-only so
+// the operator doesn't provide any way to update or delete the events
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewAuditOperator returns a new instance of Operator
+func NewAuditOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertAuditEvent takes details of an audit event and inserts into the database collection
+func (c *Operator) InsertAuditEvent(ctx context.Context, event AuditEvent) error {
+	return c.operator.Create(ctx, mongodb.AuditEventCollection, event)
+}
+
+// ListAuditEvents returns a page of the audit events matching the query, the latest event first,
+// along with the total number of events matching the query
+func (c *Operator) ListAuditEvents(ctx context.Context, query bson.D, skip int64, limit int64) ([]AuditEvent, int64, error) {
+	total, err := c.operator.CountDocuments(ctx, mongodb.AuditEventCollection, query)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	var events []AuditEvent
+	results, err := c.operator.List(ctx, mongodb.AuditEventCollection, query,
+		options.Find().SetSort(bson.D{{"created_at", -1}}).SetSkip(skip).SetLimit(limit))
+	if err != nil {
+		return nil, 0, err
+	}
+	if err = results.All(ctx, &events); err != nil {
+		return nil, 0, err
+	}
+
+	return events, total, nil
+}
+
+// GetAuditEventsCursor returns a cursor over all the audit events matching the query, the oldest event first
+func (c *Operator) GetAuditEventsCursor(ctx context.Context, query bson.D) (*mongo.Cursor, error) {
+	return c.operator.List(ctx, mongodb.AuditEventCollection, query, options.Find().SetSort(bson.D{{"created_at", 1}}))
+}
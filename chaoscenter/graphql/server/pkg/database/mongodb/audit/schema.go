@@ -0,0 +1,24 @@
+package audit
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// AuditEvent contains the required fields to be stored in the database for an entry of the audit log,
+// the entries are written by both the GraphQL and the authentication server
+type AuditEvent struct {
+	AuditID     string                 `bson:"audit_id"`
+	ProjectID   string                 `bson:"project_id,omitempty"`
+	Source      model.AuditEventSource `bson:"source"`
+	Actor       string                 `bson:"actor"`
+	ActorID     string                 `bson:"actor_id,omitempty"`
+	Action      string                 `bson:"action"`
+	ResourceIDs []string               `bson:"resource_ids"`
+	Before      map[string]interface{} `bson:"before,omitempty"`
+	After       map[string]interface{} `bson:"after,omitempty"`
+	SourceIP    string                 `bson:"source_ip"`
+	IsAPIToken  bool                   `bson:"is_api_token"`
+	Succeeded   bool                   `bson:"succeeded"`
+	Error       string                 `bson:"error,omitempty"`
+	CreatedAt   int64                  `bson:"created_at"`
+}
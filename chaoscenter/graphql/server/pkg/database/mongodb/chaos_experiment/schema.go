@@ -5,6 +5,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"go.mongodb.org/mongo-driver/bson/primitive"
 )
 
 type ChaosExperimentType string
@@ -40,6 +41,8 @@ type ChaosExperimentRequest struct {
 	IsCustomExperiment         bool                  `bson:"is_custom_experiment"`
 	RecentExperimentRunDetails []ExperimentRunDetail `bson:"recent_experiment_run_details"` // stores the details of last 10 experiment runs
 	TotalExperimentRuns        int                   `bson:"total_experiment_runs"`
+	BlackoutSuspended          bool                  `bson:"blackout_suspended,omitempty"` // cron experiment is suspended on the infra due to a blackout window
+	ResiliencyScoreStrategy    string                `bson:"resiliency_score_strategy,omitempty"`
 }
 
 // Probes details containing fault name and the probe name which it was mapped to
@@ -60,6 +63,7 @@ type ProbesMatched struct {
 
 // ChaosExperimentsWithRunDetails contains the required fields to be stored in the database for a chaos experiment input
 type ChaosExperimentsWithRunDetails struct {
+	ID                         primitive.ObjectID `bson:"_id,omitempty"`
 	mongodb.ResourceDetails    `bson:",inline"`
 	mongodb.Audit              `bson:",inline"`
 	ProjectID                  string                                    `bson:"project_id"`
@@ -74,6 +78,7 @@ type ChaosExperimentsWithRunDetails struct {
 	AvgResScore                float64                                   `bson:"avg_resiliency_score"`
 	IsCustomExperiment         bool                                      `bson:"is_custom_experiment"`
 	IsRemoved                  bool                                      `bson:"is_removed"`
+	ResiliencyScoreStrategy    string                                    `bson:"resiliency_score_strategy,omitempty"`
 }
 
 // AvgResScore contains average resiliency score
@@ -89,11 +94,12 @@ type GetExperimentDetails struct {
 }
 
 type ExperimentRevision struct {
-	RevisionID         string             `bson:"revision_id"`
-	ExperimentManifest string             `bson:"experiment_manifest"`
-	UpdatedAt          int64              `bson:"updated_at"`
-	Weightages         []*WeightagesInput `bson:"weightages"`
-	Probes             []Probes           `bson:"probes"`
+	RevisionID         string                     `bson:"revision_id"`
+	ExperimentManifest string                     `bson:"experiment_manifest"`
+	UpdatedAt          int64                      `bson:"updated_at"`
+	UpdatedBy          mongodb.UserDetailResponse `bson:"updated_by"`
+	Weightages         []*WeightagesInput         `bson:"weightages"`
+	Probes             []Probes                   `bson:"probes"`
 }
 
 // WeightagesInput contains the required fields to be stored in the database for a weightages input
@@ -118,12 +124,12 @@ type TotalFilteredData struct {
 }
 
 type FlattenedExperimentRun struct {
+	ID                     primitive.ObjectID `bson:"_id,omitempty"`
 	mongodb.Audit          `bson:",inline"`
 	ProjectID              string                            `bson:"project_id"`
 	ExperimentID           string                            `bson:"experiment_id"`
 	ExperimentRunID        string                            `bson:"experiment_run_id"`
 	CronSyntax             string                            `bson:"cron_syntax"`
-	ExecutionData          string                            `bson:"execution_data"`
 	RevisionID             string                            `bson:"revision_id"`
 	InfraID                string                            `bson:"infra_id"`
 	Phase                  string                            `bson:"phase"`
@@ -141,6 +147,11 @@ type FlattenedExperimentRun struct {
 	Completed              bool                              `bson:"completed"`
 	IsRemoved              bool                              `bson:"is_removed"`
 	RunSequence            int64                             `bson:"run_sequence"`
+	Inputs                 []chaos_experiment_run.InputValue `bson:"inputs,omitempty"`
+	Priority               int                               `bson:"priority,omitempty"`
+	ScoreStrategy          string                            `bson:"resiliency_score_strategy,omitempty"`
+	PrunedAt               int64                             `bson:"pruned_at,omitempty"`
+	ArchiveLocation        string                            `bson:"archive_location,omitempty"`
 }
 
 type ExperimentDetails struct {
@@ -0,0 +1,96 @@
+package chaos_experiment_run
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"io"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// The execution data of the runs is stored gzip compressed in the compressed_execution_data field, the
+// execution_data field is only set for the runs which were stored before the compression was introduced
+const (
+	executionDataField           = "execution_data"
+	compressedExecutionDataField = "compressed_execution_data"
+)
+
+// CompressExecutionData returns the gzip compressed execution data, it is empty if there is no execution data
+func CompressExecutionData(executionData string) ([]byte, error) {
+	if executionData == "" {
+		return nil, nil
+	}
+
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(executionData)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// DecodeExecutionData returns the execution data of a run from its compressed execution data,
+// or from its uncompressed execution data if the run was stored before the compression was introduced
+func DecodeExecutionData(executionData string, compressedExecutionData []byte) (string, error) {
+	if len(compressedExecutionData) == 0 {
+		return executionData, nil
+	}
+
+	reader, err := gzip.NewReader(bytes.NewReader(compressedExecutionData))
+	if err != nil {
+		return "", err
+	}
+	defer reader.Close()
+
+	data, err := io.ReadAll(reader)
+	if err != nil {
+		return "", err
+	}
+	return string(data), nil
+}
+
+// GetExecutionData returns the uncompressed execution data of the run
+func (c ChaosExperimentRun) GetExecutionData() (string, error) {
+	return DecodeExecutionData(c.ExecutionData, c.CompressedExecutionData)
+}
+
+// ExcludeExecutionDataStage is the aggregation stage which removes the execution data of the runs, the list
+// queries use it so that the execution data is only loaded when a single run is requested
+func ExcludeExecutionDataStage() bson.D {
+	return bson.D{
+		{"$project", bson.D{
+			{executionDataField, 0},
+			{compressedExecutionDataField, 0},
+		}},
+	}
+}
+
+// GetExperimentRunExecutionData returns the uncompressed execution data of the run matching the query, only
+// the execution data of the run is fetched
+func (c *Operator) GetExperimentRunExecutionData(ctx context.Context, query bson.D) (string, error) {
+	opts := options.Find().SetLimit(1).SetProjection(bson.D{
+		{executionDataField, 1},
+		{compressedExecutionDataField, 1},
+	})
+	results, err := c.operator.List(ctx, mongodb.ChaosExperimentRunsCollection, query, opts)
+	if err != nil {
+		return "", err
+	}
+
+	var runs []ChaosExperimentRun
+	if err = results.All(ctx, &runs); err != nil {
+		return "", err
+	}
+	if len(runs) == 0 {
+		return "", mongo.ErrNoDocuments
+	}
+
+	return runs[0].GetExecutionData()
+}
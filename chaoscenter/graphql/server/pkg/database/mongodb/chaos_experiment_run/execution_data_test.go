@@ -0,0 +1,52 @@
+package chaos_experiment_run
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestCompressExecutionData(t *testing.T) {
+	executionData := `{"name":"podtato-head","phase":"Completed","nodes":{` + strings.Repeat(`"node":{"phase":"Succeeded"},`, 100) + `}}`
+
+	compressed, err := CompressExecutionData(executionData)
+	if err != nil {
+		t.Fatalf("CompressExecutionData() error = %v", err)
+	}
+	if len(compressed) >= len(executionData) {
+		t.Errorf("expected the execution data to be compressed, got %d bytes for %d bytes", len(compressed), len(executionData))
+	}
+
+	run := ChaosExperimentRun{CompressedExecutionData: compressed}
+	got, err := run.GetExecutionData()
+	if err != nil {
+		t.Fatalf("GetExecutionData() error = %v", err)
+	}
+	if got != executionData {
+		t.Errorf("GetExecutionData() = %s, want %s", got, executionData)
+	}
+}
+
+func TestGetExecutionDataOfUncompressedRun(t *testing.T) {
+	run := ChaosExperimentRun{ExecutionData: `{"phase":"Running"}`}
+	got, err := run.GetExecutionData()
+	if err != nil {
+		t.Fatalf("GetExecutionData() error = %v", err)
+	}
+	if got != `{"phase":"Running"}` {
+		t.Errorf("GetExecutionData() = %s", got)
+	}
+
+	if compressed, err := CompressExecutionData(""); err != nil || compressed != nil {
+		t.Errorf("CompressExecutionData() = %v, %v, want no data", compressed, err)
+	}
+}
+
+func TestCompressExperimentRun(t *testing.T) {
+	run, err := compressExperimentRun(ChaosExperimentRun{ExperimentRunID: "run", ExecutionData: `{"phase":"Running"}`})
+	if err != nil {
+		t.Fatalf("compressExperimentRun() error = %v", err)
+	}
+	if run.ExecutionData != "" || len(run.CompressedExecutionData) == 0 {
+		t.Errorf("expected only the compressed execution data to be stored")
+	}
+}
@@ -25,7 +25,11 @@ func NewChaosExperimentRunOperator(mongodbOperator mongodb.MongoOperator) *Opera
 }
 
 func (c *Operator) CreateExperimentRun(ctx context.Context, wfRun ChaosExperimentRun) error {
-	err := c.operator.Create(ctx, mongodb.ChaosExperimentRunsCollection, wfRun)
+	wfRun, err := compressExperimentRun(wfRun)
+	if err != nil {
+		return err
+	}
+	err = c.operator.Create(ctx, mongodb.ChaosExperimentRunsCollection, wfRun)
 	if err != nil {
 		return err
 	}
@@ -76,6 +80,11 @@ func (c *Operator) GetExperimentRun(query bson.D) (ChaosExperimentRun, error) {
 
 // UpdateExperimentRun takes experimentID and wfRun parameters to update the experiment run details in the database
 func (c *Operator) UpdateExperimentRun(ctx context.Context, wfRun ChaosExperimentRun) (int, error) {
+	wfRun, err := compressExperimentRun(wfRun)
+	if err != nil {
+		return 0, err
+	}
+
 	query := bson.D{
 		{"experiment_id", wfRun.ExperimentID},
 		{"experiment_run_id", wfRun.ExperimentRunID},
@@ -127,12 +136,17 @@ func (c *Operator) UpdateExperimentRun(ctx context.Context, wfRun ChaosExperimen
 				{"faults_stopped", wfRun.FaultsStopped},
 				{"faults_na", wfRun.FaultsNA},
 				{"total_faults", wfRun.TotalFaults},
-				{"execution_data", wfRun.ExecutionData},
+				{"resiliency_score_strategy", wfRun.ScoreStrategy},
+				{"compressed_execution_data", wfRun.CompressedExecutionData},
 				{"completed", wfRun.Completed},
 				{"updated_by", wfRun.UpdatedBy},
 				{"updated_at", wfRun.UpdatedAt},
 				{"is_removed", wfRun.IsRemoved},
-			}}}
+			}},
+			{"$unset", bson.D{
+				{"execution_data", ""},
+			}},
+		}
 
 		result, err := c.operator.Update(ctx, mongodb.ChaosExperimentRunsCollection, updateQuery, update)
 		if err != nil {
@@ -163,6 +177,35 @@ func (c *Operator) UpdateExperimentRunsWithQuery(ctx context.Context, query bson
 	return nil
 }
 
+// ClaimExperimentRun updates the experiment run matched by the query, it returns false if no run was matched,
+// e.g. the run has been claimed by another replica in the meantime
+func (c *Operator) ClaimExperimentRun(ctx context.Context, query bson.D, update bson.D) (bool, error) {
+	result, err := c.operator.Update(ctx, mongodb.ChaosExperimentRunsCollection, query, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
+// CloseExperimentRun completes the experiment run matched by the query with the phase, it returns false if
+// no run was matched, e.g. the run has been completed in the meantime
+func (c *Operator) CloseExperimentRun(ctx context.Context, query bson.D, phase string, updatedBy mongodb.UserDetailResponse) (bool, error) {
+	update := bson.D{
+		{"$set", bson.D{
+			{"phase", phase},
+			{"completed", true},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", updatedBy},
+		}},
+	}
+
+	result, err := c.operator.Update(ctx, mongodb.ChaosExperimentRunsCollection, query, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
+
 // GetExperimentRunsByInfraID takes a infraID parameter to retrieve the experiment details from the database
 func (c *Operator) GetExperimentRunsByInfraID(infraID string) ([]ChaosExperimentRun, error) {
 	ctx, cancel := context.WithTimeout(backgroundContext, 10*time.Second)
@@ -182,6 +225,21 @@ func (c *Operator) GetExperimentRunsByInfraID(infraID string) ([]ChaosExperiment
 	return experiments, nil
 }
 
+// compressExperimentRun returns the run with its execution data compressed
+func compressExperimentRun(wfRun ChaosExperimentRun) (ChaosExperimentRun, error) {
+	if wfRun.ExecutionData == "" {
+		return wfRun, nil
+	}
+
+	compressedExecutionData, err := CompressExecutionData(wfRun.ExecutionData)
+	if err != nil {
+		return ChaosExperimentRun{}, err
+	}
+	wfRun.CompressedExecutionData = compressedExecutionData
+	wfRun.ExecutionData = ""
+	return wfRun, nil
+}
+
 // GetAggregateExperimentRuns takes a mongo pipeline to retrieve the experiment details from the database
 func (c *Operator) GetAggregateExperimentRuns(pipeline mongo.Pipeline) (*mongo.Cursor, error) {
 	ctx, cancel := context.WithTimeout(backgroundContext, 10*time.Second)
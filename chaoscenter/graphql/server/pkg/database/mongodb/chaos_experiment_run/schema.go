@@ -6,24 +6,41 @@ import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mo
 type ChaosExperimentRun struct {
 	ProjectID       string `bson:"project_id"`
 	mongodb.Audit   `bson:",inline"`
-	InfraID         string   `bson:"infra_id"`
-	ExperimentRunID string   `bson:"experiment_run_id"`
-	ExperimentID    string   `bson:"experiment_id"`
-	ExperimentName  string   `bson:"experiment_name"`
-	Phase           string   `bson:"phase"`
-	Probes          []Probes `bson:"probes"`
-	ExecutionData   string   `bson:"execution_data"`
-	RevisionID      string   `bson:"revision_id"`
-	NotifyID        *string  `bson:"notify_id"`
-	ResiliencyScore *float64 `bson:"resiliency_score,omitempty"`
-	FaultsPassed    *int     `bson:"faults_passed,omitempty"`
-	FaultsFailed    *int     `bson:"faults_failed,omitempty"`
-	FaultsAwaited   *int     `bson:"faults_awaited,omitempty"`
-	FaultsStopped   *int     `bson:"faults_stopped,omitempty"`
-	FaultsNA        *int     `bson:"faults_na,omitempty"`
-	TotalFaults     *int     `bson:"total_faults,omitempty"`
-	RunSequence     int      `bson:"run_sequence"`
-	Completed       bool     `bson:"completed"`
+	InfraID         string       `bson:"infra_id"`
+	ExperimentRunID string       `bson:"experiment_run_id"`
+	ExperimentID    string       `bson:"experiment_id"`
+	ExperimentName  string       `bson:"experiment_name"`
+	Phase           string       `bson:"phase"`
+	Probes          []Probes     `bson:"probes"`
+	ExecutionData   string       `bson:"execution_data,omitempty"`
+	RevisionID      string       `bson:"revision_id"`
+	NotifyID        *string      `bson:"notify_id"`
+	ResiliencyScore *float64     `bson:"resiliency_score,omitempty"`
+	FaultsPassed    *int         `bson:"faults_passed,omitempty"`
+	FaultsFailed    *int         `bson:"faults_failed,omitempty"`
+	FaultsAwaited   *int         `bson:"faults_awaited,omitempty"`
+	FaultsStopped   *int         `bson:"faults_stopped,omitempty"`
+	FaultsNA        *int         `bson:"faults_na,omitempty"`
+	TotalFaults     *int         `bson:"total_faults,omitempty"`
+	RunSequence     int          `bson:"run_sequence"`
+	Completed       bool         `bson:"completed"`
+	Inputs          []InputValue `bson:"inputs,omitempty"`
+	Priority        int          `bson:"priority,omitempty"`
+	InQueue         bool         `bson:"in_queue,omitempty"`
+	QueuedManifest  string       `bson:"queued_manifest,omitempty"`
+	ScoreStrategy   string       `bson:"resiliency_score_strategy,omitempty"`
+	PrunedAt        int64        `bson:"pruned_at,omitempty"`
+	ArchiveLocation string       `bson:"archive_location,omitempty"`
+
+	// CompressedExecutionData is the gzip compressed ExecutionData, the operator compresses the execution
+	// data of the runs when they are stored
+	CompressedExecutionData []byte `bson:"compressed_execution_data,omitempty"`
+}
+
+// InputValue is the value of an experiment input used by an experiment run
+type InputValue struct {
+	Name  string `bson:"name" json:"name"`
+	Value string `bson:"value" json:"value"`
 }
 
 type Probes struct {
@@ -54,3 +71,14 @@ type FlattenedExperimentRun struct {
 	ExperimentRuns   ChaosExperimentRun `bson:"experiment_runs"`
 	IsRemoved        bool               `bson:"isRemoved"`
 }
+
+// ExperimentRunTrend contains the aggregated details of the experiment runs completed in a time bucket
+type ExperimentRunTrend struct {
+	BucketStart  int64    `bson:"_id"`
+	AvgResScore  *float64 `bson:"avg_resiliency_score"`
+	TotalRuns    int      `bson:"total_runs"`
+	PassedRuns   int      `bson:"passed_runs"`
+	FailedRuns   int      `bson:"failed_runs"`
+	FaultsPassed int      `bson:"faults_passed"`
+	FaultsFailed int      `bson:"faults_failed"`
+}
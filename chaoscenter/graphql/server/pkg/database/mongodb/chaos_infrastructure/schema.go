@@ -28,6 +28,8 @@ type ChaosInfra struct {
 	Tolerations             []*Toleration `bson:"tolerations,omitempty"`
 	StartTime               string        `bson:"start_time"`
 	Version                 string        `bson:"version"`
+	MaxConcurrentRuns       int           `bson:"max_concurrent_runs,omitempty"`
+	RunQueuePolicy          string        `bson:"run_queue_policy,omitempty"`
 }
 
 type TotalFilteredData struct {
@@ -59,6 +61,8 @@ type ChaosInfraDetails struct {
 	SkipSSL                 *bool            `bson:"skip_ssl"`
 	InfraNsExists           *bool            `bson:"infra_ns_exists"`
 	InfraSaExists           *bool            `bson:"infra_sa_exists"`
+	MaxConcurrentRuns       int              `bson:"max_concurrent_runs,omitempty"`
+	RunQueuePolicy          string           `bson:"run_queue_policy,omitempty"`
 }
 
 type AggregatedGetInfras struct {
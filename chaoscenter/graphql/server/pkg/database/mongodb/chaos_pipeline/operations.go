@@ -0,0 +1,108 @@
+package chaos_pipeline
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for chaos pipeline collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewChaosPipelineOperator returns a new instance of Operator
+func NewChaosPipelineOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertPipeline takes details of a chaos pipeline and inserts into the database collection
+func (c *Operator) InsertPipeline(ctx context.Context, pipeline ChaosPipeline) error {
+	return c.operator.Create(ctx, mongodb.ChaosPipelineCollection, pipeline)
+}
+
+// GetPipeline returns the chaos pipeline matching the query
+func (c *Operator) GetPipeline(ctx context.Context, query bson.D) (ChaosPipeline, error) {
+	var pipeline ChaosPipeline
+	result, err := c.operator.Get(ctx, mongodb.ChaosPipelineCollection, query)
+	if err != nil {
+		return ChaosPipeline{}, err
+	}
+	if err = result.Decode(&pipeline); err != nil {
+		return ChaosPipeline{}, err
+	}
+
+	return pipeline, nil
+}
+
+// GetPipelines returns all the chaos pipelines matching the query
+func (c *Operator) GetPipelines(ctx context.Context, query bson.D) ([]ChaosPipeline, error) {
+	var pipelines []ChaosPipeline
+	results, err := c.operator.List(ctx, mongodb.ChaosPipelineCollection, query, options.Find().SetSort(bson.D{{"created_at", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	if err = results.All(ctx, &pipelines); err != nil {
+		return nil, err
+	}
+
+	return pipelines, nil
+}
+
+// UpdatePipeline takes query and update parameters to update the chaos pipeline in the database
+func (c *Operator) UpdatePipeline(ctx context.Context, query bson.D, update bson.D) (int64, error) {
+	result, err := c.operator.Update(ctx, mongodb.ChaosPipelineCollection, query, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.MatchedCount, nil
+}
+
+// InsertPipelineExecution takes details of a chaos pipeline execution and inserts into the database collection
+func (c *Operator) InsertPipelineExecution(ctx context.Context, execution PipelineExecution) error {
+	return c.operator.Create(ctx, mongodb.ChaosPipelineExecutionCollection, execution)
+}
+
+// GetPipelineExecution returns the chaos pipeline execution matching the query
+func (c *Operator) GetPipelineExecution(ctx context.Context, query bson.D) (PipelineExecution, error) {
+	var execution PipelineExecution
+	result, err := c.operator.Get(ctx, mongodb.ChaosPipelineExecutionCollection, query)
+	if err != nil {
+		return PipelineExecution{}, err
+	}
+	if err = result.Decode(&execution); err != nil {
+		return PipelineExecution{}, err
+	}
+
+	return execution, nil
+}
+
+// GetPipelineExecutions returns all the chaos pipeline executions matching the query, the latest execution first
+func (c *Operator) GetPipelineExecutions(ctx context.Context, query bson.D) ([]PipelineExecution, error) {
+	var executions []PipelineExecution
+	results, err := c.operator.List(ctx, mongodb.ChaosPipelineExecutionCollection, query, options.Find().SetSort(bson.D{{"started_at", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	if err = results.All(ctx, &executions); err != nil {
+		return nil, err
+	}
+
+	return executions, nil
+}
+
+// UpdatePipelineExecution updates the chaos pipeline execution matching the query, the number of
+// matched executions is returned so that the callers can detect the concurrent updates
+func (c *Operator) UpdatePipelineExecution(ctx context.Context, query bson.D, update bson.D) (int64, error) {
+	result, err := c.operator.Update(ctx, mongodb.ChaosPipelineExecutionCollection, query, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.MatchedCount, nil
+}
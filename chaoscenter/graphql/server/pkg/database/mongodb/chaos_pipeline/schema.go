@@ -0,0 +1,58 @@
+package chaos_pipeline
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+)
+
+// ChaosPipeline contains the required fields to be stored in the database for a chaos pipeline
+type ChaosPipeline struct {
+	mongodb.ResourceDetails `bson:",inline"`
+	mongodb.Audit           `bson:",inline"`
+	ProjectID               string          `bson:"project_id"`
+	PipelineID              string          `bson:"pipeline_id"`
+	Stages                  []PipelineStage `bson:"stages"`
+}
+
+// PipelineStage is a stage of a pipeline, its conditions are evaluated on the experiment run of the previous stage
+type PipelineStage struct {
+	Name         string              `bson:"name"`
+	ExperimentID string              `bson:"experiment_id"`
+	Conditions   []PipelineCondition `bson:"conditions,omitempty"`
+}
+
+type PipelineCondition struct {
+	Field    model.PipelineConditionField    `bson:"field"`
+	Operator model.PipelineConditionOperator `bson:"operator"`
+	Value    float64                         `bson:"value"`
+}
+
+// PipelineExecution contains the required fields to be stored in the database for an execution of a chaos pipeline
+type PipelineExecution struct {
+	ProjectID           string                        `bson:"project_id"`
+	PipelineExecutionID string                        `bson:"pipeline_execution_id"`
+	PipelineID          string                        `bson:"pipeline_id"`
+	PipelineName        string                        `bson:"pipeline_name"`
+	Status              model.PipelineExecutionStatus `bson:"status"`
+	CurrentStage        int                           `bson:"current_stage"`
+	Stages              []PipelineStageExecution      `bson:"stages"`
+	StartedAt           int64                         `bson:"started_at"`
+	CompletedAt         int64                         `bson:"completed_at,omitempty"`
+	StartedBy           mongodb.UserDetailResponse    `bson:"started_by"`
+}
+
+// PipelineStageExecution is the execution of a stage of a pipeline, the stages of the pipeline
+// are copied on starting the execution so that updates of the pipeline don't affect it
+type PipelineStageExecution struct {
+	PipelineStage   `bson:",inline"`
+	Status          model.PipelineStageStatus `bson:"status"`
+	NotifyID        string                    `bson:"notify_id,omitempty"`
+	ExperimentRunID string                    `bson:"experiment_run_id,omitempty"`
+	Phase           string                    `bson:"phase,omitempty"`
+	ResiliencyScore *float64                  `bson:"resiliency_score,omitempty"`
+	FaultsPassed    *int                      `bson:"faults_passed,omitempty"`
+	FaultsFailed    *int                      `bson:"faults_failed,omitempty"`
+	Message         string                    `bson:"message,omitempty"`
+	StartedAt       int64                     `bson:"started_at,omitempty"`
+	CompletedAt     int64                     `bson:"completed_at,omitempty"`
+}
@@ -40,6 +40,24 @@ func (g *GetCollectionStruct) getCollection(collectionType int) (*mongo.Collecti
 		return mongoClient.(*MongoClient).EnvironmentCollection, nil
 	case ChaosProbeCollection:
 		return mongoClient.(*MongoClient).ChaosProbeCollection, nil
+	case ChaosPipelineCollection:
+		return mongoClient.(*MongoClient).ChaosPipelineCollection, nil
+	case ChaosPipelineExecutionCollection:
+		return mongoClient.(*MongoClient).ChaosPipelineExecutionCollection, nil
+	case WebhookCollection:
+		return mongoClient.(*MongoClient).WebhookCollection, nil
+	case WebhookDeliveryCollection:
+		return mongoClient.(*MongoClient).WebhookDeliveryCollection, nil
+	case AuditEventCollection:
+		return mongoClient.(*MongoClient).AuditEventCollection, nil
+	case RunRetentionPolicyCollection:
+		return mongoClient.(*MongoClient).RunRetentionPolicyCollection, nil
+	case FaultResultCollection:
+		return mongoClient.(*MongoClient).FaultResultCollection, nil
+	case PubSubMessageCollection:
+		return mongoClient.(*MongoClient).PubSubMessageCollection, nil
+	case RunApprovalCollection:
+		return mongoClient.(*MongoClient).RunApprovalCollection, nil
 	default:
 		return nil, errors.New("unknown collection name")
 	}
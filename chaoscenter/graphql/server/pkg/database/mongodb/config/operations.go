@@ -2,11 +2,13 @@ package config
 
 import (
 	"context"
+	"time"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
 )
 
 // CreateConfig creates a new server config with unique key
@@ -52,3 +54,53 @@ func UpdateConfig(ctx context.Context, key string, value interface{}) error {
 	}
 	return nil
 }
+
+// SaveConfig creates or updates the server config
+func SaveConfig(ctx context.Context, key string, value interface{}) error {
+	query := bson.D{
+		{"key", key},
+	}
+	update := bson.D{{"$set", bson.D{{
+		"value", value}},
+	}}
+	_, err := mongodb.Operator.Update(ctx, mongodb.ServerConfigCollection, query, update, options.Update().SetUpsert(true))
+	return err
+}
+
+// AcquireLease acquires or renews the lease for the holder, it fails without error if the lease is
+// held by another holder and has not expired yet
+func AcquireLease(ctx context.Context, key string, holder string, duration time.Duration) (bool, error) {
+	now := time.Now().UnixMilli()
+	query := bson.D{
+		{"key", key},
+		{"$or", bson.A{
+			bson.D{{"holder", holder}},
+			bson.D{{"expires_at", bson.D{{"$lt", now}}}},
+		}},
+	}
+	update := bson.D{{"$set", bson.D{
+		{"holder", holder},
+		{"expires_at", now + duration.Milliseconds()},
+	}}}
+
+	// the upsert of a lease held by another holder conflicts with the unique index on the key
+	_, err := mongodb.Operator.Update(ctx, mongodb.ServerConfigCollection, query, update, options.Update().SetUpsert(true))
+	if mongo.IsDuplicateKeyError(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// ReleaseLease expires the lease if it is held by the holder so that another holder can acquire it
+func ReleaseLease(ctx context.Context, key string, holder string) error {
+	query := bson.D{
+		{"key", key},
+		{"holder", holder},
+	}
+	update := bson.D{{"$set", bson.D{{"expires_at", int64(0)}}}}
+	_, err := mongodb.Operator.Update(ctx, mongodb.ServerConfigCollection, query, update)
+	return err
+}
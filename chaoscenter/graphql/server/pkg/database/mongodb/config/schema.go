@@ -5,3 +5,11 @@ type ServerConfig struct {
 	Key   string      `bson:"key"`
 	Value interface{} `bson:"value"`
 }
+
+// Lease is stored among the server configs, it grants a singleton job of the server to the replica
+// holding it until it expires
+type Lease struct {
+	Key       string `bson:"key"`
+	Holder    string `bson:"holder"`
+	ExpiresAt int64  `bson:"expires_at"`
+}
@@ -1,6 +1,9 @@
 package environments
 
-import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+)
 
 type EnvironmentType string
 
@@ -12,10 +15,22 @@ const (
 type Environment struct {
 	mongodb.Audit           `bson:",inline"`
 	mongodb.ResourceDetails `bson:",inline"`
-	ProjectID               string          `bson:"project_id"`
-	EnvironmentID           string          `bson:"environment_id"`
-	Type                    EnvironmentType `bson:"type"`
-	InfraIDs                []string        `bson:"infra_ids"`
+	ProjectID               string           `bson:"project_id"`
+	EnvironmentID           string           `bson:"environment_id"`
+	Type                    EnvironmentType  `bson:"type"`
+	InfraIDs                []string         `bson:"infra_ids"`
+	BlackoutWindows         []BlackoutWindow `bson:"blackout_windows,omitempty"`
+	RequireRunApproval      bool             `bson:"require_run_approval,omitempty"` // runs on the infras of a PROD environment wait for an approval
+}
+
+// BlackoutWindow is a window in which no experiment runs are started on the infras of the environment,
+// the start and end time of recurring windows are of their first occurrence
+type BlackoutWindow struct {
+	WindowID   string                         `bson:"window_id"`
+	Name       string                         `bson:"name"`
+	StartTime  int64                          `bson:"start_time"`
+	EndTime    int64                          `bson:"end_time"`
+	Recurrence model.BlackoutWindowRecurrence `bson:"recurrence"`
 }
 
 type TotalFilteredData struct {
@@ -0,0 +1,47 @@
+package fault_result
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Operator is the model for fault result collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewFaultResultOperator returns a new instance of Operator
+func NewFaultResultOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// UpsertFaultResults creates or replaces the results of the faults of an experiment run, a result is
+// identified by its run and the step of the fault so that a run event received twice doesn't duplicate it
+func (c *Operator) UpsertFaultResults(ctx context.Context, faultResults []FaultResult) error {
+	for _, faultResult := range faultResults {
+		query := bson.D{
+			{"experiment_run_id", faultResult.ExperimentRunID},
+			{"step_name", faultResult.StepName},
+		}
+		if _, err := c.operator.Replace(ctx, mongodb.FaultResultCollection, query, faultResult); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// CountFaultResults returns the number of fault results matching the query
+func (c *Operator) CountFaultResults(ctx context.Context, query bson.D) (int64, error) {
+	return c.operator.CountDocuments(ctx, mongodb.FaultResultCollection, query)
+}
+
+// GetAggregateFaultResults runs the aggregation pipeline on the fault results
+func (c *Operator) GetAggregateFaultResults(ctx context.Context, pipeline mongo.Pipeline) (*mongo.Cursor, error) {
+	return c.operator.Aggregate(ctx, mongodb.FaultResultCollection, pipeline)
+}
@@ -0,0 +1,54 @@
+package fault_result
+
+import "github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+
+// FaultResult contains the required fields to be stored in the database for the result of a fault of a
+// completed experiment run, it is extracted from the chaos engine node of the execution data of the run
+type FaultResult struct {
+	ProjectID              string             `bson:"project_id"`
+	ExperimentID           string             `bson:"experiment_id"`
+	ExperimentName         string             `bson:"experiment_name"`
+	ExperimentRunID        string             `bson:"experiment_run_id"`
+	InfraID                string             `bson:"infra_id"`
+	FaultName              string             `bson:"fault_name"`
+	StepName               string             `bson:"step_name"`
+	Namespace              string             `bson:"namespace,omitempty"`
+	Targets                []FaultTarget      `bson:"targets"`
+	Verdict                model.FaultVerdict `bson:"verdict"`
+	ProbeSuccessPercentage *float64           `bson:"probe_success_percentage,omitempty"`
+	Probes                 []ProbeResult      `bson:"probes"`
+	StartedAt              int64              `bson:"started_at,omitempty"`
+	FinishedAt             int64              `bson:"finished_at,omitempty"`
+	FailureReason          string             `bson:"failure_reason,omitempty"`
+	CreatedAt              int64              `bson:"created_at"`
+}
+
+// FaultTarget is the resource targeted by a fault
+type FaultTarget struct {
+	Name string `bson:"name"`
+	Kind string `bson:"kind,omitempty"`
+}
+
+// ProbeResult is the result of a probe of a fault
+type ProbeResult struct {
+	Name        string             `bson:"name"`
+	Type        string             `bson:"type,omitempty"`
+	Mode        string             `bson:"mode,omitempty"`
+	Verdict     model.ProbeVerdict `bson:"verdict"`
+	Description string             `bson:"description,omitempty"`
+}
+
+// FaultAnalytics is the aggregation of a group of fault results
+type FaultAnalytics struct {
+	FaultName                     string   `bson:"fault_name,omitempty"`
+	Target                        string   `bson:"target,omitempty"`
+	ExperimentID                  string   `bson:"experiment_id,omitempty"`
+	ExperimentName                string   `bson:"experiment_name,omitempty"`
+	TotalRuns                     int      `bson:"total_runs"`
+	Passed                        int      `bson:"passed"`
+	Failed                        int      `bson:"failed"`
+	Stopped                       int      `bson:"stopped"`
+	AverageProbeSuccessPercentage *float64 `bson:"average_probe_success_percentage"`
+	LastFailedAt                  int64    `bson:"last_failed_at,omitempty"`
+	LastFailureReason             string   `bson:"last_failure_reason,omitempty"`
+}
@@ -26,6 +26,15 @@ const (
 	ProjectCollection
 	EnvironmentCollection
 	ChaosProbeCollection
+	ChaosPipelineCollection
+	ChaosPipelineExecutionCollection
+	WebhookCollection
+	WebhookDeliveryCollection
+	AuditEventCollection
+	RunRetentionPolicyCollection
+	FaultResultCollection
+	PubSubMessageCollection
+	RunApprovalCollection
 )
 
 // MongoInterface requires a MongoClient that implements the Initialize method to create the Mongo DB client
@@ -37,36 +46,54 @@ type MongoInterface interface {
 
 // MongoClient structure contains all the Database collections and the instance of the Database
 type MongoClient struct {
-	Database                      *mongo.Database
-	ChaosInfraCollection          *mongo.Collection
-	ChaosExperimentCollection     *mongo.Collection
-	ChaosExperimentRunsCollection *mongo.Collection
-	ChaosHubCollection            *mongo.Collection
-	ChaosServerConfigCollection   *mongo.Collection
-	ImageRegistryCollection       *mongo.Collection
-	ServerConfigCollection        *mongo.Collection
-	GitOpsCollection              *mongo.Collection
-	UserCollection                *mongo.Collection
-	ProjectCollection             *mongo.Collection
-	EnvironmentCollection         *mongo.Collection
-	ChaosProbeCollection          *mongo.Collection
+	Database                         *mongo.Database
+	ChaosInfraCollection             *mongo.Collection
+	ChaosExperimentCollection        *mongo.Collection
+	ChaosExperimentRunsCollection    *mongo.Collection
+	ChaosHubCollection               *mongo.Collection
+	ChaosServerConfigCollection      *mongo.Collection
+	ImageRegistryCollection          *mongo.Collection
+	ServerConfigCollection           *mongo.Collection
+	GitOpsCollection                 *mongo.Collection
+	UserCollection                   *mongo.Collection
+	ProjectCollection                *mongo.Collection
+	EnvironmentCollection            *mongo.Collection
+	ChaosProbeCollection             *mongo.Collection
+	ChaosPipelineCollection          *mongo.Collection
+	ChaosPipelineExecutionCollection *mongo.Collection
+	WebhookCollection                *mongo.Collection
+	WebhookDeliveryCollection        *mongo.Collection
+	AuditEventCollection             *mongo.Collection
+	RunRetentionPolicyCollection     *mongo.Collection
+	FaultResultCollection            *mongo.Collection
+	PubSubMessageCollection          *mongo.Collection
+	RunApprovalCollection            *mongo.Collection
 }
 
 var (
 	Client      MongoInterface = &MongoClient{}
 	MgoClient   *mongo.Client
 	Collections = map[int]string{
-		ChaosInfraCollection:          "chaosInfrastructures",
-		ChaosExperimentCollection:     "chaosExperiments",
-		ChaosExperimentRunsCollection: "chaosExperimentRuns",
-		ChaosProbeCollection:          "chaosProbes",
-		ChaosHubCollection:            "chaosHubs",
-		ImageRegistryCollection:       "imageRegistry",
-		ServerConfigCollection:        "serverConfig",
-		GitOpsCollection:              "gitops",
-		UserCollection:                "user",
-		ProjectCollection:             "project",
-		EnvironmentCollection:         "environment",
+		ChaosInfraCollection:             "chaosInfrastructures",
+		ChaosExperimentCollection:        "chaosExperiments",
+		ChaosExperimentRunsCollection:    "chaosExperimentRuns",
+		ChaosProbeCollection:             "chaosProbes",
+		ChaosHubCollection:               "chaosHubs",
+		ImageRegistryCollection:          "imageRegistry",
+		ServerConfigCollection:           "serverConfig",
+		GitOpsCollection:                 "gitops",
+		UserCollection:                   "user",
+		ProjectCollection:                "project",
+		EnvironmentCollection:            "environment",
+		ChaosPipelineCollection:          "chaosPipelines",
+		ChaosPipelineExecutionCollection: "chaosPipelineExecutions",
+		WebhookCollection:                "webhooks",
+		WebhookDeliveryCollection:        "webhookDeliveries",
+		AuditEventCollection:             "auditEvents",
+		RunRetentionPolicyCollection:     "runRetentionPolicies",
+		FaultResultCollection:            "chaosFaultResults",
+		PubSubMessageCollection:          "pubSubMessages",
+		RunApprovalCollection:            "runApprovals",
 	}
 
 	DbName            = "litmus"
@@ -167,6 +194,34 @@ func (m *MongoClient) initAllCollection() {
 				"name": 1,
 			},
 		},
+		// The listing of the experiments filters the experiments of a project and sorts them by their last
+		// update time, the _id breaks the ties of the cursor pagination
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"infra_id", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"experiment_type", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
 	})
 	if err != nil {
 		logrus.WithError(err).Error("failed to create indexes for chaosExperiments collection")
@@ -185,6 +240,50 @@ func (m *MongoClient) initAllCollection() {
 				"experiment_run_id": 1,
 			},
 		},
+		// The listing of the runs filters the runs of a project and sorts them by their last update time
+		// with the cursor pagination, or by their creation time with the offset pagination
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"experiment_id", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"infra_id", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"phase", 1},
+				{"updated_at", -1},
+				{"_id", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"is_removed", 1},
+				{"created_at", -1},
+			},
+		},
 	})
 	if err != nil {
 		logrus.WithError(err).Fatal("failed to create indexes for chaosExperimentRuns collection")
@@ -292,4 +391,248 @@ func (m *MongoClient) initAllCollection() {
 	if err != nil {
 		logrus.WithError(err).Error("failed to create indexes for chaosProbes collection")
 	}
+
+	// Initialize chaos pipelines collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[ChaosPipelineCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create chaosPipelines collection")
+	}
+
+	m.ChaosPipelineCollection = m.Database.Collection(Collections[ChaosPipelineCollection])
+	_, err = m.ChaosPipelineCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"pipeline_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for chaosPipelines collection")
+	}
+
+	// Initialize chaos pipeline executions collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[ChaosPipelineExecutionCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create chaosPipelineExecutions collection")
+	}
+
+	m.ChaosPipelineExecutionCollection = m.Database.Collection(Collections[ChaosPipelineExecutionCollection])
+	_, err = m.ChaosPipelineExecutionCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"pipeline_execution_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"pipeline_id", 1},
+				{"started_at", -1},
+			},
+		},
+		{
+			Keys: bson.M{
+				"stages.notify_id": 1,
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for chaosPipelineExecutions collection")
+	}
+
+	// Initialize webhooks collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[WebhookCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create webhooks collection")
+	}
+
+	m.WebhookCollection = m.Database.Collection(Collections[WebhookCollection])
+	_, err = m.WebhookCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"webhook_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for webhooks collection")
+	}
+
+	// Initialize webhook deliveries collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[WebhookDeliveryCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create webhookDeliveries collection")
+	}
+
+	m.WebhookDeliveryCollection = m.Database.Collection(Collections[WebhookDeliveryCollection])
+	_, err = m.WebhookDeliveryCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"delivery_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"webhook_id", 1},
+				{"created_at", -1},
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for webhookDeliveries collection")
+	}
+
+	// Initialize audit events collection, the events of the authentication server are written to it as well
+	err = m.Database.CreateCollection(context.TODO(), Collections[AuditEventCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create auditEvents collection")
+	}
+
+	m.AuditEventCollection = m.Database.Collection(Collections[AuditEventCollection])
+	_, err = m.AuditEventCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"audit_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"created_at", -1},
+			},
+		},
+		{
+			Keys: bson.M{
+				"resource_ids": 1,
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for auditEvents collection")
+	}
+
+	// Initialize run retention policies collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[RunRetentionPolicyCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create runRetentionPolicies collection")
+	}
+
+	m.RunRetentionPolicyCollection = m.Database.Collection(Collections[RunRetentionPolicyCollection])
+	_, err = m.RunRetentionPolicyCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"project_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for runRetentionPolicies collection")
+	}
+
+	// Initialize fault results collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[FaultResultCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create chaosFaultResults collection")
+	}
+
+	m.FaultResultCollection = m.Database.Collection(Collections[FaultResultCollection])
+	_, err = m.FaultResultCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.D{
+				{"experiment_run_id", 1},
+				{"step_name", 1},
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"fault_name", 1},
+				{"created_at", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"targets.name", 1},
+				{"created_at", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"created_at", -1},
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for chaosFaultResults collection")
+	}
+
+	// Initialize pub/sub messages collection, the messages are only needed while they are delivered to the
+	// replicas of the server so they expire shortly after they are published
+	err = m.Database.CreateCollection(context.TODO(), Collections[PubSubMessageCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create pubSubMessages collection")
+	}
+
+	m.PubSubMessageCollection = m.Database.Collection(Collections[PubSubMessageCollection])
+	_, err = m.PubSubMessageCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"created_at": 1,
+			},
+			Options: options.Index().SetExpireAfterSeconds(300),
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for pubSubMessages collection")
+	}
+
+	// Initialize run approvals collection
+	err = m.Database.CreateCollection(context.TODO(), Collections[RunApprovalCollection], nil)
+	if err != nil {
+		logrus.WithError(err).Error("failed to create runApprovals collection")
+	}
+
+	m.RunApprovalCollection = m.Database.Collection(Collections[RunApprovalCollection])
+	_, err = m.RunApprovalCollection.Indexes().CreateMany(backgroundContext, []mongo.IndexModel{
+		{
+			Keys: bson.M{
+				"approval_id": 1,
+			},
+			Options: options.Index().SetUnique(true),
+		},
+		{
+			Keys: bson.D{
+				{"project_id", 1},
+				{"status", 1},
+				{"created_at", -1},
+			},
+		},
+		{
+			Keys: bson.D{
+				{"experiment_id", 1},
+				{"status", 1},
+			},
+		},
+	})
+	if err != nil {
+		logrus.WithError(err).Error("failed to create indexes for runApprovals collection")
+	}
 }
@@ -4,9 +4,12 @@ import (
 	"context"
 	"encoding/base64"
 
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 	"go.mongodb.org/mongo-driver/bson"
 	"go.mongodb.org/mongo-driver/mongo"
 	"go.mongodb.org/mongo-driver/mongo/options"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 )
 
 type MongoOperator interface {
@@ -51,7 +54,9 @@ func (m *MongoOperations) Create(ctx context.Context, collectionType int, docume
 		return err
 	}
 
+	ctx, span := startSpan(ctx, "insertOne", collectionType)
 	_, err = collection.InsertOne(ctx, document)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return err
 	}
@@ -65,7 +70,9 @@ func (m *MongoOperations) CreateMany(ctx context.Context, collectionType int, do
 	if err != nil {
 		return err
 	}
+	ctx, span := startSpan(ctx, "insertMany", collectionType)
 	_, err = collection.InsertMany(ctx, documents)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return err
 	}
@@ -78,7 +85,13 @@ func (m *MongoOperations) Get(ctx context.Context, collectionType int, query bso
 	if err != nil {
 		return nil, err
 	}
+	ctx, span := startSpan(ctx, "findOne", collectionType)
 	result := collection.FindOne(ctx, query)
+	// a missing document is a valid result of the query
+	if err = result.Err(); err == mongo.ErrNoDocuments {
+		err = nil
+	}
+	tracing.EndSpan(span, err)
 	return result, nil
 }
 
@@ -89,7 +102,9 @@ func (m *MongoOperations) List(ctx context.Context, collectionType int, query bs
 		return nil, err
 	}
 
+	ctx, span := startSpan(ctx, "find", collectionType)
 	result, err := collection.Find(ctx, query, opts...)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
@@ -104,7 +119,9 @@ func (m *MongoOperations) Update(ctx context.Context, collectionType int, query,
 	if err != nil {
 		return result, err
 	}
+	ctx, span := startSpan(ctx, "updateOne", collectionType)
 	result, err = collection.UpdateOne(ctx, query, update, opts...)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return result, err
 	}
@@ -118,7 +135,9 @@ func (m *MongoOperations) UpdateMany(ctx context.Context, collectionType int, qu
 	if err != nil {
 		return result, err
 	}
+	ctx, span := startSpan(ctx, "updateMany", collectionType)
 	result, err = collection.UpdateMany(ctx, query, update, opts...)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return result, err
 	}
@@ -134,7 +153,9 @@ func (m *MongoOperations) Replace(ctx context.Context, collectionType int, query
 	}
 	// If the given item is not present then insert.
 	opts := options.Replace().SetUpsert(true)
+	ctx, span := startSpan(ctx, "replaceOne", collectionType)
 	result, err = collection.ReplaceOne(ctx, query, replacement, opts)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return result, err
 	}
@@ -148,7 +169,9 @@ func (m *MongoOperations) Delete(ctx context.Context, collectionType int, query
 	if err != nil {
 		return result, err
 	}
+	ctx, span := startSpan(ctx, "deleteOne", collectionType)
 	result, err = collection.DeleteOne(ctx, query, opts...)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return result, err
 	}
@@ -163,7 +186,9 @@ func (m *MongoOperations) CountDocuments(ctx context.Context, collectionType int
 		return result, err
 	}
 
+	ctx, span := startSpan(ctx, "countDocuments", collectionType)
 	result, err = collection.CountDocuments(ctx, query, opts...)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return result, err
 	}
@@ -176,13 +201,24 @@ func (m *MongoOperations) Aggregate(ctx context.Context, collectionType int, pip
 		return nil, err
 	}
 
+	ctx, span := startSpan(ctx, "aggregate", collectionType)
 	result, err := collection.Aggregate(ctx, pipeline, opts...)
+	tracing.EndSpan(span, err)
 	if err != nil {
 		return nil, err
 	}
 	return result, nil
 }
 
+// startSpan starts the span of an operation on a collection
+func startSpan(ctx context.Context, operation string, collectionType int) (context.Context, trace.Span) {
+	return tracing.StartSpan(ctx, "mongodb."+operation,
+		attribute.String("db.system", "mongodb"),
+		attribute.String("db.operation", operation),
+		attribute.String("db.mongodb.collection", Collections[collectionType]),
+	)
+}
+
 // GetCollection fetches the correct collection based on the collection type
 func (m *MongoOperations) GetCollection(collectionType int) (*mongo.Collection, error) {
 	return GetCollectionClient.getCollection(collectionType)
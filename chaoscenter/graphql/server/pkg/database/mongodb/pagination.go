@@ -0,0 +1,109 @@
+package mongodb
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+const (
+	// DefaultPageLimit is the number of documents of a page if the request doesn't set it
+	DefaultPageLimit = 15
+	// MaxPageLimit is the maximum number of documents of a page with cursor pagination
+	MaxPageLimit = 100
+)
+
+// PageCursor is the position of the last document of a page when the documents are sorted by their
+// last update time and their ID, the ID breaks the ties between the documents updated at the same time.
+// The cursors are opaque to the clients, they are encoded as base64 JSON
+type PageCursor struct {
+	UpdatedAt int64              `json:"updatedAt"`
+	ID        primitive.ObjectID `json:"id"`
+}
+
+// Encode returns the opaque representation of the cursor
+func (p PageCursor) Encode() string {
+	data, _ := json.Marshal(p)
+	return base64.RawURLEncoding.EncodeToString(data)
+}
+
+// DecodePageCursor returns the cursor of its opaque representation
+func DecodePageCursor(cursor string) (PageCursor, error) {
+	var pageCursor PageCursor
+	data, err := base64.RawURLEncoding.DecodeString(cursor)
+	if err != nil {
+		return PageCursor{}, errors.New("invalid cursor")
+	}
+	if err = json.Unmarshal(data, &pageCursor); err != nil || pageCursor.ID.IsZero() {
+		return PageCursor{}, errors.New("invalid cursor")
+	}
+
+	return pageCursor, nil
+}
+
+// After returns the condition matching the documents after the cursor in the sort order of PageCursorSort
+func (p PageCursor) After(ascending bool) bson.E {
+	operator := "$lt"
+	if ascending {
+		operator = "$gt"
+	}
+
+	return bson.E{Key: "$or", Value: bson.A{
+		bson.D{{"updated_at", bson.D{{operator, p.UpdatedAt}}}},
+		bson.D{
+			{"updated_at", p.UpdatedAt},
+			{"_id", bson.D{{operator, p.ID}}},
+		},
+	}}
+}
+
+// GetPageLimit returns the number of documents of a page with cursor pagination
+func GetPageLimit(limit *int) (int, error) {
+	if limit == nil {
+		return DefaultPageLimit, nil
+	}
+	if *limit <= 0 || *limit > MaxPageLimit {
+		return 0, fmt.Errorf("limit should be between 1 and %d", MaxPageLimit)
+	}
+	return *limit, nil
+}
+
+// PageCursorSort returns the sort order of the documents paginated with cursors
+func PageCursorSort(ascending bool) bson.D {
+	order := -1
+	if ascending {
+		order = 1
+	}
+
+	return bson.D{
+		{"updated_at", order},
+		{"_id", order},
+	}
+}
+
+// TimestampRangeQuery returns the condition matching the unix millisecond timestamps between the start date
+// and the end date, the end date defaults to now
+func TimestampRangeQuery(startDate string, endDate *string) (bson.D, error) {
+	start, err := strconv.ParseInt(startDate, 10, 64)
+	if err != nil {
+		return nil, errors.New("invalid start date, it should be in unix milliseconds")
+	}
+	end := time.Now().UnixMilli()
+	if endDate != nil {
+		end, err = strconv.ParseInt(*endDate, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid end date, it should be in unix milliseconds")
+		}
+	}
+
+	return bson.D{
+		{"$gte", start},
+		{"$lte", end},
+	}, nil
+}
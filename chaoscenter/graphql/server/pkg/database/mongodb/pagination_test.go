@@ -0,0 +1,116 @@
+package mongodb
+
+import (
+	"testing"
+
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+func TestPageCursor(t *testing.T) {
+	cursor := PageCursor{
+		UpdatedAt: 1700000000000,
+		ID:        primitive.NewObjectID(),
+	}
+
+	decoded, err := DecodePageCursor(cursor.Encode())
+	if err != nil {
+		t.Fatalf("DecodePageCursor() error = %v", err)
+	}
+	if decoded != cursor {
+		t.Errorf("DecodePageCursor() = %+v, want %+v", decoded, cursor)
+	}
+
+	for _, invalid := range []string{"", "not a cursor", PageCursor{UpdatedAt: 1}.Encode()} {
+		if _, err := DecodePageCursor(invalid); err == nil {
+			t.Errorf("DecodePageCursor(%q) should fail", invalid)
+		}
+	}
+}
+
+func TestPageCursorAfter(t *testing.T) {
+	cursor := PageCursor{
+		UpdatedAt: 1700000000000,
+		ID:        primitive.NewObjectID(),
+	}
+
+	tests := []struct {
+		name      string
+		ascending bool
+		operator  string
+	}{
+		{name: "descending order", operator: "$lt"},
+		{name: "ascending order", ascending: true, operator: "$gt"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			condition := cursor.After(tc.ascending)
+			if condition.Key != "$or" {
+				t.Fatalf("After() key = %s, want $or", condition.Key)
+			}
+			alternatives := condition.Value.(bson.A)
+			if len(alternatives) != 2 {
+				t.Fatalf("After() has %d alternatives, want 2", len(alternatives))
+			}
+
+			updatedAt := alternatives[0].(bson.D)[0].Value.(bson.D)[0]
+			if updatedAt.Key != tc.operator || updatedAt.Value != cursor.UpdatedAt {
+				t.Errorf("condition on updated_at = %v, want %s %d", updatedAt, tc.operator, cursor.UpdatedAt)
+			}
+			tie := alternatives[1].(bson.D)
+			id := tie[1].Value.(bson.D)[0]
+			if tie[0].Value != cursor.UpdatedAt || id.Key != tc.operator || id.Value != cursor.ID {
+				t.Errorf("condition on the ties = %v, want updated_at %d and _id %s %s", tie, cursor.UpdatedAt, tc.operator, cursor.ID.Hex())
+			}
+		})
+	}
+}
+
+func TestGetPageLimit(t *testing.T) {
+	valid, zero, tooLarge := 50, 0, MaxPageLimit+1
+
+	tests := []struct {
+		name    string
+		limit   *int
+		want    int
+		wantErr bool
+	}{
+		{name: "default limit", want: DefaultPageLimit},
+		{name: "requested limit", limit: &valid, want: valid},
+		{name: "zero limit", limit: &zero, wantErr: true},
+		{name: "limit above the maximum", limit: &tooLarge, wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := GetPageLimit(tc.limit)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("GetPageLimit() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("GetPageLimit() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestTimestampRangeQuery(t *testing.T) {
+	endDate, invalidDate := "1700000000000", "today"
+
+	query, err := TimestampRangeQuery("1600000000000", &endDate)
+	if err != nil {
+		t.Fatalf("TimestampRangeQuery() error = %v", err)
+	}
+	want := bson.D{{"$gte", int64(1600000000000)}, {"$lte", int64(1700000000000)}}
+	for i := range want {
+		if query[i] != want[i] {
+			t.Errorf("TimestampRangeQuery() = %v, want %v", query, want)
+		}
+	}
+
+	if _, err = TimestampRangeQuery("1600000000000", &invalidDate); err == nil {
+		t.Error("TimestampRangeQuery() should fail with an invalid end date")
+	}
+	if _, err = TimestampRangeQuery(invalidDate, nil); err == nil {
+		t.Error("TimestampRangeQuery() should fail with an invalid start date")
+	}
+}
@@ -67,6 +67,12 @@ func (p *Operator) UpdateProbe(ctx context.Context, query bson.D, updateQuery bs
 	return result, nil
 }
 
+// DeleteProbes deletes the probes matching the query
+func (p *Operator) DeleteProbes(ctx context.Context, query bson.D) error {
+	_, err := p.operator.Delete(ctx, mongodb.ChaosProbeCollection, query)
+	return err
+}
+
 // UpdateProbes updates details of Probe
 func (p *Operator) UpdateProbes(ctx context.Context, query bson.D, updateQuery bson.D) (*mongo.UpdateResult, error) {
 	result, err := p.operator.UpdateMany(ctx, mongodb.ChaosProbeCollection, query, updateQuery)
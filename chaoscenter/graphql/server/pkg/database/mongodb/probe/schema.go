@@ -43,6 +43,8 @@ type ExecutionHistory struct {
 	UpdatedAt      int                        `bson:"updated_at"`
 	Probes         []Probes                   `bson:"probes"`
 	Phase          model.ExperimentRunStatus  `bson:"phase"`
+	// CompressedExecutionData is the gzip compressed execution data of the run
+	CompressedExecutionData []byte `bson:"compressed_execution_data"`
 }
 
 type ProbeWithExecutionHistory struct {
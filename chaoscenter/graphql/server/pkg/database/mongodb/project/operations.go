@@ -20,8 +20,13 @@ func NewProjectOperator(mongodbOperator mongodb.MongoOperator) *Operator {
 	}
 }
 
-func (c *Operator) WatchProjectEvents(ctx context.Context, pipeline mongo.Pipeline, client *mongo.Client) (*mongo.ChangeStream, error) {
-	experimentEvents, err := c.operator.WatchEvents(ctx, client, mongodb.EnvironmentCollection, pipeline, options.ChangeStream().SetFullDocument(options.UpdateLookup))
+// WatchProjectEvents watches the changes of the projects, from the change after the resume token if one is provided
+func (c *Operator) WatchProjectEvents(ctx context.Context, pipeline mongo.Pipeline, client *mongo.Client, resumeToken interface{}) (*mongo.ChangeStream, error) {
+	opts := options.ChangeStream().SetFullDocument(options.UpdateLookup)
+	if resumeToken != nil {
+		opts.SetResumeAfter(resumeToken)
+	}
+	experimentEvents, err := c.operator.WatchEvents(ctx, client, mongodb.EnvironmentCollection, pipeline, opts)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,45 @@
+package pubsub
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for pub/sub message collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewPubSubOperator returns a new instance of Operator
+func NewPubSubOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// CreateMessage inserts a new message, the change stream of the collection delivers it to every replica
+func (c *Operator) CreateMessage(ctx context.Context, message PubSubMessage) error {
+	return c.operator.Create(ctx, mongodb.PubSubMessageCollection, message)
+}
+
+// WatchMessages returns the change stream of the inserted messages, the stream starts after the resume
+// token if it is set so that the messages inserted while the stream was interrupted are not lost
+func (c *Operator) WatchMessages(ctx context.Context, resumeToken bson.Raw) (*mongo.ChangeStream, error) {
+	collection, err := c.operator.GetCollection(mongodb.PubSubMessageCollection)
+	if err != nil {
+		return nil, err
+	}
+
+	pipeline := mongo.Pipeline{
+		{{"$match", bson.D{{"operationType", "insert"}}}},
+	}
+	opts := options.ChangeStream()
+	if resumeToken != nil {
+		opts.SetResumeAfter(resumeToken)
+	}
+	return collection.Watch(ctx, pipeline, opts)
+}
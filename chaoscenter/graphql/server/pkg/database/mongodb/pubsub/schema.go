@@ -0,0 +1,23 @@
+package pubsub
+
+import (
+	"time"
+
+	"go.mongodb.org/mongo-driver/bson/primitive"
+)
+
+// PubSubMessage contains the required fields to be stored in the database for a message published to
+// the replicas of the server, the messages expire shortly after they are created
+type PubSubMessage struct {
+	ID        primitive.ObjectID `bson:"_id,omitempty"`
+	Topic     string             `bson:"topic"`
+	Key       string             `bson:"key"`
+	Payload   []byte             `bson:"payload"`
+	CreatedAt time.Time          `bson:"created_at"`
+}
+
+// PubSubMessageEvent is the change stream event of an inserted message
+type PubSubMessageEvent struct {
+	OperationType string        `bson:"operationType"`
+	FullDocument  PubSubMessage `bson:"fullDocument"`
+}
@@ -0,0 +1,60 @@
+package retention
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+// Operator is the model for run retention policy collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewRetentionOperator returns a new instance of Operator
+func NewRetentionOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// GetRunRetentionPolicy returns the retention policy of the project
+func (c *Operator) GetRunRetentionPolicy(ctx context.Context, projectID string) (RunRetentionPolicy, error) {
+	var policy RunRetentionPolicy
+	result, err := c.operator.Get(ctx, mongodb.RunRetentionPolicyCollection, bson.D{{"project_id", projectID}})
+	if err != nil {
+		return RunRetentionPolicy{}, err
+	}
+	if err = result.Decode(&policy); err != nil {
+		return RunRetentionPolicy{}, err
+	}
+
+	return policy, nil
+}
+
+// GetRunRetentionPolicies returns the retention policies of all the projects which override the default policy
+func (c *Operator) GetRunRetentionPolicies(ctx context.Context) ([]RunRetentionPolicy, error) {
+	var policies []RunRetentionPolicy
+	results, err := c.operator.List(ctx, mongodb.RunRetentionPolicyCollection, bson.D{})
+	if err != nil {
+		return nil, err
+	}
+	if err = results.All(ctx, &policies); err != nil {
+		return nil, err
+	}
+
+	return policies, nil
+}
+
+// UpsertRunRetentionPolicy creates or replaces the retention policy of the project
+func (c *Operator) UpsertRunRetentionPolicy(ctx context.Context, policy RunRetentionPolicy) error {
+	_, err := c.operator.Replace(ctx, mongodb.RunRetentionPolicyCollection, bson.D{{"project_id", policy.ProjectID}}, policy)
+	return err
+}
+
+// DeleteRunRetentionPolicy removes the retention policy of the project
+func (c *Operator) DeleteRunRetentionPolicy(ctx context.Context, projectID string) error {
+	_, err := c.operator.Delete(ctx, mongodb.RunRetentionPolicyCollection, bson.D{{"project_id", projectID}})
+	return err
+}
@@ -0,0 +1,17 @@
+package retention
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+)
+
+// RunRetentionPolicy contains the required fields to be stored in the database for the retention policy
+// of the experiment runs of a project, a limit of zero means the runs are not limited by it
+type RunRetentionPolicy struct {
+	ProjectID            string                     `bson:"project_id"`
+	MaxAgeDays           int                        `bson:"max_age_days"`
+	MaxRunsPerExperiment int                        `bson:"max_runs_per_experiment"`
+	Mode                 model.RunRetentionMode     `bson:"mode"`
+	UpdatedAt            int64                      `bson:"updated_at"`
+	UpdatedBy            mongodb.UserDetailResponse `bson:"updated_by"`
+}
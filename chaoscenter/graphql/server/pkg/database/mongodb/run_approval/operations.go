@@ -0,0 +1,69 @@
+package run_approval
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for run approval collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewRunApprovalOperator returns a new instance of Operator
+func NewRunApprovalOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// CreateRunApproval stores a new approval request
+func (c *Operator) CreateRunApproval(ctx context.Context, request RunApprovalRequest) error {
+	return c.operator.Create(ctx, mongodb.RunApprovalCollection, request)
+}
+
+// GetRunApproval returns the approval request matching the query
+func (c *Operator) GetRunApproval(ctx context.Context, query bson.D) (RunApprovalRequest, error) {
+	var request RunApprovalRequest
+	result, err := c.operator.Get(ctx, mongodb.RunApprovalCollection, query)
+	if err != nil {
+		return RunApprovalRequest{}, err
+	}
+	if err = result.Decode(&request); err != nil {
+		return RunApprovalRequest{}, err
+	}
+
+	return request, nil
+}
+
+// ListRunApprovals returns the approval requests matching the query
+func (c *Operator) ListRunApprovals(ctx context.Context, query bson.D, opts ...*options.FindOptions) ([]RunApprovalRequest, error) {
+	var requests []RunApprovalRequest
+	results, err := c.operator.List(ctx, mongodb.RunApprovalCollection, query, opts...)
+	if err != nil {
+		return nil, err
+	}
+	if err = results.All(ctx, &requests); err != nil {
+		return nil, err
+	}
+
+	return requests, nil
+}
+
+// CountRunApprovals returns the number of approval requests matching the query
+func (c *Operator) CountRunApprovals(ctx context.Context, query bson.D) (int64, error) {
+	return c.operator.CountDocuments(ctx, mongodb.RunApprovalCollection, query)
+}
+
+// UpdateRunApproval updates the approval request matching the query, it returns false if no request was matched,
+// e.g. the request has already been decided in the meantime
+func (c *Operator) UpdateRunApproval(ctx context.Context, query bson.D, update bson.D) (bool, error) {
+	result, err := c.operator.Update(ctx, mongodb.RunApprovalCollection, query, update)
+	if err != nil {
+		return false, err
+	}
+	return result.MatchedCount > 0, nil
+}
@@ -0,0 +1,35 @@
+package run_approval
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+)
+
+// RunApprovalRequest contains the required fields to be stored in the database for an approval request of the
+// action of an experiment of a PROD environment which requires run approvals
+type RunApprovalRequest struct {
+	ApprovalID     string                            `bson:"approval_id"`
+	ProjectID      string                            `bson:"project_id"`
+	ExperimentID   string                            `bson:"experiment_id"`
+	ExperimentName string                            `bson:"experiment_name"`
+	InfraID        string                            `bson:"infra_id"`
+	EnvironmentID  string                            `bson:"environment_id"`
+	Action         model.RunApprovalAction           `bson:"action"`
+	Status         model.RunApprovalStatus           `bson:"status"`
+	Inputs         []dbChaosExperimentRun.InputValue `bson:"inputs,omitempty"`
+	Priority       *int                              `bson:"priority,omitempty"`
+	RequestedBy    mongodb.UserDetailResponse        `bson:"requested_by"`
+	CreatedAt      int64                             `bson:"created_at"`
+	Decision       *RunApprovalDecision              `bson:"decision,omitempty"`
+	NotifyID       string                            `bson:"notify_id,omitempty"`
+	DispatchError  string                            `bson:"dispatch_error,omitempty"`
+}
+
+// RunApprovalDecision is the decision of a project owner on an approval request
+type RunApprovalDecision struct {
+	Status    model.RunApprovalStatus    `bson:"status"`
+	DecidedBy mongodb.UserDetailResponse `bson:"decided_by"`
+	Comment   string                     `bson:"comment,omitempty"`
+	DecidedAt int64                      `bson:"decided_at"`
+}
@@ -0,0 +1,89 @@
+package webhook
+
+import (
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Operator is the model for webhook collection
+type Operator struct {
+	operator mongodb.MongoOperator
+}
+
+// NewWebhookOperator returns a new instance of Operator
+func NewWebhookOperator(mongodbOperator mongodb.MongoOperator) *Operator {
+	return &Operator{
+		operator: mongodbOperator,
+	}
+}
+
+// InsertWebhook takes details of a webhook and inserts into the database collection
+func (c *Operator) InsertWebhook(ctx context.Context, webhook Webhook) error {
+	return c.operator.Create(ctx, mongodb.WebhookCollection, webhook)
+}
+
+// GetWebhook returns the webhook matching the query
+func (c *Operator) GetWebhook(ctx context.Context, query bson.D) (Webhook, error) {
+	var webhook Webhook
+	result, err := c.operator.Get(ctx, mongodb.WebhookCollection, query)
+	if err != nil {
+		return Webhook{}, err
+	}
+	if err = result.Decode(&webhook); err != nil {
+		return Webhook{}, err
+	}
+
+	return webhook, nil
+}
+
+// GetWebhooks returns all the webhooks matching the query
+func (c *Operator) GetWebhooks(ctx context.Context, query bson.D) ([]Webhook, error) {
+	var webhooks []Webhook
+	results, err := c.operator.List(ctx, mongodb.WebhookCollection, query, options.Find().SetSort(bson.D{{"created_at", -1}}))
+	if err != nil {
+		return nil, err
+	}
+	if err = results.All(ctx, &webhooks); err != nil {
+		return nil, err
+	}
+
+	return webhooks, nil
+}
+
+// UpdateWebhook takes query and update parameters to update the webhook in the database
+func (c *Operator) UpdateWebhook(ctx context.Context, query bson.D, update bson.D) (int64, error) {
+	result, err := c.operator.Update(ctx, mongodb.WebhookCollection, query, update)
+	if err != nil {
+		return 0, err
+	}
+
+	return result.MatchedCount, nil
+}
+
+// InsertWebhookDelivery takes details of a webhook delivery and inserts into the database collection
+func (c *Operator) InsertWebhookDelivery(ctx context.Context, delivery WebhookDelivery) error {
+	return c.operator.Create(ctx, mongodb.WebhookDeliveryCollection, delivery)
+}
+
+// GetWebhookDeliveries returns the latest webhook deliveries matching the query, the latest delivery first
+func (c *Operator) GetWebhookDeliveries(ctx context.Context, query bson.D, limit int64) ([]WebhookDelivery, error) {
+	var deliveries []WebhookDelivery
+	results, err := c.operator.List(ctx, mongodb.WebhookDeliveryCollection, query, options.Find().SetSort(bson.D{{"created_at", -1}}).SetLimit(limit))
+	if err != nil {
+		return nil, err
+	}
+	if err = results.All(ctx, &deliveries); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+// UpdateWebhookDelivery takes query and update parameters to update the webhook delivery in the database
+func (c *Operator) UpdateWebhookDelivery(ctx context.Context, query bson.D, update bson.D) error {
+	_, err := c.operator.Update(ctx, mongodb.WebhookDeliveryCollection, query, update)
+	return err
+}
@@ -0,0 +1,43 @@
+package webhook
+
+import (
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+)
+
+// Webhook contains the required fields to be stored in the database for a webhook
+type Webhook struct {
+	mongodb.ResourceDetails `bson:",inline"`
+	mongodb.Audit           `bson:",inline"`
+	ProjectID               string        `bson:"project_id"`
+	WebhookID               string        `bson:"webhook_id"`
+	URL                     string        `bson:"url"`
+	Secret                  string        `bson:"secret"`
+	Filter                  WebhookFilter `bson:"filter"`
+	IsEnabled               bool          `bson:"is_enabled"`
+}
+
+// WebhookFilter contains the filters on the experiment runs whose events are posted to a webhook
+type WebhookFilter struct {
+	Events         []model.WebhookEventType `bson:"events,omitempty"`
+	EnvironmentIDs []string                 `bson:"environment_ids,omitempty"`
+	Tags           []string                 `bson:"tags,omitempty"`
+}
+
+// WebhookDelivery contains the required fields to be stored in the database for a delivery of an event to a webhook
+type WebhookDelivery struct {
+	ProjectID       string                      `bson:"project_id"`
+	DeliveryID      string                      `bson:"delivery_id"`
+	WebhookID       string                      `bson:"webhook_id"`
+	Event           model.WebhookEventType      `bson:"event"`
+	ExperimentID    string                      `bson:"experiment_id"`
+	ExperimentRunID string                      `bson:"experiment_run_id,omitempty"`
+	NotifyID        string                      `bson:"notify_id,omitempty"`
+	Status          model.WebhookDeliveryStatus `bson:"status"`
+	Attempts        int                         `bson:"attempts"`
+	ResponseCode    int                         `bson:"response_code,omitempty"`
+	Error           string                      `bson:"error,omitempty"`
+	Payload         string                      `bson:"payload"`
+	CreatedAt       int64                       `bson:"created_at"`
+	UpdatedAt       int64                       `bson:"updated_at"`
+}
@@ -0,0 +1,42 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// InfraRequiresRunApproval checks if the runs on the infra have to be approved, i.e. the infra belongs to a
+// PROD environment which requires run approvals
+func InfraRequiresRunApproval(ctx context.Context, mongodbOperator mongodb.MongoOperator, infra dbChaosInfra.ChaosInfra) (bool, error) {
+	if infra.EnvironmentID == "" {
+		return false, nil
+	}
+
+	env, err := environments.NewEnvironmentOperator(mongodbOperator).GetEnvironmentDetails(ctx, infra.EnvironmentID, infra.ProjectID)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return false, nil
+		}
+		return false, fmt.Errorf("failed to get environment %s, error: %v", infra.EnvironmentID, err)
+	}
+	if env.IsRemoved {
+		return false, nil
+	}
+
+	return env.RequireRunApproval && model.EnvironmentType(env.Type) == model.EnvironmentTypeProd, nil
+}
+
+// validateRunApproval checks that the run approvals are only required for PROD environments
+func validateRunApproval(envType model.EnvironmentType, requireRunApproval bool) error {
+	if requireRunApproval && envType != model.EnvironmentTypeProd {
+		return errors.New("run approvals can only be required for PROD environments")
+	}
+	return nil
+}
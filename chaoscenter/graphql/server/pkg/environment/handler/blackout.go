@@ -0,0 +1,137 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// BlackoutWindowUsername is recorded as the user for the changes made on enforcing the blackout windows
+const BlackoutWindowUsername = "blackout-window"
+
+var blackoutWindowPeriods = map[model.BlackoutWindowRecurrence]int64{
+	model.BlackoutWindowRecurrenceDaily:  (24 * time.Hour).Milliseconds(),
+	model.BlackoutWindowRecurrenceWeekly: (7 * 24 * time.Hour).Milliseconds(),
+}
+
+// IsBlackoutWindowActive checks if the blackout window or one of its occurrences is active at the given time
+func IsBlackoutWindowActive(window environments.BlackoutWindow, t time.Time) bool {
+	now := t.UnixMilli()
+	if now < window.StartTime {
+		return false
+	}
+
+	period, ok := blackoutWindowPeriods[window.Recurrence]
+	if !ok {
+		return now < window.EndTime
+	}
+	return (now-window.StartTime)%period < window.EndTime-window.StartTime
+}
+
+// GetActiveBlackoutWindow returns the first blackout window which is active at the given time
+func GetActiveBlackoutWindow(windows []environments.BlackoutWindow, t time.Time) *environments.BlackoutWindow {
+	for i := range windows {
+		if IsBlackoutWindowActive(windows[i], t) {
+			return &windows[i]
+		}
+	}
+	return nil
+}
+
+// GetInfraBlackoutWindow returns the blackout window of the infra's environment which is currently active
+func GetInfraBlackoutWindow(ctx context.Context, mongodbOperator mongodb.MongoOperator, infra dbChaosInfra.ChaosInfra) (*environments.BlackoutWindow, error) {
+	return getInfraBlackoutWindow(ctx, environments.NewEnvironmentOperator(mongodbOperator), infra)
+}
+
+func getInfraBlackoutWindow(ctx context.Context, environmentOperator *environments.Operator, infra dbChaosInfra.ChaosInfra) (*environments.BlackoutWindow, error) {
+	if infra.EnvironmentID == "" {
+		return nil, nil
+	}
+
+	env, err := environmentOperator.GetEnvironmentDetails(ctx, infra.EnvironmentID, infra.ProjectID)
+	if err != nil {
+		if err == mongo.ErrNoDocuments {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to get environment %s, error: %v", infra.EnvironmentID, err)
+	}
+	if env.IsRemoved {
+		return nil, nil
+	}
+
+	return GetActiveBlackoutWindow(env.BlackoutWindows, time.Now()), nil
+}
+
+// ValidateInfraBlackoutWindow returns an error if a blackout window of the infra's environment is currently active
+func ValidateInfraBlackoutWindow(ctx context.Context, mongodbOperator mongodb.MongoOperator, infra dbChaosInfra.ChaosInfra) error {
+	window, err := GetInfraBlackoutWindow(ctx, mongodbOperator, infra)
+	if err != nil {
+		return err
+	}
+	if window != nil {
+		return fmt.Errorf("experiment runs are not allowed on infra %s during the blackout window %s of environment %s", infra.Name, window.Name, infra.EnvironmentID)
+	}
+	return nil
+}
+
+func getBlackoutWindows(request []*model.BlackoutWindowInput) ([]environments.BlackoutWindow, error) {
+	windows := []environments.BlackoutWindow{}
+	for _, window := range request {
+		startTime, err := strconv.ParseInt(window.StartTime, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid start time of blackout window %s, error: %v", window.Name, err)
+		}
+		endTime, err := strconv.ParseInt(window.EndTime, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid end time of blackout window %s, error: %v", window.Name, err)
+		}
+		if endTime <= startTime {
+			return nil, fmt.Errorf("end time of blackout window %s must be after its start time", window.Name)
+		}
+		if !window.Recurrence.IsValid() {
+			return nil, fmt.Errorf("invalid recurrence %s of blackout window %s", window.Recurrence, window.Name)
+		}
+		if period, ok := blackoutWindowPeriods[window.Recurrence]; ok && endTime-startTime >= period {
+			return nil, fmt.Errorf("blackout window %s must be shorter than its recurrence period", window.Name)
+		}
+
+		windows = append(windows, environments.BlackoutWindow{
+			WindowID:   uuid.NewString(),
+			Name:       window.Name,
+			StartTime:  startTime,
+			EndTime:    endTime,
+			Recurrence: window.Recurrence,
+		})
+	}
+	return windows, nil
+}
+
+func getModelBlackoutWindows(windows []environments.BlackoutWindow) ([]*model.BlackoutWindow, *model.BlackoutWindow) {
+	var (
+		result []*model.BlackoutWindow
+		active *model.BlackoutWindow
+		now    = time.Now()
+	)
+	for _, window := range windows {
+		blackoutWindow := &model.BlackoutWindow{
+			WindowID:   window.WindowID,
+			Name:       window.Name,
+			StartTime:  strconv.FormatInt(window.StartTime, 10),
+			EndTime:    strconv.FormatInt(window.EndTime, 10),
+			Recurrence: window.Recurrence,
+		}
+		if active == nil && IsBlackoutWindowActive(window, now) {
+			active = blackoutWindow
+		}
+		result = append(result, blackoutWindow)
+	}
+	return result, active
+}
@@ -0,0 +1,193 @@
+package handler
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/argoproj/argo-workflows/v3/pkg/apis/workflow/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment/ops"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
+	probe "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/probe/handler"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	log "github.com/sirupsen/logrus"
+	"github.com/tidwall/gjson"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const blackoutSyncInterval = time.Minute
+
+// BlackoutService suspends the cron experiments on the infras of an environment during
+// its blackout windows and resumes them once the windows end
+type BlackoutService struct {
+	chaosExperimentService  ops.Service
+	probeService            probe.Service
+	environmentOperator     *environments.Operator
+	chaosExperimentOperator *dbChaosExperiment.Operator
+	chaosInfraOperator      *dbChaosInfra.Operator
+}
+
+// NewBlackoutService returns a new instance of the blackout service
+func NewBlackoutService(chaosExperimentService ops.Service, probeService probe.Service, mongodbOperator mongodb.MongoOperator) *BlackoutService {
+	return &BlackoutService{
+		chaosExperimentService:  chaosExperimentService,
+		probeService:            probeService,
+		environmentOperator:     environments.NewEnvironmentOperator(mongodbOperator),
+		chaosExperimentOperator: dbChaosExperiment.NewChaosExperimentOperator(mongodbOperator),
+		chaosInfraOperator:      dbChaosInfra.NewInfrastructureOperator(mongodbOperator),
+	}
+}
+
+// RecurringBlackoutSync syncs the state of the cron experiments with the blackout windows periodically
+// until the context is cancelled
+func (b *BlackoutService) RecurringBlackoutSync(ctx context.Context, r *store.StateData) {
+	for {
+		if err := b.SyncBlackoutWindows(ctx, r); err != nil {
+			log.Errorf("failed to sync blackout windows, error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(blackoutSyncInterval):
+		}
+	}
+}
+
+// SyncBlackoutWindows suspends the cron experiments of the environments with an active blackout
+// window and resumes the cron experiments which were suspended once there is no active window
+func (b *BlackoutService) SyncBlackoutWindows(ctx context.Context, r *store.StateData) error {
+	envs, err := b.environmentOperator.GetEnvironments(ctx, bson.D{
+		{"is_removed", false},
+		{"blackout_windows.0", bson.D{{"$exists", true}}},
+	})
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, env := range envs {
+		if GetActiveBlackoutWindow(env.BlackoutWindows, now) == nil || len(env.InfraIDs) == 0 {
+			continue
+		}
+
+		experiments, err := b.chaosExperimentOperator.GetExperiments(bson.D{
+			{"infra_id", bson.D{{"$in", env.InfraIDs}}},
+			{"experiment_type", dbChaosExperiment.CronExperiment},
+			{"blackout_suspended", bson.D{{"$ne", true}}},
+			{"is_removed", false},
+		})
+		if err != nil {
+			return err
+		}
+		for _, experiment := range experiments {
+			if err := b.setCronExperimentBlackoutState(ctx, experiment, true, r); err != nil {
+				log.Errorf("failed to suspend cron experiment %s, error: %v", experiment.ExperimentID, err)
+			}
+		}
+	}
+
+	experiments, err := b.chaosExperimentOperator.GetExperiments(bson.D{
+		{"blackout_suspended", true},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return err
+	}
+	for _, experiment := range experiments {
+		infra, err := b.chaosInfraOperator.GetInfra(experiment.InfraID)
+		if err != nil {
+			log.Errorf("failed to get infra %s, error: %v", experiment.InfraID, err)
+			continue
+		}
+		window, err := getInfraBlackoutWindow(ctx, b.environmentOperator, infra)
+		if err != nil {
+			log.Error(err)
+			continue
+		}
+		if window != nil {
+			continue
+		}
+		if err := b.setCronExperimentBlackoutState(ctx, experiment, false, r); err != nil {
+			log.Errorf("failed to resume cron experiment %s, error: %v", experiment.ExperimentID, err)
+		}
+	}
+
+	return nil
+}
+
+// setCronExperimentBlackoutState sends the cron experiment to the infra either suspended or as it is stored,
+// the manifest stored in the database is not changed so that the suspension by the users is retained
+func (b *BlackoutService) setCronExperimentBlackoutState(ctx context.Context, experiment dbChaosExperiment.ChaosExperimentRequest, suspend bool, r *store.StateData) error {
+	if len(experiment.Revision) == 0 {
+		return fmt.Errorf("no revisions found")
+	}
+	sort.Slice(experiment.Revision, func(i, j int) bool {
+		return experiment.Revision[i].UpdatedAt > experiment.Revision[j].UpdatedAt
+	})
+
+	// cron experiments which are already suspended by the users are not sent to the infra
+	isSuspended := gjson.Get(experiment.Revision[0].ExperimentManifest, "spec.suspend").Bool()
+	if !isSuspended {
+		infra, err := b.chaosInfraOperator.GetInfra(experiment.InfraID)
+		if err != nil {
+			return err
+		}
+		if !infra.IsActive {
+			return fmt.Errorf("infra %s is not active", experiment.InfraID)
+		}
+
+		manifest, err := utils.ApplyDefaultExperimentInputs(experiment.Revision[0].ExperimentManifest)
+		if err != nil {
+			return err
+		}
+
+		var cronWorkflowManifest v1alpha1.CronWorkflow
+		if err := json.Unmarshal([]byte(manifest), &cronWorkflowManifest); err != nil {
+			return fmt.Errorf("failed to unmarshal experiment manifest, error: %v", err)
+		}
+		cronWorkflowManifest.Spec.Suspend = suspend
+
+		cronWorkflowManifest, _, err = b.chaosExperimentService.UpdateRuntimeCronWorkflowConfiguration(cronWorkflowManifest, experiment)
+		if err != nil {
+			return err
+		}
+		updatedManifest, err := json.Marshal(cronWorkflowManifest)
+		if err != nil {
+			return fmt.Errorf("failed to marshal experiment manifest, error: %v", err)
+		}
+		cronWorkflowManifest, err = b.probeService.GenerateCronExperimentManifestWithProbes(string(updatedManifest), experiment.ProjectID)
+		if err != nil {
+			return fmt.Errorf("failed to generate probes in experiment manifest, error: %v", err)
+		}
+		updatedManifest, err = json.Marshal(cronWorkflowManifest)
+		if err != nil {
+			return fmt.Errorf("failed to marshal experiment manifest, error: %v", err)
+		}
+
+		if r != nil {
+			username := BlackoutWindowUsername
+			chaos_infrastructure.SendExperimentToSubscriber(ctx, experiment.ProjectID, &model.ChaosExperimentRequest{
+				ExperimentID:       &experiment.ExperimentID,
+				ExperimentManifest: string(updatedManifest),
+				ExperimentName:     experiment.Name,
+				InfraID:            experiment.InfraID,
+			}, &username, nil, "update", r)
+		}
+	}
+
+	return b.chaosExperimentOperator.UpdateChaosExperiment(ctx, bson.D{
+		{"experiment_id", experiment.ExperimentID},
+	}, bson.D{
+		{"$set", bson.D{
+			{"blackout_suspended", suspend},
+		}},
+	})
+}
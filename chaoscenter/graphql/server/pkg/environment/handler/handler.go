@@ -45,6 +45,16 @@ func (e *EnvironmentService) CreateEnvironment(ctx context.Context, projectID st
 	}
 	infraIds := []string{}
 
+	blackoutWindows, err := getBlackoutWindows(input.BlackoutWindows)
+	if err != nil {
+		return &model.Environment{}, err
+	}
+
+	requireRunApproval := input.RequireRunApproval != nil && *input.RequireRunApproval
+	if err := validateRunApproval(input.Type, requireRunApproval); err != nil {
+		return &model.Environment{}, err
+	}
+
 	newEnv := environments.Environment{
 		EnvironmentID: input.EnvironmentID,
 		ResourceDetails: mongodb.ResourceDetails{
@@ -52,9 +62,11 @@ func (e *EnvironmentService) CreateEnvironment(ctx context.Context, projectID st
 			Description: desc,
 			Tags:        input.Tags,
 		},
-		ProjectID: projectID,
-		Type:      environments.EnvironmentType(input.Type),
-		InfraIDs:  infraIds,
+		ProjectID:          projectID,
+		Type:               environments.EnvironmentType(input.Type),
+		InfraIDs:           infraIds,
+		BlackoutWindows:    blackoutWindows,
+		RequireRunApproval: requireRunApproval,
 		Audit: mongodb.Audit{
 			CreatedAt: currentTime.UnixMilli(),
 			UpdatedAt: currentTime.UnixMilli(),
@@ -68,18 +80,22 @@ func (e *EnvironmentService) CreateEnvironment(ctx context.Context, projectID st
 		},
 	}
 
-	err := e.EnvironmentOperator.InsertEnvironment(context.Background(), newEnv)
+	err = e.EnvironmentOperator.InsertEnvironment(context.Background(), newEnv)
 	if err != nil {
 		return &model.Environment{}, err
 	}
 
+	windows, activeWindow := getModelBlackoutWindows(blackoutWindows)
 	return &model.Environment{
-		EnvironmentID: input.EnvironmentID,
-		ProjectID:     projectID,
-		Name:          input.Name,
-		Description:   input.Description,
-		Tags:          input.Tags,
-		Type:          input.Type,
+		EnvironmentID:        input.EnvironmentID,
+		ProjectID:            projectID,
+		Name:                 input.Name,
+		Description:          input.Description,
+		Tags:                 input.Tags,
+		Type:                 input.Type,
+		BlackoutWindows:      windows,
+		ActiveBlackoutWindow: activeWindow,
+		RequireRunApproval:   requireRunApproval,
 	}, nil
 
 }
@@ -92,10 +108,29 @@ func (e *EnvironmentService) UpdateEnvironment(ctx context.Context, projectID st
 		{"is_removed", false},
 	}
 
-	_, err := e.EnvironmentOperator.GetEnvironments(context.TODO(), query)
+	envs, err := e.EnvironmentOperator.GetEnvironments(context.TODO(), query)
 	if err != nil {
 		return "couldn't update environment", err
 	}
+	if len(envs) == 0 {
+		return "couldn't update environment", errors.New("environment " + request.EnvironmentID + " not found")
+	}
+
+	// The approval requirement is kept only as long as the environment is a PROD one
+	envType := model.EnvironmentType(envs[0].Type)
+	if request.Type != nil {
+		envType = *request.Type
+	}
+	requireRunApproval := envs[0].RequireRunApproval
+	if request.RequireRunApproval != nil {
+		requireRunApproval = *request.RequireRunApproval
+		if err := validateRunApproval(envType, requireRunApproval); err != nil {
+			return "couldn't update environment", err
+		}
+	}
+	if envType != model.EnvironmentTypeProd {
+		requireRunApproval = false
+	}
 
 	updateQuery := bson.D{}
 	updateQuery = append(updateQuery, bson.E{
@@ -135,6 +170,24 @@ func (e *EnvironmentService) UpdateEnvironment(ctx context.Context, projectID st
 			},
 		})
 	}
+	if request.RequireRunApproval != nil || request.Type != nil {
+		updateQuery = append(updateQuery, bson.E{
+			Key: "$set", Value: bson.D{
+				{"require_run_approval", requireRunApproval},
+			},
+		})
+	}
+	if request.BlackoutWindows != nil {
+		blackoutWindows, err := getBlackoutWindows(request.BlackoutWindows)
+		if err != nil {
+			return "couldn't update environment", err
+		}
+		updateQuery = append(updateQuery, bson.E{
+			Key: "$set", Value: bson.D{
+				{"blackout_windows", blackoutWindows},
+			},
+		})
+	}
 
 	err = e.EnvironmentOperator.UpdateEnvironment(context.TODO(), query, updateQuery)
 	if err != nil {
@@ -185,19 +238,23 @@ func (e *EnvironmentService) GetEnvironment(projectID string, environmentID stri
 		return &model.Environment{}, err
 	}
 
+	windows, activeWindow := getModelBlackoutWindows(env.BlackoutWindows)
 	return &model.Environment{
-		EnvironmentID: env.EnvironmentID,
-		ProjectID:     env.ProjectID,
-		Name:          env.Name,
-		Description:   &env.Description,
-		Tags:          env.Tags,
-		Type:          model.EnvironmentType(env.Type),
-		CreatedAt:     strconv.FormatInt(env.CreatedAt, 10),
-		UpdatedAt:     strconv.FormatInt(env.UpdatedAt, 10),
-		CreatedBy:     &model.UserDetails{Username: env.CreatedBy.Username},
-		UpdatedBy:     &model.UserDetails{Username: env.UpdatedBy.Username},
-		InfraIDs:      env.InfraIDs,
-		IsRemoved:     &env.IsRemoved,
+		EnvironmentID:        env.EnvironmentID,
+		ProjectID:            env.ProjectID,
+		Name:                 env.Name,
+		Description:          &env.Description,
+		Tags:                 env.Tags,
+		Type:                 model.EnvironmentType(env.Type),
+		CreatedAt:            strconv.FormatInt(env.CreatedAt, 10),
+		UpdatedAt:            strconv.FormatInt(env.UpdatedAt, 10),
+		CreatedBy:            &model.UserDetails{Username: env.CreatedBy.Username},
+		UpdatedBy:            &model.UserDetails{Username: env.UpdatedBy.Username},
+		InfraIDs:             env.InfraIDs,
+		IsRemoved:            &env.IsRemoved,
+		BlackoutWindows:      windows,
+		ActiveBlackoutWindow: activeWindow,
+		RequireRunApproval:   env.RequireRunApproval,
 	}, nil
 
 }
@@ -376,19 +433,23 @@ func (e *EnvironmentService) ListEnvironments(projectID string, request *model.L
 	}
 
 	for _, env := range aggregatedEnvironments[0].Environments {
+		windows, activeWindow := getModelBlackoutWindows(env.BlackoutWindows)
 		envs = append(envs, &model.Environment{
-			EnvironmentID: env.EnvironmentID,
-			ProjectID:     env.ProjectID,
-			Name:          env.Name,
-			Description:   &env.Description,
-			Tags:          env.Tags,
-			Type:          model.EnvironmentType(env.Type),
-			CreatedAt:     strconv.FormatInt(env.CreatedAt, 10),
-			UpdatedAt:     strconv.FormatInt(env.UpdatedAt, 10),
-			CreatedBy:     &model.UserDetails{Username: env.CreatedBy.Username},
-			UpdatedBy:     &model.UserDetails{Username: env.UpdatedBy.Username},
-			InfraIDs:      env.InfraIDs,
-			IsRemoved:     &env.IsRemoved,
+			EnvironmentID:        env.EnvironmentID,
+			ProjectID:            env.ProjectID,
+			Name:                 env.Name,
+			Description:          &env.Description,
+			Tags:                 env.Tags,
+			Type:                 model.EnvironmentType(env.Type),
+			CreatedAt:            strconv.FormatInt(env.CreatedAt, 10),
+			UpdatedAt:            strconv.FormatInt(env.UpdatedAt, 10),
+			CreatedBy:            &model.UserDetails{Username: env.CreatedBy.Username},
+			UpdatedBy:            &model.UserDetails{Username: env.UpdatedBy.Username},
+			InfraIDs:             env.InfraIDs,
+			IsRemoved:            &env.IsRemoved,
+			BlackoutWindows:      windows,
+			ActiveBlackoutWindow: activeWindow,
+			RequireRunApproval:   env.RequireRunApproval,
 		})
 	}
 
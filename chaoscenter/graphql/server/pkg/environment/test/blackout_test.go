@@ -0,0 +1,79 @@
+package test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/environments"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+)
+
+func TestIsBlackoutWindowActive(t *testing.T) {
+	start := time.Date(2024, time.March, 4, 22, 0, 0, 0, time.UTC)
+	end := start.Add(4 * time.Hour)
+
+	testCases := []struct {
+		name       string
+		recurrence model.BlackoutWindowRecurrence
+		at         time.Time
+		expected   bool
+	}{
+		{
+			name:       "one-off window is active between its start and end time",
+			recurrence: model.BlackoutWindowRecurrenceNone,
+			at:         start.Add(time.Hour),
+			expected:   true,
+		},
+		{
+			name:       "one-off window is not active before its start time",
+			recurrence: model.BlackoutWindowRecurrenceNone,
+			at:         start.Add(-time.Minute),
+			expected:   false,
+		},
+		{
+			name:       "one-off window is not active after its end time",
+			recurrence: model.BlackoutWindowRecurrenceNone,
+			at:         end.AddDate(0, 0, 1),
+			expected:   false,
+		},
+		{
+			name:       "daily window is active during a later occurrence",
+			recurrence: model.BlackoutWindowRecurrenceDaily,
+			at:         start.AddDate(0, 0, 3).Add(3 * time.Hour),
+			expected:   true,
+		},
+		{
+			name:       "daily window is not active between its occurrences",
+			recurrence: model.BlackoutWindowRecurrenceDaily,
+			at:         end.AddDate(0, 0, 3).Add(time.Hour),
+			expected:   false,
+		},
+		{
+			name:       "weekly window is active during a later occurrence",
+			recurrence: model.BlackoutWindowRecurrenceWeekly,
+			at:         start.AddDate(0, 0, 14).Add(time.Hour),
+			expected:   true,
+		},
+		{
+			name:       "weekly window is not active on the other days of the week",
+			recurrence: model.BlackoutWindowRecurrenceWeekly,
+			at:         start.AddDate(0, 0, 15).Add(time.Hour),
+			expected:   false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			window := environments.BlackoutWindow{
+				Name:       "release-freeze",
+				StartTime:  start.UnixMilli(),
+				EndTime:    end.UnixMilli(),
+				Recurrence: tc.recurrence,
+			}
+			if got := handler.IsBlackoutWindowActive(window, tc.at); got != tc.expected {
+				t.Errorf("IsBlackoutWindowActive() = %v, expected %v", got, tc.expected)
+			}
+		})
+	}
+}
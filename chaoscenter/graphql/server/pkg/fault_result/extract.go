@@ -0,0 +1,137 @@
+package fault_result
+
+import (
+	"sort"
+	"strconv"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	types "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbFaultResult "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/fault_result"
+)
+
+const chaosEngineNodeType = "ChaosEngine"
+
+// ExtractFaultResults returns the result of every chaos engine node of the execution data of the completed
+// run, the nodes without the data of the chaos exporter are skipped as their fault is unknown
+func ExtractFaultResults(run dbChaosExperimentRun.ChaosExperimentRun, executionData types.ExecutionData, completedAt int64) []dbFaultResult.FaultResult {
+	var faultResults []dbFaultResult.FaultResult
+	for _, node := range executionData.Nodes {
+		if node.Type != chaosEngineNodeType || node.ChaosExp == nil {
+			continue
+		}
+
+		faultResult := dbFaultResult.FaultResult{
+			ProjectID:       run.ProjectID,
+			ExperimentID:    run.ExperimentID,
+			ExperimentName:  run.ExperimentName,
+			ExperimentRunID: run.ExperimentRunID,
+			InfraID:         run.InfraID,
+			FaultName:       node.ChaosExp.ExperimentName,
+			StepName:        node.Name,
+			Namespace:       node.ChaosExp.Namespace,
+			Targets:         []dbFaultResult.FaultTarget{},
+			Verdict:         getFaultVerdict(node.ChaosExp.ExperimentVerdict),
+			Probes:          []dbFaultResult.ProbeResult{},
+			StartedAt:       parseTimestamp(node.StartedAt),
+			FinishedAt:      parseTimestamp(node.FinishedAt),
+			CreatedAt:       completedAt,
+		}
+		if faultResult.FaultName == "" {
+			faultResult.FaultName = node.Name
+		}
+		if score, err := strconv.ParseFloat(node.ChaosExp.ProbeSuccessPercentage, 64); err == nil {
+			faultResult.ProbeSuccessPercentage = &score
+		}
+
+		if result := node.ChaosExp.ChaosResult; result != nil {
+			if result.Status.History != nil {
+				for _, target := range result.Status.History.Targets {
+					if target.Name == "" {
+						continue
+					}
+					faultResult.Targets = append(faultResult.Targets, dbFaultResult.FaultTarget{
+						Name: target.Name,
+						Kind: target.Kind,
+					})
+				}
+			}
+			for _, probe := range result.Status.ProbeStatuses {
+				faultResult.Probes = append(faultResult.Probes, dbFaultResult.ProbeResult{
+					Name:        probe.Name,
+					Type:        probe.Type,
+					Mode:        probe.Mode,
+					Verdict:     getProbeVerdict(string(probe.Status.Verdict)),
+					Description: probe.Status.Description,
+				})
+			}
+		}
+		if faultResult.Verdict == model.FaultVerdictFail {
+			faultResult.FailureReason = getFailureReason(node)
+		}
+
+		faultResults = append(faultResults, faultResult)
+	}
+
+	// the nodes are stored in a map, the results are sorted to keep them in the order of execution
+	sort.SliceStable(faultResults, func(i, j int) bool {
+		if faultResults[i].StartedAt != faultResults[j].StartedAt {
+			return faultResults[i].StartedAt < faultResults[j].StartedAt
+		}
+		return faultResults[i].StepName < faultResults[j].StepName
+	})
+
+	return faultResults
+}
+
+// getFaultVerdict converts the verdict of the chaos exporter to the verdict of the fault
+func getFaultVerdict(verdict string) model.FaultVerdict {
+	switch verdict {
+	case "Pass":
+		return model.FaultVerdictPass
+	case "Fail":
+		return model.FaultVerdictFail
+	case "Stopped":
+		return model.FaultVerdictStopped
+	case "Awaited":
+		return model.FaultVerdictAwaited
+	default:
+		return model.FaultVerdictNa
+	}
+}
+
+// getProbeVerdict converts the verdict of a probe of the chaos result to the verdict of the probe
+func getProbeVerdict(verdict string) model.ProbeVerdict {
+	switch verdict {
+	case "Passed":
+		return model.ProbeVerdictPassed
+	case "Failed":
+		return model.ProbeVerdictFailed
+	case "Awaited":
+		return model.ProbeVerdictAwaited
+	default:
+		return model.ProbeVerdictNa
+	}
+}
+
+// getFailureReason returns the error of the chaos result, or the failed step reported by the chaos exporter
+// or the message of the node if the chaos result has no error
+func getFailureReason(node types.Node) string {
+	if result := node.ChaosExp.ChaosResult; result != nil && result.Status.ExperimentStatus.ErrorOutput != nil &&
+		result.Status.ExperimentStatus.ErrorOutput.Reason != "" {
+		return result.Status.ExperimentStatus.ErrorOutput.Reason
+	}
+	if node.ChaosExp.FailStep != "" {
+		return node.ChaosExp.FailStep
+	}
+	return node.Message
+}
+
+// parseTimestamp returns the unix timestamp of the node, zero if the node has no valid timestamp
+func parseTimestamp(timestamp string) int64 {
+	value, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil || value < 0 {
+		return 0
+	}
+	return value
+}
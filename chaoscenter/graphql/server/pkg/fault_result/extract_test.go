@@ -0,0 +1,137 @@
+package fault_result
+
+import (
+	"testing"
+
+	chaosTypes "github.com/litmuschaos/chaos-operator/api/litmuschaos/v1alpha1"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	types "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+)
+
+func TestExtractFaultResults(t *testing.T) {
+	run := dbChaosExperimentRun.ChaosExperimentRun{
+		ProjectID:       "project",
+		ExperimentID:    "experiment",
+		ExperimentName:  "checkout",
+		ExperimentRunID: "run",
+		InfraID:         "infra",
+	}
+	executionData := types.ExecutionData{
+		Nodes: map[string]types.Node{
+			"install": {
+				Name: "install-chaos-faults",
+				Type: "Pod",
+			},
+			"latency": {
+				Name:       "pod-network-latency-ab1",
+				Type:       "ChaosEngine",
+				StartedAt:  "1700000100",
+				FinishedAt: "1700000200",
+				Message:    "child failed",
+				ChaosExp: &types.ChaosData{
+					Namespace:              "litmus",
+					ExperimentName:         "pod-network-latency",
+					ExperimentVerdict:      "Fail",
+					ProbeSuccessPercentage: "50",
+					FailStep:               "ChaosInject",
+					ChaosResult: &chaosTypes.ChaosResult{
+						Status: chaosTypes.ChaosResultStatus{
+							ExperimentStatus: chaosTypes.TestStatus{
+								ErrorOutput: &chaosTypes.ErrorOutput{Reason: "[probe]: http probe failed"},
+							},
+							ProbeStatuses: []chaosTypes.ProbeStatuses{
+								{Name: "http-check", Type: "httpProbe", Mode: "Continuous", Status: chaosTypes.ProbeStatus{Verdict: chaosTypes.ProbeVerdictFailed, Description: "timeout"}},
+								{Name: "cmd-check", Type: "cmdProbe", Mode: "EOT", Status: chaosTypes.ProbeStatus{Verdict: chaosTypes.ProbeVerdictNA}},
+							},
+							History: &chaosTypes.HistoryDetails{
+								Targets: []chaosTypes.TargetDetails{{Name: "cart", Kind: "deployment", ChaosStatus: "targeted"}},
+							},
+						},
+					},
+				},
+			},
+			"delete": {
+				Name:      "pod-delete-xy2",
+				Type:      "ChaosEngine",
+				StartedAt: "1700000000",
+				ChaosExp: &types.ChaosData{
+					ExperimentName:    "pod-delete",
+					ExperimentVerdict: "Pass",
+				},
+			},
+			"unknown": {
+				Name: "pod-cpu-hog-cd3",
+				Type: "ChaosEngine",
+			},
+		},
+	}
+
+	results := ExtractFaultResults(run, executionData, 1700000300000)
+	if len(results) != 2 {
+		t.Fatalf("ExtractFaultResults() returned %d results, want 2", len(results))
+	}
+
+	deleteResult, latencyResult := results[0], results[1]
+	if deleteResult.FaultName != "pod-delete" || deleteResult.Verdict != model.FaultVerdictPass {
+		t.Errorf("first result = %s with verdict %s, want pod-delete with verdict PASS", deleteResult.FaultName, deleteResult.Verdict)
+	}
+	if deleteResult.FailureReason != "" || deleteResult.ProbeSuccessPercentage != nil || len(deleteResult.Targets) != 0 {
+		t.Errorf("passed result without chaos result = %+v, want no failure reason, probe score or targets", deleteResult)
+	}
+
+	if latencyResult.ProjectID != "project" || latencyResult.ExperimentRunID != "run" || latencyResult.CreatedAt != 1700000300000 {
+		t.Errorf("result is not linked to the run: %+v", latencyResult)
+	}
+	if latencyResult.FaultName != "pod-network-latency" || latencyResult.StepName != "pod-network-latency-ab1" {
+		t.Errorf("fault = %s, step = %s", latencyResult.FaultName, latencyResult.StepName)
+	}
+	if latencyResult.Verdict != model.FaultVerdictFail || latencyResult.FailureReason != "[probe]: http probe failed" {
+		t.Errorf("verdict = %s, failure reason = %q", latencyResult.Verdict, latencyResult.FailureReason)
+	}
+	if latencyResult.StartedAt != 1700000100 || latencyResult.FinishedAt != 1700000200 {
+		t.Errorf("started at = %d, finished at = %d", latencyResult.StartedAt, latencyResult.FinishedAt)
+	}
+	if latencyResult.ProbeSuccessPercentage == nil || *latencyResult.ProbeSuccessPercentage != 50 {
+		t.Errorf("probe success percentage = %v, want 50", latencyResult.ProbeSuccessPercentage)
+	}
+	if len(latencyResult.Targets) != 1 || latencyResult.Targets[0].Name != "cart" || latencyResult.Targets[0].Kind != "deployment" {
+		t.Errorf("targets = %+v, want the cart deployment", latencyResult.Targets)
+	}
+	if len(latencyResult.Probes) != 2 || latencyResult.Probes[0].Verdict != model.ProbeVerdictFailed || latencyResult.Probes[1].Verdict != model.ProbeVerdictNa {
+		t.Errorf("probes = %+v, want a failed and a N/A probe", latencyResult.Probes)
+	}
+}
+
+func TestGetFailureReason(t *testing.T) {
+	tests := []struct {
+		name string
+		node types.Node
+		want string
+	}{
+		{
+			name: "error of the chaos result",
+			node: types.Node{Message: "failed", ChaosExp: &types.ChaosData{FailStep: "PreChaosCheck", ChaosResult: &chaosTypes.ChaosResult{
+				Status: chaosTypes.ChaosResultStatus{ExperimentStatus: chaosTypes.TestStatus{ErrorOutput: &chaosTypes.ErrorOutput{Reason: "target not found"}}},
+			}}},
+			want: "target not found",
+		},
+		{
+			name: "failed step without chaos result",
+			node: types.Node{Message: "failed", ChaosExp: &types.ChaosData{FailStep: "PreChaosCheck"}},
+			want: "PreChaosCheck",
+		},
+		{
+			name: "message of the node",
+			node: types.Node{Message: "failed", ChaosExp: &types.ChaosData{}},
+			want: "failed",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := getFailureReason(tc.node); got != tc.want {
+				t.Errorf("getFailureReason() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,332 @@
+package fault_result
+
+import (
+	"context"
+	"errors"
+	"strconv"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbFaultResult "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/fault_result"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultPageLimit      = 15
+	defaultAnalyticsLimit = 20
+	maxAnalyticsLimit     = 100
+)
+
+// Service is the interface for the fault result service
+type Service interface {
+	ListFaultResults(ctx context.Context, projectID string, request *model.ListFaultResultsRequest) (*model.ListFaultResultsResponse, error)
+	GetFaultAnalytics(ctx context.Context, projectID string, request model.FaultAnalyticsRequest) (*model.FaultAnalyticsResponse, error)
+}
+
+// faultResultService is the implementation of the fault result service
+type faultResultService struct {
+	faultResultOperator *dbFaultResult.Operator
+}
+
+// NewFaultResultService returns a new instance of the fault result service
+func NewFaultResultService(faultResultOperator *dbFaultResult.Operator) Service {
+	return &faultResultService{
+		faultResultOperator: faultResultOperator,
+	}
+}
+
+// ListFaultResults returns a page of the fault results of the project matching the filter, the latest result first
+func (f *faultResultService) ListFaultResults(ctx context.Context, projectID string, request *model.ListFaultResultsRequest) (*model.ListFaultResultsResponse, error) {
+	var (
+		filter     *model.FaultResultFilterInput
+		pagination *model.Pagination
+	)
+	if request != nil {
+		filter, pagination = request.Filter, request.Pagination
+	}
+
+	query, err := getFaultResultsQuery(projectID, filter)
+	if err != nil {
+		return nil, err
+	}
+	total, err := f.faultResultOperator.CountFaultResults(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+
+	skip, limit := 0, defaultPageLimit
+	if pagination != nil {
+		if pagination.Page < 0 || pagination.Limit <= 0 {
+			return nil, errors.New("page should be positive and limit should be greater than zero")
+		}
+		skip, limit = pagination.Page*pagination.Limit, pagination.Limit
+	}
+	cursor, err := f.faultResultOperator.GetAggregateFaultResults(ctx, mongo.Pipeline{
+		{{"$match", query}},
+		{{"$sort", bson.D{{"created_at", -1}, {"step_name", 1}}}},
+		{{"$skip", skip}},
+		{{"$limit", limit}},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var faultResults []dbFaultResult.FaultResult
+	if err = cursor.All(ctx, &faultResults); err != nil {
+		return nil, err
+	}
+
+	response := &model.ListFaultResultsResponse{
+		TotalNoOfFaultResults: int(total),
+		FaultResults:          []*model.FaultResult{},
+	}
+	for _, faultResult := range faultResults {
+		response.FaultResults = append(response.FaultResults, toFaultResultModel(faultResult))
+	}
+	return response, nil
+}
+
+// GetFaultAnalytics returns the pass/fail counts of the fault results of the project matching the filter
+// grouped as requested, the group with the most failures first
+func (f *faultResultService) GetFaultAnalytics(ctx context.Context, projectID string, request model.FaultAnalyticsRequest) (*model.FaultAnalyticsResponse, error) {
+	if !request.GroupBy.IsValid() {
+		return nil, errors.New("invalid group by " + request.GroupBy.String())
+	}
+	limit := defaultAnalyticsLimit
+	if request.Limit != nil {
+		if *request.Limit <= 0 || *request.Limit > maxAnalyticsLimit {
+			return nil, errors.New("limit should be between 1 and " + strconv.Itoa(maxAnalyticsLimit))
+		}
+		limit = *request.Limit
+	}
+
+	query, err := getFaultResultsQuery(projectID, request.Filter)
+	if err != nil {
+		return nil, err
+	}
+	cursor, err := f.faultResultOperator.GetAggregateFaultResults(ctx, getFaultAnalyticsPipeline(query, request.GroupBy, request.Filter, limit))
+	if err != nil {
+		return nil, err
+	}
+
+	var analytics []dbFaultResult.FaultAnalytics
+	if err = cursor.All(ctx, &analytics); err != nil {
+		return nil, err
+	}
+
+	response := &model.FaultAnalyticsResponse{
+		GroupBy:   request.GroupBy,
+		Analytics: []*model.FaultAnalytics{},
+	}
+	for _, group := range analytics {
+		response.Analytics = append(response.Analytics, toFaultAnalyticsModel(group, request.GroupBy))
+	}
+	return response, nil
+}
+
+// getFaultResultsQuery returns the query matching the fault results of the project with the filter
+func getFaultResultsQuery(projectID string, filter *model.FaultResultFilterInput) (bson.D, error) {
+	query := bson.D{{"project_id", projectID}}
+	if filter == nil {
+		return query, nil
+	}
+
+	if len(filter.ExperimentIDs) > 0 {
+		query = append(query, bson.E{Key: "experiment_id", Value: bson.D{{"$in", filter.ExperimentIDs}}})
+	}
+	if len(filter.InfraIDs) > 0 {
+		query = append(query, bson.E{Key: "infra_id", Value: bson.D{{"$in", filter.InfraIDs}}})
+	}
+	if len(filter.FaultNames) > 0 {
+		query = append(query, bson.E{Key: "fault_name", Value: bson.D{{"$in", filter.FaultNames}}})
+	}
+	if len(filter.Targets) > 0 {
+		query = append(query, bson.E{Key: "targets.name", Value: bson.D{{"$in", filter.Targets}}})
+	}
+	if len(filter.Verdicts) > 0 {
+		query = append(query, bson.E{Key: "verdict", Value: bson.D{{"$in", filter.Verdicts}}})
+	}
+	if filter.DateRange != nil {
+		startDate, err := strconv.ParseInt(filter.DateRange.StartDate, 10, 64)
+		if err != nil {
+			return nil, errors.New("invalid start date, it should be in unix milliseconds")
+		}
+		createdAt := bson.D{{"$gte", startDate}}
+		if filter.DateRange.EndDate != nil {
+			endDate, err := strconv.ParseInt(*filter.DateRange.EndDate, 10, 64)
+			if err != nil {
+				return nil, errors.New("invalid end date, it should be in unix milliseconds")
+			}
+			if endDate < startDate {
+				return nil, errors.New("end date should be after the start date")
+			}
+			createdAt = append(createdAt, bson.E{Key: "$lte", Value: endDate})
+		}
+		query = append(query, bson.E{Key: "created_at", Value: createdAt})
+	}
+
+	return query, nil
+}
+
+// getFaultAnalyticsPipeline returns the pipeline grouping the fault results matching the query, the results
+// are unwound by target when they are grouped by target so that a result is counted once for each of its targets
+func getFaultAnalyticsPipeline(query bson.D, groupBy model.FaultAnalyticsGroupBy, filter *model.FaultResultFilterInput, limit int) mongo.Pipeline {
+	pipeline := mongo.Pipeline{
+		{{"$match", query}},
+	}
+
+	var groupID bson.D
+	switch groupBy {
+	case model.FaultAnalyticsGroupByTarget, model.FaultAnalyticsGroupByFaultAndTarget:
+		pipeline = append(pipeline, bson.D{{"$unwind", "$targets"}})
+		// only the targets of the filter are kept from the results which targeted several resources
+		if filter != nil && len(filter.Targets) > 0 {
+			pipeline = append(pipeline, bson.D{{"$match", bson.D{{"targets.name", bson.D{{"$in", filter.Targets}}}}}})
+		}
+		groupID = bson.D{{"target", "$targets.name"}}
+		if groupBy == model.FaultAnalyticsGroupByFaultAndTarget {
+			groupID = append(bson.D{{"fault_name", "$fault_name"}}, groupID...)
+		}
+	case model.FaultAnalyticsGroupByExperiment:
+		groupID = bson.D{{"experiment_id", "$experiment_id"}}
+	default:
+		groupID = bson.D{{"fault_name", "$fault_name"}}
+	}
+
+	isFailed := bson.D{{"$eq", bson.A{"$verdict", model.FaultVerdictFail}}}
+	return append(pipeline,
+		bson.D{{"$group", bson.D{
+			{"_id", groupID},
+			{"experiment_name", bson.D{{"$last", "$experiment_name"}}},
+			{"total_runs", bson.D{{"$sum", 1}}},
+			{"passed", bson.D{{"$sum", bson.D{{"$cond", bson.A{
+				bson.D{{"$eq", bson.A{"$verdict", model.FaultVerdictPass}}}, 1, 0,
+			}}}}}},
+			{"failed", bson.D{{"$sum", bson.D{{"$cond", bson.A{isFailed, 1, 0}}}}}},
+			{"stopped", bson.D{{"$sum", bson.D{{"$cond", bson.A{
+				bson.D{{"$eq", bson.A{"$verdict", model.FaultVerdictStopped}}}, 1, 0,
+			}}}}}},
+			{"average_probe_success_percentage", bson.D{{"$avg", "$probe_success_percentage"}}},
+			// documents are compared field by field, hence the latest failure is the one with the greatest created_at
+			{"last_failure", bson.D{{"$max", bson.D{{"$cond", bson.A{
+				isFailed,
+				bson.D{{"created_at", "$created_at"}, {"reason", "$failure_reason"}},
+				nil,
+			}}}}}},
+		}}},
+		bson.D{{"$project", bson.D{
+			{"_id", 1},
+			{"fault_name", "$_id.fault_name"},
+			{"target", "$_id.target"},
+			{"experiment_id", "$_id.experiment_id"},
+			{"experiment_name", 1},
+			{"total_runs", 1},
+			{"passed", 1},
+			{"failed", 1},
+			{"stopped", 1},
+			{"average_probe_success_percentage", 1},
+			{"last_failed_at", "$last_failure.created_at"},
+			{"last_failure_reason", "$last_failure.reason"},
+		}}},
+		bson.D{{"$sort", bson.D{{"failed", -1}, {"total_runs", -1}, {"_id", 1}}}},
+		bson.D{{"$limit", limit}},
+	)
+}
+
+// toFaultResultModel converts the fault result of the database to the GraphQL model
+func toFaultResultModel(faultResult dbFaultResult.FaultResult) *model.FaultResult {
+	result := &model.FaultResult{
+		ProjectID:              faultResult.ProjectID,
+		ExperimentID:           faultResult.ExperimentID,
+		ExperimentName:         faultResult.ExperimentName,
+		ExperimentRunID:        faultResult.ExperimentRunID,
+		InfraID:                faultResult.InfraID,
+		FaultName:              faultResult.FaultName,
+		StepName:               faultResult.StepName,
+		Targets:                []*model.FaultTarget{},
+		Verdict:                faultResult.Verdict,
+		ProbeSuccessPercentage: faultResult.ProbeSuccessPercentage,
+		Probes:                 []*model.FaultProbeResult{},
+		CreatedAt:              strconv.FormatInt(faultResult.CreatedAt, 10),
+	}
+	if faultResult.Namespace != "" {
+		result.Namespace = &faultResult.Namespace
+	}
+	if faultResult.FailureReason != "" {
+		result.FailureReason = &faultResult.FailureReason
+	}
+	if faultResult.StartedAt > 0 {
+		startedAt := strconv.FormatInt(faultResult.StartedAt, 10)
+		result.StartedAt = &startedAt
+	}
+	if faultResult.FinishedAt > 0 {
+		finishedAt := strconv.FormatInt(faultResult.FinishedAt, 10)
+		result.FinishedAt = &finishedAt
+	}
+	for _, target := range faultResult.Targets {
+		faultTarget := &model.FaultTarget{Name: target.Name}
+		if target.Kind != "" {
+			kind := target.Kind
+			faultTarget.Kind = &kind
+		}
+		result.Targets = append(result.Targets, faultTarget)
+	}
+	for _, probe := range faultResult.Probes {
+		probeResult := &model.FaultProbeResult{
+			Name:    probe.Name,
+			Verdict: probe.Verdict,
+		}
+		if probe.Type != "" {
+			probeType := probe.Type
+			probeResult.Type = &probeType
+		}
+		if probe.Mode != "" {
+			mode := probe.Mode
+			probeResult.Mode = &mode
+		}
+		if probe.Description != "" {
+			description := probe.Description
+			probeResult.Description = &description
+		}
+		result.Probes = append(result.Probes, probeResult)
+	}
+	return result
+}
+
+// toFaultAnalyticsModel converts the group of fault results to the GraphQL model, only the fields of the group by are set
+func toFaultAnalyticsModel(group dbFaultResult.FaultAnalytics, groupBy model.FaultAnalyticsGroupBy) *model.FaultAnalytics {
+	analytics := &model.FaultAnalytics{
+		TotalRuns: group.TotalRuns,
+		Passed:    group.Passed,
+		Failed:    group.Failed,
+		Stopped:   group.Stopped,
+	}
+	switch groupBy {
+	case model.FaultAnalyticsGroupByTarget:
+		analytics.Target = &group.Target
+	case model.FaultAnalyticsGroupByFaultAndTarget:
+		analytics.FaultName = &group.FaultName
+		analytics.Target = &group.Target
+	case model.FaultAnalyticsGroupByExperiment:
+		analytics.ExperimentID = &group.ExperimentID
+		analytics.ExperimentName = &group.ExperimentName
+	default:
+		analytics.FaultName = &group.FaultName
+	}
+	if group.TotalRuns > 0 {
+		analytics.FailureRate = utils.Truncate(float64(group.Failed) / float64(group.TotalRuns))
+	}
+	if group.AverageProbeSuccessPercentage != nil {
+		averageProbeSuccessPercentage := utils.Truncate(*group.AverageProbeSuccessPercentage)
+		analytics.AverageProbeSuccessPercentage = &averageProbeSuccessPercentage
+	}
+	if group.LastFailedAt > 0 {
+		lastFailedAt := strconv.FormatInt(group.LastFailedAt, 10)
+		analytics.LastFailedAt = &lastFailedAt
+	}
+	if group.LastFailureReason != "" {
+		analytics.LastFailureReason = &group.LastFailureReason
+	}
+	return analytics
+}
@@ -0,0 +1,165 @@
+package fault_result
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbFaultResult "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/fault_result"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+func TestGetFaultResultsQuery(t *testing.T) {
+	endDate := "1700000000000"
+	invalidDate := "yesterday"
+	beforeStartDate := "1600000000000"
+
+	tests := []struct {
+		name     string
+		filter   *model.FaultResultFilterInput
+		wantKeys []string
+		wantErr  bool
+	}{
+		{
+			name:     "without filter",
+			wantKeys: []string{"project_id"},
+		},
+		{
+			name: "all the filters",
+			filter: &model.FaultResultFilterInput{
+				ExperimentIDs: []string{"experiment"},
+				InfraIDs:      []string{"infra"},
+				FaultNames:    []string{"pod-network-latency"},
+				Targets:       []string{"cart"},
+				Verdicts:      []model.FaultVerdict{model.FaultVerdictFail},
+				DateRange:     &model.DateRange{StartDate: "1690000000000", EndDate: &endDate},
+			},
+			wantKeys: []string{"project_id", "experiment_id", "infra_id", "fault_name", "targets.name", "verdict", "created_at"},
+		},
+		{
+			name:    "invalid start date",
+			filter:  &model.FaultResultFilterInput{DateRange: &model.DateRange{StartDate: invalidDate}},
+			wantErr: true,
+		},
+		{
+			name:    "end date before the start date",
+			filter:  &model.FaultResultFilterInput{DateRange: &model.DateRange{StartDate: "1690000000000", EndDate: &beforeStartDate}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			query, err := getFaultResultsQuery("project", tc.filter)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("getFaultResultsQuery() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(query) != len(tc.wantKeys) {
+				t.Fatalf("getFaultResultsQuery() = %v, want the keys %v", query, tc.wantKeys)
+			}
+			for i, key := range tc.wantKeys {
+				if query[i].Key != key {
+					t.Errorf("key %d = %s, want %s", i, query[i].Key, key)
+				}
+			}
+		})
+	}
+}
+
+func TestGetFaultAnalyticsPipeline(t *testing.T) {
+	query := bson.D{{"project_id", "project"}}
+	filter := &model.FaultResultFilterInput{Targets: []string{"cart"}}
+
+	tests := []struct {
+		name       string
+		groupBy    model.FaultAnalyticsGroupBy
+		filter     *model.FaultResultFilterInput
+		wantStages []string
+		wantGroup  []string
+	}{
+		{
+			name:       "group by fault",
+			groupBy:    model.FaultAnalyticsGroupByFault,
+			wantStages: []string{"$match", "$group", "$project", "$sort", "$limit"},
+			wantGroup:  []string{"fault_name"},
+		},
+		{
+			name:       "group by target",
+			groupBy:    model.FaultAnalyticsGroupByTarget,
+			wantStages: []string{"$match", "$unwind", "$group", "$project", "$sort", "$limit"},
+			wantGroup:  []string{"target"},
+		},
+		{
+			name:       "group by fault and filtered target",
+			groupBy:    model.FaultAnalyticsGroupByFaultAndTarget,
+			filter:     filter,
+			wantStages: []string{"$match", "$unwind", "$match", "$group", "$project", "$sort", "$limit"},
+			wantGroup:  []string{"fault_name", "target"},
+		},
+		{
+			name:       "group by experiment",
+			groupBy:    model.FaultAnalyticsGroupByExperiment,
+			filter:     filter,
+			wantStages: []string{"$match", "$group", "$project", "$sort", "$limit"},
+			wantGroup:  []string{"experiment_id"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			pipeline := getFaultAnalyticsPipeline(query, tc.groupBy, tc.filter, 20)
+			if len(pipeline) != len(tc.wantStages) {
+				t.Fatalf("getFaultAnalyticsPipeline() has %d stages, want %v", len(pipeline), tc.wantStages)
+			}
+			for i, stage := range tc.wantStages {
+				if pipeline[i][0].Key != stage {
+					t.Errorf("stage %d = %s, want %s", i, pipeline[i][0].Key, stage)
+				}
+			}
+
+			group := pipeline[len(pipeline)-4][0].Value.(bson.D)
+			groupID := group[0].Value.(bson.D)
+			if len(groupID) != len(tc.wantGroup) {
+				t.Fatalf("group id = %v, want the fields %v", groupID, tc.wantGroup)
+			}
+			for i, field := range tc.wantGroup {
+				if groupID[i].Key != field {
+					t.Errorf("group id field %d = %s, want %s", i, groupID[i].Key, field)
+				}
+			}
+		})
+	}
+}
+
+func TestToFaultAnalyticsModel(t *testing.T) {
+	probeSuccess := 66.666
+	group := dbFaultResult.FaultAnalytics{
+		FaultName:                     "pod-network-latency",
+		Target:                        "cart",
+		TotalRuns:                     3,
+		Passed:                        1,
+		Failed:                        2,
+		AverageProbeSuccessPercentage: &probeSuccess,
+		LastFailedAt:                  1700000000000,
+		LastFailureReason:             "http probe failed",
+	}
+
+	analytics := toFaultAnalyticsModel(group, model.FaultAnalyticsGroupByFault)
+	if analytics.FaultName == nil || *analytics.FaultName != "pod-network-latency" || analytics.Target != nil {
+		t.Errorf("only the fault name should be set when grouped by fault, got %+v", analytics)
+	}
+	if analytics.FailureRate != 0.66 {
+		t.Errorf("failure rate = %v, want 0.66", analytics.FailureRate)
+	}
+	if analytics.AverageProbeSuccessPercentage == nil || *analytics.AverageProbeSuccessPercentage != 66.66 {
+		t.Errorf("average probe success percentage = %v, want 66.66", analytics.AverageProbeSuccessPercentage)
+	}
+	if analytics.LastFailedAt == nil || *analytics.LastFailedAt != "1700000000000" {
+		t.Errorf("last failed at = %v, want 1700000000000", analytics.LastFailedAt)
+	}
+
+	analytics = toFaultAnalyticsModel(dbFaultResult.FaultAnalytics{Target: "cart"}, model.FaultAnalyticsGroupByTarget)
+	if analytics.Target == nil || *analytics.Target != "cart" || analytics.FaultName != nil || analytics.FailureRate != 0 {
+		t.Errorf("empty group grouped by target = %+v", analytics)
+	}
+}
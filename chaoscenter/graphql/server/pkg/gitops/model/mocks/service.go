@@ -56,12 +56,22 @@ func (g *GitOpsService) DeleteExperimentFromGit(ctx context.Context, projectID s
 	return args.Error(0)
 }
 
-// GitOpsSyncHandler provides a mock function with given fields: singleRun
-func (g *GitOpsService) GitOpsSyncHandler(singleRun bool) {
-	g.Called(singleRun)
+// GitOpsSyncHandler provides a mock function with given fields: ctx, singleRun
+func (g *GitOpsService) GitOpsSyncHandler(ctx context.Context, singleRun bool) {
+	g.Called(ctx, singleRun)
 }
 
 func (g *GitOpsService) SyncDBToGit(ctx context.Context, config gitops.GitConfig) error {
 	args := g.Called(ctx, config)
 	return args.Error(0)
 }
+
+// SetExperimentRunner provides a mock function with given fields: runner
+func (g *GitOpsService) SetExperimentRunner(runner gitops.ExperimentRunner) {
+	g.Called(runner)
+}
+
+// SetApprovalRequester provides a mock function with given fields: requester
+func (g *GitOpsService) SetApprovalRequester(requester gitops.ApprovalRequester) {
+	g.Called(requester)
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"os"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -14,16 +13,18 @@ import (
 	"github.com/ghodss/yaml"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
 	chaosExperimentOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment/ops"
-	chaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_infrastructure"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/cloudevents"
 	dataStore "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/grpc"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
 	log "github.com/sirupsen/logrus"
 	"github.com/tidwall/gjson"
-	"github.com/tidwall/sjson"
 	"go.mongodb.org/mongo-driver/bson"
 	grpc2 "google.golang.org/grpc"
 )
@@ -46,26 +47,54 @@ type Service interface {
 	GetGitOpsDetails(ctx context.Context, projectID string) (*model.GitConfigResponse, error)
 	UpsertExperimentToGit(ctx context.Context, projectID string, experiment *model.ChaosExperimentRequest) error
 	DeleteExperimentFromGit(ctx context.Context, projectID string, experiment *model.ChaosExperimentRequest) error
-	GitOpsSyncHandler(singleRun bool)
+	GitOpsSyncHandler(ctx context.Context, singleRun bool)
 	SyncDBToGit(ctx context.Context, config GitConfig) error
+	SetExperimentRunner(runner ExperimentRunner)
+	SetApprovalRequester(requester ApprovalRequester)
+}
+
+// ExperimentRunner queues the runs of the experiments triggered from git
+type ExperimentRunner interface {
+	RunChaosWorkFlowForUser(ctx context.Context, projectID string, workflow chaos_experiment.ChaosExperimentRequest, username string, notifyID string, r *store.StateData) (*model.RunChaosExperimentResponse, error)
+}
+
+// ApprovalRequester requests the approval of the runs triggered from git if the environment of the infra requires one
+type ApprovalRequester interface {
+	RequestApproval(ctx context.Context, projectID string, experiment chaos_experiment.ChaosExperimentRequest, action model.RunApprovalAction, inputs []*model.ExperimentInputValueRequest, priority *int, username string) (*model.RunApprovalRequest, error)
 }
 
 type gitOpsService struct {
 	gitOpsOperator         *gitops.Operator
 	chaosExperimentOps     chaos_experiment.Operator
 	chaosExperimentService chaosExperimentOps.Service
+	experimentRunner       ExperimentRunner
+	approvalRequester      ApprovalRequester
+	mongodbOperator        mongodb.MongoOperator
 }
 
 // NewGitOpsService returns a new instance of a gitOpsService
-func NewGitOpsService(gitOpsOperator *gitops.Operator, chaosExperimentService chaosExperimentOps.Service, chaosExperimentOps chaos_experiment.Operator) Service {
+func NewGitOpsService(gitOpsOperator *gitops.Operator, chaosExperimentService chaosExperimentOps.Service, chaosExperimentOps chaos_experiment.Operator, mongodbOperator mongodb.MongoOperator) Service {
 	return &gitOpsService{
 		gitOpsOperator:         gitOpsOperator,
 		chaosExperimentService: chaosExperimentService,
 		chaosExperimentOps:     chaosExperimentOps,
+		mongodbOperator:        mongodbOperator,
 	}
 }
 
-// GitOpsNotificationHandler sends experiment run request(single run experiment only) to agent on GitOps notification
+// SetExperimentRunner sets the runner of the experiments triggered from git
+func (g *gitOpsService) SetExperimentRunner(runner ExperimentRunner) {
+	g.experimentRunner = runner
+}
+
+// SetApprovalRequester sets the requester of the approvals needed to run the experiments triggered from git
+func (g *gitOpsService) SetApprovalRequester(requester ApprovalRequester) {
+	g.approvalRequester = requester
+}
+
+// GitOpsNotificationHandler sends experiment run request(single run experiment only) to agent on GitOps notification,
+// the run is queued like the other runs so that the concurrency limit of the infra holds. Runs are refused during the
+// blackout windows of the infra's environment and wait for an approval if the environment requires run approvals
 func (g *gitOpsService) GitOpsNotificationHandler(ctx context.Context, infra chaos_infrastructure.ChaosInfra, experimentID string) (string, error) {
 	gitLock.Lock(infra.ProjectID, nil)
 	defer gitLock.Unlock(infra.ProjectID, nil)
@@ -89,17 +118,24 @@ func (g *gitOpsService) GitOpsNotificationHandler(ctx context.Context, infra cha
 	if strings.ToLower(resKind) == "cronexperiment" { // no op
 		return "Request Acknowledged for experimentID: " + experimentID, nil
 	}
-	experiments[0].Revision[len(experiments[0].Revision)-1].ExperimentManifest, err = sjson.Set(experiments[0].Revision[len(experiments[0].Revision)-1].ExperimentManifest, "metadata.name", experiments[0].Name+"-"+strconv.FormatInt(time.Now().UnixMilli(), 10))
-	if err != nil {
-		log.Error("Failed to updated experiment name :", err)
-		return "", errors.New("Failed to updated experiment name " + err.Error())
+	if err := envHandler.ValidateInfraBlackoutWindow(ctx, g.mongodbOperator, infra); err != nil {
+		return "", err
+	}
+	if g.experimentRunner == nil || g.approvalRequester == nil {
+		return "", errors.New("no experiment runner is set to run the experiments triggered from git")
 	}
 
-	username := "git-ops"
-	chaosInfra.SendExperimentToSubscriber(experiments[0].ProjectID, &model.ChaosExperimentRequest{
-		ExperimentManifest: experiments[0].Revision[len(experiments[0].Revision)-1].ExperimentManifest,
-		InfraID:            experiments[0].InfraID,
-	}, &username, nil, "create", store.Store)
+	// experiments triggered from git are run with the default values of their inputs
+	approval, err := g.approvalRequester.RequestApproval(ctx, infra.ProjectID, experiments[0], model.RunApprovalActionRun, nil, nil, "git-ops")
+	if err != nil {
+		return "", err
+	}
+	if approval != nil {
+		return "Approval requested for experimentID: " + experimentID + ", approval request " + approval.ApprovalID + " is pending", nil
+	}
+	if _, err := g.experimentRunner.RunChaosWorkFlowForUser(ctx, infra.ProjectID, experiments[0], "git-ops", "", store.Store); err != nil {
+		return "", err
+	}
 
 	return "Request Acknowledged for experimentID: " + experimentID, nil
 }
@@ -121,7 +157,7 @@ func (g *gitOpsService) EnableGitOpsHandler(ctx context.Context, projectID strin
 		}
 	}(conn)
 
-	_, err := grpc.GetProjectById(client, projectID)
+	_, err := grpc.GetProjectById(ctx, client, projectID)
 	if err != nil {
 		return false, errors.New("Failed to setup GitOps : " + err.Error())
 	}
@@ -386,15 +422,15 @@ func (g *gitOpsService) gitSyncHelper(config gitops.GitConfigDB, wg *sync.WaitGr
 	}
 }
 
-// GitOpsSyncHandler syncs all repos in the DB
-func (g *gitOpsService) GitOpsSyncHandler(singleRun bool) {
+// GitOpsSyncHandler syncs all repos in the DB, it returns once the context is cancelled
+func (g *gitOpsService) GitOpsSyncHandler(ctx context.Context, singleRun bool) {
 	const syncGroupSize = 10
 	const syncInterval = 2 * time.Minute
 	for {
 
-		ctx, cancel := context.WithTimeout(backgroundContext, timeout)
+		getCtx, cancel := context.WithTimeout(ctx, timeout)
 		log.Info("Running GitOps DB Sync...")
-		configs, err := g.gitOpsOperator.GetAllGitConfig(ctx)
+		configs, err := g.gitOpsOperator.GetAllGitConfig(getCtx)
 
 		cancel()
 		if err != nil {
@@ -425,30 +461,50 @@ func (g *gitOpsService) GitOpsSyncHandler(singleRun bool) {
 		if singleRun {
 			break
 		}
-		time.Sleep(syncInterval)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(syncInterval):
+		}
 	}
 }
 
-// SyncDBToGit syncs the DB with the GitRepo for the project
+// SyncDBToGit syncs the DB with the GitRepo for the project, a cloudevent is emitted if
+// the sync failed or brought in new commits of the repository
 func (g *gitOpsService) SyncDBToGit(ctx context.Context, config GitConfig) error {
+	latestCommit, err := g.syncDBToGit(ctx, config)
+	metrics.ObserveGitOpsSync(config.ProjectID, err)
+	if err != nil || latestCommit != config.LatestCommit {
+		cloudevents.Emit(cloudevents.NewGitOpsSyncEvent(cloudevents.GitOpsSyncData{
+			ProjectID:     config.ProjectID,
+			RepositoryURL: config.RepositoryURL,
+			Branch:        config.Branch,
+			LatestCommit:  latestCommit,
+		}, err))
+	}
+	return err
+}
+
+// syncDBToGit syncs the DB with the GitRepo and returns the latest commit synced to the DB
+func (g *gitOpsService) syncDBToGit(ctx context.Context, config GitConfig) (string, error) {
 	repositoryExists, err := PathExists(config.LocalPath)
 	if err != nil {
-		return fmt.Errorf("Error while checking repo exists, err: %s", err)
+		return "", fmt.Errorf("Error while checking repo exists, err: %s", err)
 	}
 	if !repositoryExists {
 		err = config.setupGitRepo(GitUserFromContext(ctx))
 	} else {
 		err = config.GitPull()
 		if err != nil {
-			return errors.New("Error syncing DB : " + err.Error())
+			return "", errors.New("Error syncing DB : " + err.Error())
 		}
 	}
 	latestCommit, files, err := config.GetChanges()
 	if err != nil {
-		return errors.New("Error Getting File Changes : " + err.Error())
+		return "", errors.New("Error Getting File Changes : " + err.Error())
 	}
 	if latestCommit == config.LatestCommit {
-		return nil
+		return latestCommit, nil
 	}
 	log.Info(latestCommit, " ", config.LatestCommit, "File Changes: ", files)
 	newExperiments := false
@@ -459,7 +515,7 @@ func (g *gitOpsService) SyncDBToGit(ctx context.Context, config GitConfig) error
 		// check if file was deleted or not
 		exists, err := PathExists(config.LocalPath + "/" + file)
 		if err != nil {
-			return errors.New("Error checking file in local repo : " + file + " | " + err.Error())
+			return "", errors.New("Error checking file in local repo : " + file + " | " + err.Error())
 		}
 		if !exists {
 			err = g.deleteExperiment(file, config)
@@ -510,11 +566,11 @@ func (g *gitOpsService) SyncDBToGit(ctx context.Context, config GitConfig) error
 	if newExperiments {
 		latestCommit, err = config.GitCommit(GitUserFromContext(ctx), "Updated New Experiments", nil)
 		if err != nil {
-			return errors.New("Cannot commit experiments to git : " + err.Error())
+			return "", errors.New("Cannot commit experiments to git : " + err.Error())
 		}
 		err = config.GitPush()
 		if err != nil {
-			return errors.New("Cannot push experiments to git : " + err.Error())
+			return "", errors.New("Cannot push experiments to git : " + err.Error())
 		}
 	}
 
@@ -530,9 +586,9 @@ func (g *gitOpsService) SyncDBToGit(ctx context.Context, config GitConfig) error
 	}
 
 	if err != nil {
-		return errors.New("Failed to update git config : " + err.Error())
+		return "", errors.New("Failed to update git config : " + err.Error())
 	}
-	return nil
+	return latestCommit, nil
 }
 
 // createExperiment helps in creating a new experiment during the SyncDBToGit operation
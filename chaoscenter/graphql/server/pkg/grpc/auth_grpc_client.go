@@ -11,6 +11,7 @@ import (
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 
 	"github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 )
 
@@ -30,7 +31,7 @@ func GetAuthGRPCSvcClient(conn *grpc.ClientConn) (protos.AuthRpcServiceClient, *
 			tlsCredential := credentials.NewTLS(conf)
 
 			// Set up a connection to the server.
-			conn, err = grpc.NewClient(utils.Config.LitmusAuthGrpcEndpoint+":"+utils.Config.LitmusAuthGrpcPort, grpc.WithTransportCredentials(tlsCredential))
+			conn, err = grpc.NewClient(utils.Config.LitmusAuthGrpcEndpoint+":"+utils.Config.LitmusAuthGrpcPort, grpc.WithTransportCredentials(tlsCredential), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 			if err != nil {
 				logrus.Fatalf("did not connect: %v", err)
 			}
@@ -38,7 +39,7 @@ func GetAuthGRPCSvcClient(conn *grpc.ClientConn) (protos.AuthRpcServiceClient, *
 			logrus.Fatalf("Failure to start chaoscenter authentication REST server due to empty TLS cert file path and TLS key path")
 		}
 	} else {
-		conn, err = grpc.Dial(utils.Config.LitmusAuthGrpcEndpoint+":"+utils.Config.LitmusAuthGrpcPort, grpc.WithBlock(), grpc.WithInsecure())
+		conn, err = grpc.Dial(utils.Config.LitmusAuthGrpcEndpoint+":"+utils.Config.LitmusAuthGrpcPort, grpc.WithBlock(), grpc.WithInsecure(), grpc.WithStatsHandler(otelgrpc.NewClientHandler()))
 		if err != nil {
 			logrus.Fatalf("did not connect: %s", err)
 		}
@@ -48,30 +49,35 @@ func GetAuthGRPCSvcClient(conn *grpc.ClientConn) (protos.AuthRpcServiceClient, *
 }
 
 // ValidatorGRPCRequest sends a request to Authentication server to ensure
-// user permission over the project
-func ValidatorGRPCRequest(client protos.AuthRpcServiceClient,
-	jwt string, projectID string, requiredRoles []string, invitation string) error {
+// user permission over the project, the custom roles of the user in the project are returned
+// when the permission is granted
+func ValidatorGRPCRequest(ctx context.Context, client protos.AuthRpcServiceClient,
+	jwt string, projectID string, requiredRoles []string, invitation string,
+	action string, environmentID string, environmentType string) ([]*protos.ProjectRole, error) {
 
-	resp, err := client.ValidateRequest(context.Background(),
+	resp, err := client.ValidateRequest(ctx,
 		&protos.ValidationRequest{
-			Jwt:           jwt,
-			ProjectId:     projectID,
-			RequiredRoles: requiredRoles,
-			Invitation:    invitation,
+			Jwt:             jwt,
+			ProjectId:       projectID,
+			RequiredRoles:   requiredRoles,
+			Invitation:      invitation,
+			Action:          action,
+			EnvironmentId:   environmentID,
+			EnvironmentType: environmentType,
 		})
 	if err != nil {
-		return err
+		return nil, err
 	}
 	if resp.Error != "" || !resp.IsValid {
-		return errors.New(resp.Error)
+		return nil, errors.New(resp.Error)
 	}
-	return nil
+	return resp.CustomRoles, nil
 }
 
 // GetProjectById returns the project details based on its uid
-func GetProjectById(client protos.AuthRpcServiceClient,
+func GetProjectById(ctx context.Context, client protos.AuthRpcServiceClient,
 	projectId string) (*protos.GetProjectByIdResponse, error) {
-	resp, err := client.GetProjectById(context.Background(), &protos.GetProjectByIdRequest{ProjectID: projectId})
+	resp, err := client.GetProjectById(ctx, &protos.GetProjectByIdRequest{ProjectID: projectId})
 	if err != nil {
 		return nil, err
 	}
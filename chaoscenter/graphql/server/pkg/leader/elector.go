@@ -0,0 +1,161 @@
+package leader
+
+import (
+	"context"
+	"os"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/config"
+	log "github.com/sirupsen/logrus"
+)
+
+const (
+	// leaseKeyPrefix prefixes the name of a job in the key of its lease among the server configs
+	leaseKeyPrefix = "leader-lease/"
+	// leaseDuration is the time after which the lease of a job expires if its holder doesn't renew it
+	leaseDuration = 30 * time.Second
+	// renewInterval is the interval at which the leases are acquired or renewed
+	renewInterval = 10 * time.Second
+)
+
+// Job is a background job which should only run on one replica of the server at a time, it should
+// return once its context is cancelled
+type Job func(ctx context.Context)
+
+type registeredJob struct {
+	name string
+	job  Job
+}
+
+// Elector runs every registered job on the replica holding the lease of the job. The leases are stored
+// in the serverConfig collection, a lease which is not renewed expires so that another replica takes
+// over the job once its leader fails
+type Elector struct {
+	holder        string
+	leaseDuration time.Duration
+	renewInterval time.Duration
+	acquireLease  func(ctx context.Context, key string, holder string, duration time.Duration) (bool, error)
+	releaseLease  func(ctx context.Context, key string, holder string) error
+	jobs          []registeredJob
+}
+
+// NewElector returns a new instance of Elector identified by the host name of the replica
+func NewElector() *Elector {
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "graphql-server"
+	}
+
+	return &Elector{
+		holder:        hostname + "-" + uuid.NewString(),
+		leaseDuration: leaseDuration,
+		renewInterval: renewInterval,
+		acquireLease:  config.AcquireLease,
+		releaseLease:  config.ReleaseLease,
+	}
+}
+
+// Register adds a singleton job to the elector, the jobs should be registered before the elector is started
+func (e *Elector) Register(name string, job Job) {
+	e.jobs = append(e.jobs, registeredJob{name: name, job: job})
+}
+
+// Start campaigns for the leases of the registered jobs until the context is cancelled, it doesn't block
+func (e *Elector) Start(ctx context.Context) {
+	for _, job := range e.jobs {
+		go e.campaign(ctx, job)
+	}
+}
+
+// campaign acquires and renews the lease of the job and runs the job while it is held. The job is stopped
+// once the lease is taken by another replica or can't be renewed before it expires
+func (e *Elector) campaign(ctx context.Context, job registeredJob) {
+	var (
+		key         = leaseKeyPrefix + job.name
+		logFields   = log.Fields{"job": job.name, "holder": e.holder}
+		leaseExpiry time.Time
+		run         *jobRun
+	)
+
+	ticker := time.NewTicker(e.renewInterval)
+	defer ticker.Stop()
+	for {
+		now := time.Now()
+		acquired, err := e.acquireLease(ctx, key, e.holder, e.leaseDuration)
+		switch {
+		case err != nil:
+			log.WithFields(logFields).Errorf("failed to acquire the lease of the job, error: %v", err)
+			if run.leading() && now.After(leaseExpiry) {
+				log.WithFields(logFields).Info("the lease of the job expired, stopping it")
+				run.stop()
+			}
+		case acquired:
+			leaseExpiry = now.Add(e.leaseDuration)
+			// a stopped job may still be running, it is only started again once it returned
+			if !run.running() {
+				log.WithFields(logFields).Info("acquired the lease of the job, starting it")
+				run = startJob(ctx, job.job)
+			}
+		case run.leading():
+			log.WithFields(logFields).Info("the lease of the job is held by another replica, stopping it")
+			run.stop()
+		}
+
+		select {
+		case <-ctx.Done():
+			run.stop()
+			if err := e.releaseLease(context.Background(), key, e.holder); err != nil {
+				log.WithFields(logFields).Errorf("failed to release the lease of the job, error: %v", err)
+			}
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// jobRun is a run of a job started once its lease was acquired
+type jobRun struct {
+	cancel  context.CancelFunc
+	stopped bool
+	done    chan struct{}
+}
+
+func startJob(ctx context.Context, job Job) *jobRun {
+	jobCtx, cancel := context.WithCancel(ctx)
+	run := &jobRun{
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+	go func() {
+		defer close(run.done)
+		job(jobCtx)
+	}()
+	return run
+}
+
+// leading returns whether the job was started and not stopped yet
+func (r *jobRun) leading() bool {
+	return r != nil && !r.stopped
+}
+
+// running returns whether the job has not returned yet
+func (r *jobRun) running() bool {
+	if r == nil {
+		return false
+	}
+	select {
+	case <-r.done:
+		return false
+	default:
+		return true
+	}
+}
+
+// stop cancels the context of the job
+func (r *jobRun) stop() {
+	if r != nil && !r.stopped {
+		r.stopped = true
+		r.cancel()
+	}
+}
@@ -0,0 +1,132 @@
+package leader
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// fakeLeases stores the leases in memory with the semantics of the leases of the server configs
+type fakeLeases struct {
+	mutex   sync.Mutex
+	holders map[string]string
+	expiry  map[string]time.Time
+	err     error
+}
+
+func newFakeLeases() *fakeLeases {
+	return &fakeLeases{
+		holders: make(map[string]string),
+		expiry:  make(map[string]time.Time),
+	}
+}
+
+func (f *fakeLeases) acquire(_ context.Context, key string, holder string, duration time.Duration) (bool, error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.err != nil {
+		return false, f.err
+	}
+	if f.holders[key] != holder && time.Now().Before(f.expiry[key]) {
+		return false, nil
+	}
+	f.holders[key] = holder
+	f.expiry[key] = time.Now().Add(duration)
+	return true, nil
+}
+
+func (f *fakeLeases) release(_ context.Context, key string, holder string) error {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	if f.holders[key] == holder {
+		f.expiry[key] = time.Time{}
+	}
+	return nil
+}
+
+func (f *fakeLeases) setErr(err error) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+	f.err = err
+}
+
+func newTestElector(holder string, leases *fakeLeases) *Elector {
+	return &Elector{
+		holder:        holder,
+		leaseDuration: 50 * time.Millisecond,
+		renewInterval: 10 * time.Millisecond,
+		acquireLease:  leases.acquire,
+		releaseLease:  leases.release,
+	}
+}
+
+// countingJob counts the running instances of the job
+func countingJob(running *int32) Job {
+	return func(ctx context.Context) {
+		atomic.AddInt32(running, 1)
+		defer atomic.AddInt32(running, -1)
+		<-ctx.Done()
+	}
+}
+
+func waitFor(t *testing.T, condition func() bool, message string) {
+	t.Helper()
+	deadline := time.Now().Add(2 * time.Second)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal(message)
+		}
+		time.Sleep(5 * time.Millisecond)
+	}
+}
+
+func TestElector_Failover(t *testing.T) {
+	var (
+		leases                  = newFakeLeases()
+		firstRunning            int32
+		secondRunning           int32
+		firstCtx, cancelFirst   = context.WithCancel(context.Background())
+		secondCtx, cancelSecond = context.WithCancel(context.Background())
+	)
+	defer cancelSecond()
+
+	first := newTestElector("first", leases)
+	first.Register("job", countingJob(&firstRunning))
+	first.Start(firstCtx)
+	waitFor(t, func() bool { return atomic.LoadInt32(&firstRunning) == 1 }, "the job was not started by the first replica")
+
+	second := newTestElector("second", leases)
+	second.Register("job", countingJob(&secondRunning))
+	second.Start(secondCtx)
+	time.Sleep(100 * time.Millisecond)
+	if atomic.LoadInt32(&secondRunning) != 0 {
+		t.Fatal("the job was started by the replica which doesn't hold the lease")
+	}
+
+	cancelFirst()
+	waitFor(t, func() bool { return atomic.LoadInt32(&firstRunning) == 0 }, "the job was not stopped by the first replica")
+	waitFor(t, func() bool { return atomic.LoadInt32(&secondRunning) == 1 }, "the job was not taken over by the second replica")
+}
+
+func TestElector_StopsJobOnceLeaseExpires(t *testing.T) {
+	var (
+		leases      = newFakeLeases()
+		running     int32
+		ctx, cancel = context.WithCancel(context.Background())
+	)
+	defer cancel()
+
+	elector := newTestElector("holder", leases)
+	elector.Register("job", countingJob(&running))
+	elector.Start(ctx)
+	waitFor(t, func() bool { return atomic.LoadInt32(&running) == 1 }, "the job was not started")
+
+	leases.setErr(errors.New("database unavailable"))
+	waitFor(t, func() bool { return atomic.LoadInt32(&running) == 0 }, "the job was not stopped once its lease expired")
+
+	leases.setErr(nil)
+	waitFor(t, func() bool { return atomic.LoadInt32(&running) == 1 }, "the job was not started again once the lease was acquired")
+}
@@ -0,0 +1,63 @@
+package metrics
+
+import (
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	connectedInfrasDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "connected_infras"),
+		"Number of infras connected to the server",
+		nil, nil,
+	)
+	activeSubscriptionsDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "active_subscriptions"),
+		"Number of active websocket subscriptions",
+		[]string{"subscription"}, nil,
+	)
+)
+
+// stateCollector collects the connected infras and the active subscriptions from the application state at scrape time
+type stateCollector struct {
+	state *store.StateData
+}
+
+// RegisterStateCollector registers the collector of the connected infras and the active subscriptions of the application state
+func RegisterStateCollector(state *store.StateData) error {
+	return prometheus.Register(&stateCollector{state: state})
+}
+
+// Describe implements prometheus.Collector
+func (s *stateCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- connectedInfrasDesc
+	ch <- activeSubscriptionsDesc
+}
+
+// Collect implements prometheus.Collector
+func (s *stateCollector) Collect(ch chan<- prometheus.Metric) {
+	s.state.Mutex.Lock()
+	connectedInfras := len(s.state.ConnectedInfra)
+	infraEvents := 0
+	for _, observers := range s.state.InfraEventPublish {
+		infraEvents += len(observers)
+	}
+	experimentRunEvents := 0
+	for _, observers := range s.state.ExperimentEventPublish {
+		experimentRunEvents += len(observers)
+	}
+	subscriptions := map[string]int{
+		"getInfraEvents":      infraEvents,
+		"experimentRunEvents": experimentRunEvents,
+		"getPodLog":           len(s.state.ExperimentLog),
+		"getKubeObject":       len(s.state.KubeObjectData),
+		"getKubeNamespace":    len(s.state.KubeNamespaceData),
+		"infraConnect":        connectedInfras,
+	}
+	s.state.Mutex.Unlock()
+
+	ch <- prometheus.MustNewConstMetric(connectedInfrasDesc, prometheus.GaugeValue, float64(connectedInfras))
+	for subscription, count := range subscriptions {
+		ch <- prometheus.MustNewConstMetric(activeSubscriptionsDesc, prometheus.GaugeValue, float64(count), subscription)
+	}
+}
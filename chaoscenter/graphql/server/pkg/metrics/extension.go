@@ -0,0 +1,44 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/99designs/gqlgen/graphql"
+)
+
+// ResolverLatency is a gqlgen extension recording the latency of the query and mutation resolvers
+type ResolverLatency struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = ResolverLatency{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (ResolverLatency) ExtensionName() string {
+	return "ResolverLatency"
+}
+
+// Validate implements graphql.HandlerExtension
+func (ResolverLatency) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptField records the latency of the root query and mutation fields, the nested fields are skipped
+// as the number of series would grow with the schema and the subscriptions stay open until the client leaves
+func (ResolverLatency) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver || (fc.Object != "Query" && fc.Object != "Mutation") {
+		return next(ctx)
+	}
+
+	start := time.Now()
+	res, err := next(ctx)
+	status := "success"
+	if err != nil {
+		status = "error"
+	}
+	ResolverDuration.WithLabelValues(fc.Object, fc.Field.Name, status).Observe(time.Since(start).Seconds())
+	return res, err
+}
@@ -0,0 +1,92 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+const namespace = "litmus"
+
+// The labels are limited to project level identifiers and bounded values such as phases
+// and schema fields, so that the number of series grows with the number of projects
+var (
+	ExperimentRunsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "experiment_runs_total",
+		Help:      "Number of experiment runs which reached a phase",
+	}, []string{"project_id", "phase"})
+
+	ResiliencyScore = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "experiment_run_resiliency_score",
+		Help:      "Resiliency scores of the completed experiment runs",
+		Buckets:   prometheus.LinearBuckets(10, 10, 10),
+	}, []string{"project_id"})
+
+	ChaosHubSyncDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "chaoshub_sync_duration_seconds",
+		Help:      "Duration of the chaos hub syncs",
+		Buckets:   prometheus.ExponentialBuckets(0.5, 2, 8),
+	}, []string{"project_id", "hub_type"})
+
+	ChaosHubSyncFailuresTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "chaoshub_sync_failures_total",
+		Help:      "Number of failed chaos hub syncs",
+	}, []string{"project_id", "hub_type"})
+
+	GitOpsSyncErrorsTotal = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "gitops_sync_errors_total",
+		Help:      "Number of failed syncs of the gitops repositories",
+	}, []string{"project_id"})
+
+	ResolverDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Name:      "graphql_resolver_duration_seconds",
+		Help:      "Latency of the query and mutation resolvers",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"object", "field", "status"})
+)
+
+// ObserveChaosHubSync records the duration of a chaos hub sync which started at the given time, syncErr is the error of a failed sync
+func ObserveChaosHubSync(projectID string, hubType string, start time.Time, syncErr error) {
+	if hubType == "" {
+		hubType = string(model.HubTypeGit)
+	}
+	ChaosHubSyncDuration.WithLabelValues(projectID, hubType).Observe(time.Since(start).Seconds())
+	if syncErr != nil {
+		ChaosHubSyncFailuresTotal.WithLabelValues(projectID, hubType).Inc()
+	}
+}
+
+// ObserveGitOpsSync records the result of a sync of the gitops repository of a project
+func ObserveGitOpsSync(projectID string, syncErr error) {
+	if syncErr != nil {
+		GitOpsSyncErrorsTotal.WithLabelValues(projectID).Inc()
+	}
+}
+
+// ExperimentRunListener records the phases and the resiliency scores of the experiment runs,
+// it is registered with the experiment and run handlers
+type ExperimentRunListener struct{}
+
+// ExperimentRunStarted counts the experiment run as running
+func (ExperimentRunListener) ExperimentRunStarted(_ context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun) {
+	ExperimentRunsTotal.WithLabelValues(experimentRun.ProjectID, string(model.ExperimentRunStatusRunning)).Inc()
+}
+
+// ExperimentRunCompleted counts the final phase of the experiment run and records its resiliency score
+func (ExperimentRunListener) ExperimentRunCompleted(_ context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, _ *store.StateData) {
+	ExperimentRunsTotal.WithLabelValues(experimentRun.ProjectID, experimentRun.Phase).Inc()
+	if experimentRun.ResiliencyScore != nil {
+		ResiliencyScore.WithLabelValues(experimentRun.ProjectID).Observe(*experimentRun.ResiliencyScore)
+	}
+}
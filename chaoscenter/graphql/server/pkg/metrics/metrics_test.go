@@ -0,0 +1,77 @@
+package metrics
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+)
+
+func TestStateCollector(t *testing.T) {
+	state := store.NewStore()
+	state.ConnectedInfra["infra-1"] = make(chan *model.InfraActionResponse)
+	state.ConnectedInfra["infra-2"] = make(chan *model.InfraActionResponse)
+	state.ExperimentEventPublish["project/run"] = []chan *model.ExperimentRun{make(chan *model.ExperimentRun), make(chan *model.ExperimentRun)}
+
+	registry := prometheus.NewPedanticRegistry()
+	registry.MustRegister(&stateCollector{state: state})
+
+	expected := `
+# HELP litmus_connected_infras Number of infras connected to the server
+# TYPE litmus_connected_infras gauge
+litmus_connected_infras 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "litmus_connected_infras"); err != nil {
+		t.Error(err)
+	}
+
+	expected = `
+# HELP litmus_active_subscriptions Number of active websocket subscriptions
+# TYPE litmus_active_subscriptions gauge
+litmus_active_subscriptions{subscription="experimentRunEvents"} 2
+litmus_active_subscriptions{subscription="getInfraEvents"} 0
+litmus_active_subscriptions{subscription="getKubeNamespace"} 0
+litmus_active_subscriptions{subscription="getKubeObject"} 0
+litmus_active_subscriptions{subscription="getPodLog"} 0
+litmus_active_subscriptions{subscription="infraConnect"} 2
+`
+	if err := testutil.GatherAndCompare(registry, strings.NewReader(expected), "litmus_active_subscriptions"); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestObserveChaosHubSync(t *testing.T) {
+	ObserveChaosHubSync("project-id", "", time.Now(), nil)
+	ObserveChaosHubSync("project-id", "REMOTE", time.Now(), errors.New("sync failed"))
+
+	if got := testutil.ToFloat64(ChaosHubSyncFailuresTotal.WithLabelValues("project-id", "REMOTE")); got != 1 {
+		t.Errorf("chaoshub_sync_failures_total{hub_type=REMOTE} = %v, want 1", got)
+	}
+	if got := testutil.ToFloat64(ChaosHubSyncFailuresTotal.WithLabelValues("project-id", "GIT")); got != 0 {
+		t.Errorf("chaoshub_sync_failures_total{hub_type=GIT} = %v, want 0", got)
+	}
+}
+
+func TestExperimentRunListener_ExperimentRunCompleted(t *testing.T) {
+	score := 80.0
+	for _, experimentID := range []string{"experiment-1", "experiment-2"} {
+		ExperimentRunListener{}.ExperimentRunCompleted(context.Background(), dbChaosExperimentRun.ChaosExperimentRun{
+			ProjectID:       "project-id",
+			ExperimentID:    experimentID,
+			Phase:           string(model.ExperimentRunStatusCompleted),
+			ResiliencyScore: &score,
+		}, nil)
+	}
+
+	// the scores of all the experiments of a project are recorded in a single series
+	if got := testutil.CollectAndCount(ResiliencyScore, "litmus_experiment_run_resiliency_score"); got != 1 {
+		t.Errorf("litmus_experiment_run_resiliency_score series = %v, want 1", got)
+	}
+}
@@ -417,8 +417,12 @@ func GetProbeExecutionHistoryInExperimentRuns(projectID string, probeName string
 
 	for _, execution := range expRuns {
 		var executionData chaos_experiment.ExecutionData
-		if execution.ExecutionData != "" {
-			err = json.Unmarshal([]byte(execution.ExecutionData), &executionData)
+		runExecutionData, err := execution.GetExecutionData()
+		if err != nil {
+			continue
+		}
+		if runExecutionData != "" {
+			err = json.Unmarshal([]byte(runExecutionData), &executionData)
 			if err != nil {
 				continue
 			}
@@ -562,6 +566,7 @@ func (p *probeService) GetProbeReference(ctx context.Context, probeName, project
 								{"updated_at", 1},
 								{"updated_by", 1},
 								{"execution_data", 1},
+								{"compressed_execution_data", 1},
 								{"experiment_id", 1},
 							}},
 						},
@@ -599,8 +604,12 @@ func (p *probeService) GetProbeReference(ctx context.Context, probeName, project
 					var executionData chaos_experiment.ExecutionData
 					status := model.ProbeVerdictNa
 					mode := model.ModeSot
-					if runs.ExecutionData != "" {
-						err := json.Unmarshal([]byte(runs.ExecutionData), &executionData)
+					runExecutionData, err := dbChaosExperimentRun.DecodeExecutionData(runs.ExecutionData, runs.CompressedExecutionData)
+					if err != nil {
+						continue
+					}
+					if runExecutionData != "" {
+						err := json.Unmarshal([]byte(runExecutionData), &executionData)
 						if err != nil {
 							continue
 						}
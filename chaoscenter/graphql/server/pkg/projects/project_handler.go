@@ -7,6 +7,7 @@ import (
 	"github.com/google/uuid"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/config"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/image_registry"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/project"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/grpc"
@@ -16,6 +17,9 @@ import (
 	grpc2 "google.golang.org/grpc"
 )
 
+// projectEventsResumeTokenKey is the server config storing the resume token of the last handled project event
+const projectEventsResumeTokenKey = "project_events_resume_token"
+
 // ProjectInitializer creates a default hub and default image registry for a new project
 func ProjectInitializer(ctx context.Context, project project.Project, role string, operator mongodb.MongoOperator) error {
 
@@ -50,20 +54,43 @@ func ProjectInitializer(ctx context.Context, project project.Project, role strin
 	return nil
 }
 
-func ProjectEvents(projectEventChannel chan string, mongoClient *mongo.Client, mongoOp mongodb.MongoOperator) {
+// ProjectEvents initializes the projects created in the auth service until the context is cancelled,
+// it returns if the change stream of the projects can't be watched. The change stream resumes after the
+// last handled event so that the projects created while no replica was watching them are initialized
+func ProjectEvents(ctx context.Context, projectEventChannel chan string, mongoClient *mongo.Client, mongoOp mongodb.MongoOperator) {
 
 	pipeline := mongo.Pipeline{
 		bson.D{{"$match", bson.D{{"operationType", "insert"}}}},
 	}
-	projectDetails, err := project.NewProjectOperator(mongoOp).WatchProjectEvents(context.Background(), pipeline, mongoClient)
+	var resumeToken interface{}
+	resumeConfig, err := config.GetConfig(ctx, projectEventsResumeTokenKey)
+	if err != nil {
+		log.Errorf("failed to get the resume token of the project events, error: %v", err)
+	} else if resumeConfig != nil {
+		resumeToken = resumeConfig.Value
+	}
+
+	projectOperator := project.NewProjectOperator(mongoOp)
+	projectDetails, err := projectOperator.WatchProjectEvents(ctx, pipeline, mongoClient, resumeToken)
+	if err != nil && resumeToken != nil {
+		// the token is no longer in the oplog, the events are watched from now on
+		log.Errorf("failed to resume the project events, error: %v", err)
+		projectDetails, err = projectOperator.WatchProjectEvents(ctx, pipeline, mongoClient, nil)
+	}
 	if err != nil {
 		log.Error(err.Error())
+		return
+	}
+	defer projectDetails.Close(context.Background())
+	// the start of a new change stream is saved so that the leader taking over resumes from it
+	if resumeToken == nil {
+		saveProjectEventsResumeToken(ctx, projectDetails)
 	}
 	var conn *grpc2.ClientConn
 	client, conn := grpc.GetAuthGRPCSvcClient(conn)
 	defer conn.Close()
 
-	for projectDetails.Next(context.Background()) {
+	for projectDetails.Next(ctx) {
 		var DbEvent project.ProjectCreationEvent
 		if err := projectDetails.Decode(&DbEvent); err != nil {
 			log.Error(err.Error())
@@ -73,12 +100,24 @@ func ProjectEvents(projectEventChannel chan string, mongoClient *mongo.Client, m
 			if err != nil {
 				log.Error(err)
 			}
-			err = ProjectInitializer(context.Background(), DbEvent.FullDocument, user.Role, mongoOp)
+			err = ProjectInitializer(ctx, DbEvent.FullDocument, user.Role, mongoOp)
 			if err != nil {
 				log.Error(err)
 			}
 			//projectEventChannel <- DbEvent.OperationType
 		}
+		saveProjectEventsResumeToken(ctx, projectDetails)
 	}
 
 }
+
+// saveProjectEventsResumeToken saves the resume token of the change stream, the events up to it are handled
+func saveProjectEventsResumeToken(ctx context.Context, projectDetails *mongo.ChangeStream) {
+	resumeToken := projectDetails.ResumeToken()
+	if resumeToken == nil {
+		return
+	}
+	if err := config.SaveConfig(ctx, projectEventsResumeTokenKey, resumeToken); err != nil {
+		log.Errorf("failed to save the resume token of the project events, error: %v", err)
+	}
+}
@@ -0,0 +1,298 @@
+package retention
+
+import (
+	"context"
+	"path"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbRetention "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/retention"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+const (
+	pruneInterval  = time.Hour
+	pruneBatchSize = 100
+)
+
+// prunedRun identifies an experiment run to prune, the runs are identified by their document ID as the queued runs
+// which were stopped and the runs which timed out before they started have no experiment run ID
+type prunedRun struct {
+	ID primitive.ObjectID `bson:"_id"`
+}
+
+// RunPruner removes the execution data of the completed experiment runs which are beyond the retention
+// policy of their project, the runs are archived first if the policy requires it
+type RunPruner struct {
+	retentionOperator          *dbRetention.Operator
+	chaosExperimentRunOperator *dbChaosExperimentRun.Operator
+	mongodbOperator            mongodb.MongoOperator
+}
+
+// NewRunPruner returns a new instance of the run pruner
+func NewRunPruner(mongodbOperator mongodb.MongoOperator) *RunPruner {
+	return &RunPruner{
+		retentionOperator:          dbRetention.NewRetentionOperator(mongodbOperator),
+		chaosExperimentRunOperator: dbChaosExperimentRun.NewChaosExperimentRunOperator(mongodbOperator),
+		mongodbOperator:            mongodbOperator,
+	}
+}
+
+// RecurringPrune prunes the experiment runs of all the projects periodically until the context is cancelled
+func (p *RunPruner) RecurringPrune(ctx context.Context) {
+	for {
+		if err := p.Prune(ctx); err != nil {
+			log.Errorf("failed to prune the experiment runs, error: %v", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(pruneInterval):
+		}
+	}
+}
+
+// Prune applies the retention policy of every project with completed runs which were not pruned yet
+func (p *RunPruner) Prune(ctx context.Context) error {
+	var sink Sink
+	if utils.Config.RunArchiveSink != "" {
+		var err error
+		sink, err = NewSink(utils.Config.RunArchiveSink, S3Config{
+			Endpoint:        utils.Config.RunArchiveS3Endpoint,
+			Region:          utils.Config.RunArchiveS3Region,
+			AccessKeyID:     utils.Config.RunArchiveS3AccessKeyId,
+			SecretAccessKey: utils.Config.RunArchiveS3SecretAccessKey,
+		})
+		if err != nil {
+			log.Errorf("failed to configure the archive sink of the experiment runs, error: %v", err)
+		}
+	}
+
+	policies, err := p.retentionOperator.GetRunRetentionPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	projectPolicies := make(map[string]dbRetention.RunRetentionPolicy, len(policies))
+	for _, policy := range policies {
+		projectPolicies[policy.ProjectID] = policy
+	}
+
+	projectIDs, err := p.getUnprunedProjectIDs(ctx)
+	if err != nil {
+		return err
+	}
+	for _, projectID := range projectIDs {
+		policy, ok := projectPolicies[projectID]
+		if !ok {
+			policy = defaultPolicy(projectID)
+		}
+		if policy.MaxAgeDays <= 0 && policy.MaxRunsPerExperiment <= 0 {
+			continue
+		}
+		if policy.Mode == model.RunRetentionModeArchive && sink == nil {
+			log.Errorf("skipping the pruning of the experiment runs of project %s, the archive sink is not configured", projectID)
+			continue
+		}
+		if err := p.pruneProject(ctx, policy, sink); err != nil {
+			log.Errorf("failed to prune the experiment runs of project %s, error: %v", projectID, err)
+		}
+	}
+
+	return nil
+}
+
+// pruneProject prunes the runs of the project beyond its policy by batches
+func (p *RunPruner) pruneProject(ctx context.Context, policy dbRetention.RunRetentionPolicy, sink Sink) error {
+	if policy.MaxAgeDays > 0 {
+		cutoff := time.Now().AddDate(0, 0, -policy.MaxAgeDays).UnixMilli()
+		for {
+			runs, err := p.getExpiredRuns(ctx, policy.ProjectID, cutoff)
+			if err != nil {
+				return err
+			}
+			if err = p.pruneRuns(ctx, policy, sink, runs); err != nil {
+				return err
+			}
+			if len(runs) < pruneBatchSize {
+				break
+			}
+		}
+	}
+
+	if policy.MaxRunsPerExperiment > 0 {
+		for {
+			runs, err := p.getExcessRuns(ctx, policy.ProjectID, policy.MaxRunsPerExperiment)
+			if err != nil {
+				return err
+			}
+			if err = p.pruneRuns(ctx, policy, sink, runs); err != nil {
+				return err
+			}
+			if len(runs) < pruneBatchSize {
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// pruneRuns archives the runs if required by the policy and removes their execution data, the summary of the
+// runs (resiliency score, phase and fault counts) is kept
+func (p *RunPruner) pruneRuns(ctx context.Context, policy dbRetention.RunRetentionPolicy, sink Sink, runs []prunedRun) error {
+	for _, target := range runs {
+		query := bson.D{
+			{"_id", target.ID},
+			{"project_id", policy.ProjectID},
+		}
+
+		var archiveLocation string
+		if policy.Mode == model.RunRetentionModeArchive {
+			run, err := p.chaosExperimentRunOperator.GetExperimentRun(query)
+			if err != nil {
+				return err
+			}
+			// the execution data is archived uncompressed so that the archive can be read without the server
+			run.ExecutionData, err = run.GetExecutionData()
+			if err != nil {
+				return err
+			}
+			run.CompressedExecutionData = nil
+			data, err := bson.MarshalExtJSON(run, false, false)
+			if err != nil {
+				return err
+			}
+			archiveLocation, err = sink.Archive(ctx, archiveKey(run, target.ID), data)
+			if err != nil {
+				return err
+			}
+		}
+
+		set := bson.D{
+			{"pruned_at", time.Now().UnixMilli()},
+		}
+		if archiveLocation != "" {
+			set = append(set, bson.E{Key: "archive_location", Value: archiveLocation})
+		}
+		err := p.chaosExperimentRunOperator.UpdateExperimentRunWithQuery(ctx, append(query,
+			bson.E{Key: "pruned_at", Value: bson.D{{"$exists", false}}},
+		), bson.D{
+			{"$set", set},
+			{"$unset", bson.D{
+				{"queued_manifest", ""},
+				{"execution_data", ""},
+				{"compressed_execution_data", ""},
+			}},
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// getUnprunedProjectIDs returns the projects with completed runs which were not pruned yet
+func (p *RunPruner) getUnprunedProjectIDs(ctx context.Context) ([]string, error) {
+	pipeline := mongo.Pipeline{
+		{{"$match", unprunedRunsQuery()}},
+		{{"$group", bson.D{{"_id", "$project_id"}}}},
+	}
+	cursor, err := p.mongodbOperator.Aggregate(ctx, mongodb.ChaosExperimentRunsCollection, pipeline)
+	if err != nil {
+		return nil, err
+	}
+
+	var projects []struct {
+		ProjectID string `bson:"_id"`
+	}
+	if err = cursor.All(ctx, &projects); err != nil {
+		return nil, err
+	}
+	projectIDs := make([]string, 0, len(projects))
+	for _, project := range projects {
+		projectIDs = append(projectIDs, project.ProjectID)
+	}
+	return projectIDs, nil
+}
+
+// getExpiredRuns returns a batch of the unpruned runs of the project created before the cutoff
+func (p *RunPruner) getExpiredRuns(ctx context.Context, projectID string, cutoff int64) ([]prunedRun, error) {
+	query := append(unprunedRunsQuery(),
+		bson.E{Key: "project_id", Value: projectID},
+		bson.E{Key: "created_at", Value: bson.D{{"$lt", cutoff}}},
+	)
+	opts := options.Find().SetLimit(pruneBatchSize).SetProjection(bson.D{{"_id", 1}})
+	cursor, err := p.mongodbOperator.List(ctx, mongodb.ChaosExperimentRunsCollection, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []prunedRun
+	if err = cursor.All(ctx, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// getExcessRuns returns a batch of the unpruned runs of the project which are not among the latest
+// maxRuns runs of their experiment
+func (p *RunPruner) getExcessRuns(ctx context.Context, projectID string, maxRuns int) ([]prunedRun, error) {
+	cursor, err := p.mongodbOperator.Aggregate(ctx, mongodb.ChaosExperimentRunsCollection, excessRunsPipeline(projectID, maxRuns))
+	if err != nil {
+		return nil, err
+	}
+
+	var runs []prunedRun
+	if err = cursor.All(ctx, &runs); err != nil {
+		return nil, err
+	}
+	return runs, nil
+}
+
+// unprunedRunsQuery matches the completed runs which were not pruned yet
+func unprunedRunsQuery() bson.D {
+	return bson.D{
+		{"completed", true},
+		{"pruned_at", bson.D{{"$exists", false}}},
+	}
+}
+
+// excessRunsPipeline returns the pipeline listing the completed and unpruned runs of the project beyond
+// the latest maxRuns runs of their experiment, the runs still in progress count towards the limit
+func excessRunsPipeline(projectID string, maxRuns int) mongo.Pipeline {
+	return mongo.Pipeline{
+		{{"$match", bson.D{{"project_id", projectID}}}},
+		{{"$sort", bson.D{{"created_at", -1}}}},
+		{{"$group", bson.D{
+			{"_id", "$experiment_id"},
+			{"runs", bson.D{{"$push", bson.D{
+				{"_id", "$_id"},
+				{"completed", "$completed"},
+				{"pruned_at", "$pruned_at"},
+			}}}},
+		}}},
+		{{"$match", bson.D{{"runs." + strconv.Itoa(maxRuns), bson.D{{"$exists", true}}}}}},
+		{{"$project", bson.D{
+			{"runs", bson.D{{"$slice", bson.A{"$runs", maxRuns, bson.D{{"$size", "$runs"}}}}}},
+		}}},
+		{{"$unwind", "$runs"}},
+		{{"$replaceRoot", bson.D{{"newRoot", "$runs"}}}},
+		{{"$match", unprunedRunsQuery()}},
+		{{"$limit", pruneBatchSize}},
+	}
+}
+
+// archiveKey returns the key of the archive of the run in the sink, the document ID of the run keeps the key unique
+// for the runs without an experiment run ID
+func archiveKey(run dbChaosExperimentRun.ChaosExperimentRun, id primitive.ObjectID) string {
+	return path.Join(run.ProjectID, run.ExperimentID, id.Hex()+".json")
+}
@@ -0,0 +1,58 @@
+package retention
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/mocks"
+	dbRetention "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/retention"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/bson/primitive"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+func TestRunPruner_PruneRunsWithoutRunID(t *testing.T) {
+	directory := t.TempDir()
+	sink, err := NewSink("file://"+filepath.ToSlash(directory), S3Config{})
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+
+	mongodbMockOperator := new(dbMocks.MongoOperator)
+	p := NewRunPruner(mongodbMockOperator)
+
+	// stopped queued runs have no experiment run ID
+	runs := []prunedRun{{ID: primitive.NewObjectID()}, {ID: primitive.NewObjectID()}}
+	for _, run := range runs {
+		document := bson.D{
+			{Key: "project_id", Value: "project"},
+			{Key: "experiment_id", Value: "experiment"},
+			{Key: "experiment_run_id", Value: ""},
+			{Key: "phase", Value: string(model.ExperimentRunStatusStopped)},
+		}
+		mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosExperimentRunsCollection, bson.D{{"_id", run.ID}, {"project_id", "project"}}).
+			Return(mongo.NewSingleResultFromDocument(document, nil, nil), nil).Once()
+		mongodbMockOperator.On("Update", mock.Anything, mongodb.ChaosExperimentRunsCollection, mock.MatchedBy(func(query bson.D) bool {
+			return len(query) == 3 && query[0].Key == "_id" && query[0].Value == run.ID && query[1].Key == "project_id" && query[1].Value == "project"
+		}), mock.Anything, mock.Anything).Return(&mongo.UpdateResult{MatchedCount: 1}, nil).Once()
+	}
+
+	policy := dbRetention.RunRetentionPolicy{ProjectID: "project", Mode: model.RunRetentionModeArchive}
+	if err := p.pruneRuns(context.Background(), policy, sink, runs); err != nil {
+		t.Fatalf("pruneRuns() error = %v", err)
+	}
+	mongodbMockOperator.AssertExpectations(t)
+
+	entries, err := os.ReadDir(filepath.Join(directory, "project", "experiment"))
+	if err != nil {
+		t.Fatalf("failed to read the archives: %v", err)
+	}
+	if len(entries) != len(runs) {
+		t.Errorf("expected an archive per run, found %d archives", len(entries))
+	}
+}
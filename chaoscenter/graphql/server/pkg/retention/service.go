@@ -0,0 +1,134 @@
+package retention
+
+import (
+	"context"
+	"errors"
+	"strconv"
+	"time"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbRetention "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/retention"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// Service is the interface for the run retention service
+type Service interface {
+	GetRunRetentionPolicy(ctx context.Context, projectID string) (*model.RunRetentionPolicy, error)
+	UpdateRunRetentionPolicy(ctx context.Context, projectID string, request model.RunRetentionPolicyRequest, username string) (*model.RunRetentionPolicy, error)
+	ResetRunRetentionPolicy(ctx context.Context, projectID string) (*model.RunRetentionPolicy, error)
+}
+
+// retentionService is the implementation of the run retention service
+type retentionService struct {
+	retentionOperator *dbRetention.Operator
+}
+
+// NewRetentionService returns a new instance of the run retention service
+func NewRetentionService(retentionOperator *dbRetention.Operator) Service {
+	return &retentionService{
+		retentionOperator: retentionOperator,
+	}
+}
+
+// GetRunRetentionPolicy returns the policy of the project, or the default policy of the server if the project doesn't override it
+func (r *retentionService) GetRunRetentionPolicy(ctx context.Context, projectID string) (*model.RunRetentionPolicy, error) {
+	policy, err := r.retentionOperator.GetRunRetentionPolicy(ctx, projectID)
+	if errors.Is(err, mongo.ErrNoDocuments) {
+		return toModel(defaultPolicy(projectID), true), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return toModel(policy, false), nil
+}
+
+// UpdateRunRetentionPolicy validates the policy and stores it as the policy of the project
+func (r *retentionService) UpdateRunRetentionPolicy(ctx context.Context, projectID string, request model.RunRetentionPolicyRequest, username string) (*model.RunRetentionPolicy, error) {
+	policy := dbRetention.RunRetentionPolicy{
+		ProjectID: projectID,
+		Mode:      request.Mode,
+		UpdatedAt: time.Now().UnixMilli(),
+		UpdatedBy: mongodb.UserDetailResponse{
+			Username: username,
+		},
+	}
+	if request.MaxAgeDays != nil {
+		policy.MaxAgeDays = *request.MaxAgeDays
+	}
+	if request.MaxRunsPerExperiment != nil {
+		policy.MaxRunsPerExperiment = *request.MaxRunsPerExperiment
+	}
+	if err := validatePolicy(policy); err != nil {
+		return nil, err
+	}
+
+	if err := r.retentionOperator.UpsertRunRetentionPolicy(ctx, policy); err != nil {
+		return nil, err
+	}
+
+	return toModel(policy, false), nil
+}
+
+// ResetRunRetentionPolicy removes the policy of the project and returns the default policy of the server
+func (r *retentionService) ResetRunRetentionPolicy(ctx context.Context, projectID string) (*model.RunRetentionPolicy, error) {
+	if err := r.retentionOperator.DeleteRunRetentionPolicy(ctx, projectID); err != nil {
+		return nil, err
+	}
+
+	return toModel(defaultPolicy(projectID), true), nil
+}
+
+// defaultPolicy returns the default policy of the server for the project
+func defaultPolicy(projectID string) dbRetention.RunRetentionPolicy {
+	return dbRetention.RunRetentionPolicy{
+		ProjectID:            projectID,
+		MaxAgeDays:           utils.Config.RunRetentionMaxAgeDays,
+		MaxRunsPerExperiment: utils.Config.RunRetentionMaxRuns,
+		Mode:                 model.RunRetentionMode(utils.Config.RunRetentionMode),
+	}
+}
+
+// validatePolicy checks the limits of the policy and that the server can archive the runs if the policy requires it
+func validatePolicy(policy dbRetention.RunRetentionPolicy) error {
+	if policy.MaxAgeDays < 0 {
+		return errors.New("maximum age of the runs can't be negative")
+	}
+	if policy.MaxRunsPerExperiment < 0 {
+		return errors.New("maximum number of runs per experiment can't be negative")
+	}
+	if !policy.Mode.IsValid() {
+		return errors.New("invalid retention mode " + string(policy.Mode))
+	}
+	if policy.Mode == model.RunRetentionModeArchive && utils.Config.RunArchiveSink == "" {
+		return errors.New("runs can't be archived, the archive sink of the server is not configured")
+	}
+	return nil
+}
+
+// toModel converts the policy of the database to the GraphQL model
+func toModel(policy dbRetention.RunRetentionPolicy, isServerDefault bool) *model.RunRetentionPolicy {
+	runRetentionPolicy := &model.RunRetentionPolicy{
+		ProjectID:       policy.ProjectID,
+		Mode:            policy.Mode,
+		IsServerDefault: isServerDefault,
+	}
+	if policy.MaxAgeDays > 0 {
+		runRetentionPolicy.MaxAgeDays = &policy.MaxAgeDays
+	}
+	if policy.MaxRunsPerExperiment > 0 {
+		runRetentionPolicy.MaxRunsPerExperiment = &policy.MaxRunsPerExperiment
+	}
+	if !isServerDefault {
+		updatedAt := strconv.FormatInt(policy.UpdatedAt, 10)
+		runRetentionPolicy.UpdatedAt = &updatedAt
+		runRetentionPolicy.UpdatedBy = &model.UserDetails{
+			UserID:   policy.UpdatedBy.UserID,
+			Username: policy.UpdatedBy.Username,
+			Email:    policy.UpdatedBy.Email,
+		}
+	}
+	return runRetentionPolicy
+}
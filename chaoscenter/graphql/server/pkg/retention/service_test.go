@@ -0,0 +1,75 @@
+package retention
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbRetention "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/retention"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+)
+
+func TestValidatePolicy(t *testing.T) {
+	tests := []struct {
+		name        string
+		policy      dbRetention.RunRetentionPolicy
+		archiveSink string
+		wantErr     bool
+	}{
+		{
+			name:   "limits of the delete policy",
+			policy: dbRetention.RunRetentionPolicy{MaxAgeDays: 30, MaxRunsPerExperiment: 50, Mode: model.RunRetentionModeDelete},
+		},
+		{
+			name:   "policy without limits",
+			policy: dbRetention.RunRetentionPolicy{Mode: model.RunRetentionModeDelete},
+		},
+		{
+			name:    "negative age",
+			policy:  dbRetention.RunRetentionPolicy{MaxAgeDays: -1, Mode: model.RunRetentionModeDelete},
+			wantErr: true,
+		},
+		{
+			name:    "negative number of runs",
+			policy:  dbRetention.RunRetentionPolicy{MaxRunsPerExperiment: -1, Mode: model.RunRetentionModeDelete},
+			wantErr: true,
+		},
+		{
+			name:    "invalid mode",
+			policy:  dbRetention.RunRetentionPolicy{Mode: "MOVE"},
+			wantErr: true,
+		},
+		{
+			name:    "archive policy without sink",
+			policy:  dbRetention.RunRetentionPolicy{MaxAgeDays: 30, Mode: model.RunRetentionModeArchive},
+			wantErr: true,
+		},
+		{
+			name:        "archive policy with sink",
+			policy:      dbRetention.RunRetentionPolicy{MaxAgeDays: 30, Mode: model.RunRetentionModeArchive},
+			archiveSink: "file:///var/lib/litmus/archive",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			utils.Config.RunArchiveSink = tt.archiveSink
+			defer func() { utils.Config.RunArchiveSink = "" }()
+
+			if err := validatePolicy(tt.policy); (err != nil) != tt.wantErr {
+				t.Errorf("validatePolicy() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestToModel(t *testing.T) {
+	policy := toModel(dbRetention.RunRetentionPolicy{ProjectID: "project", MaxRunsPerExperiment: 10, Mode: model.RunRetentionModeDelete}, true)
+	if policy.MaxAgeDays != nil {
+		t.Errorf("expected the age not to be limited, got %d", *policy.MaxAgeDays)
+	}
+	if policy.MaxRunsPerExperiment == nil || *policy.MaxRunsPerExperiment != 10 {
+		t.Errorf("expected the number of runs to be limited to 10")
+	}
+	if !policy.IsServerDefault || policy.UpdatedAt != nil || policy.UpdatedBy != nil {
+		t.Errorf("expected the default policy of the server without update details")
+	}
+}
@@ -0,0 +1,240 @@
+package retention
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+	"time"
+)
+
+const (
+	fileSinkScheme = "file"
+	s3SinkScheme   = "s3"
+	s3Service      = "s3"
+	s3Algorithm    = "AWS4-HMAC-SHA256"
+	s3Timeout      = 30 * time.Second
+)
+
+// Sink stores the archives of the experiment runs before their execution data is removed
+type Sink interface {
+	// Archive stores the data under the key and returns the location of the archive
+	Archive(ctx context.Context, key string, data []byte) (string, error)
+}
+
+// S3Config contains the endpoint and the credentials of the object storage used by the S3 sinks
+type S3Config struct {
+	Endpoint        string
+	Region          string
+	AccessKeyID     string
+	SecretAccessKey string
+}
+
+// NewSink returns the sink of the URI, file:///<directory> for a directory of the server
+// or s3://<bucket>/<prefix> for a bucket of an S3 compatible object storage
+func NewSink(uri string, s3Config S3Config) (Sink, error) {
+	sinkURL, err := url.Parse(uri)
+	if err != nil {
+		return nil, fmt.Errorf("invalid archive sink %s: %v", uri, err)
+	}
+
+	switch sinkURL.Scheme {
+	case fileSinkScheme:
+		if sinkURL.Path == "" {
+			return nil, errors.New("directory of the file archive sink can't be empty")
+		}
+		return &fileSink{directory: sinkURL.Path}, nil
+	case s3SinkScheme:
+		if sinkURL.Host == "" {
+			return nil, errors.New("bucket of the s3 archive sink can't be empty")
+		}
+		if s3Config.AccessKeyID == "" || s3Config.SecretAccessKey == "" {
+			return nil, errors.New("credentials of the s3 archive sink are not configured")
+		}
+		if s3Config.Region == "" {
+			return nil, errors.New("region of the s3 archive sink is not configured")
+		}
+		if s3Config.Endpoint == "" {
+			s3Config.Endpoint = "https://s3." + s3Config.Region + ".amazonaws.com"
+		}
+		if _, err := url.Parse(s3Config.Endpoint); err != nil {
+			return nil, fmt.Errorf("invalid s3 endpoint %s: %v", s3Config.Endpoint, err)
+		}
+		return &s3Sink{
+			bucket: sinkURL.Host,
+			prefix: strings.Trim(sinkURL.Path, "/"),
+			config: s3Config,
+			client: &http.Client{Timeout: s3Timeout},
+			now:    time.Now,
+		}, nil
+	default:
+		return nil, fmt.Errorf("unsupported archive sink scheme %q, supported schemes are file and s3", sinkURL.Scheme)
+	}
+}
+
+// fileSink writes the archives to a directory of the server
+type fileSink struct {
+	directory string
+}
+
+// Archive writes the data to a temporary file which is then renamed so that partial archives are never visible
+func (f *fileSink) Archive(ctx context.Context, key string, data []byte) (string, error) {
+	target := filepath.Join(f.directory, filepath.FromSlash(key))
+	if err := os.MkdirAll(filepath.Dir(target), 0o755); err != nil {
+		return "", err
+	}
+
+	file, err := os.CreateTemp(filepath.Dir(target), ".archive-*")
+	if err != nil {
+		return "", err
+	}
+	defer os.Remove(file.Name())
+
+	if _, err = file.Write(data); err != nil {
+		file.Close()
+		return "", err
+	}
+	if err = file.Close(); err != nil {
+		return "", err
+	}
+	if err = os.Rename(file.Name(), target); err != nil {
+		return "", err
+	}
+
+	return (&url.URL{Scheme: fileSinkScheme, Path: filepath.ToSlash(target)}).String(), nil
+}
+
+// s3Sink uploads the archives to a bucket of an S3 compatible object storage, the requests are
+// signed with AWS signature version 4 and use path-style addressing
+type s3Sink struct {
+	bucket string
+	prefix string
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+// Archive uploads the data to the object of the key in the bucket
+func (s *s3Sink) Archive(ctx context.Context, key string, data []byte) (string, error) {
+	objectKey := path.Join(s.prefix, key)
+	endpoint, err := url.Parse(s.config.Endpoint)
+	if err != nil {
+		return "", err
+	}
+	endpoint.Path = path.Join("/", endpoint.Path, s.bucket, objectKey)
+	endpoint.RawPath = encodeS3Path(endpoint.Path)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, endpoint.String(), bytes.NewReader(data))
+	if err != nil {
+		return "", err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	s.sign(req, data)
+
+	resp, err := s.client.Do(req)
+	if err != nil {
+		return "", err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, 1024))
+		return "", fmt.Errorf("failed to upload archive %s, status code: %d, response: %s", objectKey, resp.StatusCode, string(body))
+	}
+
+	return s3SinkScheme + "://" + s.bucket + "/" + objectKey, nil
+}
+
+// sign adds the AWS signature version 4 of the request to its headers
+func (s *s3Sink) sign(req *http.Request, payload []byte) {
+	now := s.now().UTC()
+	amzDate := now.Format("20060102T150405Z")
+	date := now.Format("20060102")
+	payloadHash := sha256Hex(payload)
+
+	req.Header.Set("X-Amz-Date", amzDate)
+	req.Header.Set("X-Amz-Content-Sha256", payloadHash)
+
+	headers := map[string]string{
+		"host":                 req.URL.Host,
+		"x-amz-content-sha256": payloadHash,
+		"x-amz-date":           amzDate,
+		"content-type":         req.Header.Get("Content-Type"),
+	}
+	names := make([]string, 0, len(headers))
+	for name := range headers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	var canonicalHeaders strings.Builder
+	for _, name := range names {
+		canonicalHeaders.WriteString(name + ":" + strings.TrimSpace(headers[name]) + "\n")
+	}
+	signedHeaders := strings.Join(names, ";")
+
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		req.URL.EscapedPath(),
+		req.URL.RawQuery,
+		canonicalHeaders.String(),
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := strings.Join([]string{date, s.config.Region, s3Service, "aws4_request"}, "/")
+	stringToSign := strings.Join([]string{
+		s3Algorithm,
+		amzDate,
+		scope,
+		sha256Hex([]byte(canonicalRequest)),
+	}, "\n")
+
+	signingKey := hmacSHA256([]byte("AWS4"+s.config.SecretAccessKey), date)
+	signingKey = hmacSHA256(signingKey, s.config.Region)
+	signingKey = hmacSHA256(signingKey, s3Service)
+	signingKey = hmacSHA256(signingKey, "aws4_request")
+	signature := hex.EncodeToString(hmacSHA256(signingKey, stringToSign))
+
+	req.Header.Set("Authorization", fmt.Sprintf("%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+		s3Algorithm, s.config.AccessKeyID, scope, signedHeaders, signature))
+}
+
+// encodeS3Path encodes the segments of the path as expected by the signature, only the unreserved
+// characters of RFC 3986 are kept as they are
+func encodeS3Path(objectPath string) string {
+	segments := strings.Split(objectPath, "/")
+	for i, segment := range segments {
+		var encoded strings.Builder
+		for _, b := range []byte(segment) {
+			if (b >= 'A' && b <= 'Z') || (b >= 'a' && b <= 'z') || (b >= '0' && b <= '9') ||
+				b == '-' || b == '_' || b == '.' || b == '~' {
+				encoded.WriteByte(b)
+				continue
+			}
+			fmt.Fprintf(&encoded, "%%%02X", b)
+		}
+		segments[i] = encoded.String()
+	}
+	return strings.Join(segments, "/")
+}
+
+func sha256Hex(data []byte) string {
+	hash := sha256.Sum256(data)
+	return hex.EncodeToString(hash[:])
+}
+
+func hmacSHA256(key []byte, data string) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(data))
+	return mac.Sum(nil)
+}
@@ -0,0 +1,145 @@
+package retention
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNewSink(t *testing.T) {
+	s3Config := S3Config{Region: "us-east-1", AccessKeyID: "key", SecretAccessKey: "secret"}
+	tests := []struct {
+		name     string
+		uri      string
+		s3Config S3Config
+		wantErr  bool
+	}{
+		{name: "file sink", uri: "file:///var/lib/litmus/archive"},
+		{name: "s3 sink", uri: "s3://archive/runs", s3Config: s3Config},
+		{name: "file sink without directory", uri: "file://", wantErr: true},
+		{name: "s3 sink without bucket", uri: "s3:///runs", s3Config: s3Config, wantErr: true},
+		{name: "s3 sink without credentials", uri: "s3://archive/runs", s3Config: S3Config{Region: "us-east-1"}, wantErr: true},
+		{name: "unsupported scheme", uri: "ftp://archive/runs", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewSink(tt.uri, tt.s3Config)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("NewSink() error = %v, wantErr %v", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileSinkArchive(t *testing.T) {
+	directory := t.TempDir()
+	sink, err := NewSink("file://"+filepath.ToSlash(directory), S3Config{})
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+
+	location, err := sink.Archive(context.Background(), "project/experiment/run.json", []byte(`{"phase":"Completed"}`))
+	if err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+
+	target := filepath.Join(directory, "project", "experiment", "run.json")
+	if want := "file://" + filepath.ToSlash(target); location != want {
+		t.Errorf("Archive() location = %s, want %s", location, want)
+	}
+	data, err := os.ReadFile(target)
+	if err != nil {
+		t.Fatalf("failed to read the archive: %v", err)
+	}
+	if string(data) != `{"phase":"Completed"}` {
+		t.Errorf("archive = %s", string(data))
+	}
+	entries, _ := os.ReadDir(filepath.Dir(target))
+	if len(entries) != 1 {
+		t.Errorf("expected only the archive in the directory, found %d entries", len(entries))
+	}
+}
+
+func TestS3SinkArchive(t *testing.T) {
+	var (
+		method, path, authorization, contentHash string
+		body                                     []byte
+	)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		method = r.Method
+		path = r.URL.EscapedPath()
+		authorization = r.Header.Get("Authorization")
+		contentHash = r.Header.Get("X-Amz-Content-Sha256")
+		body, _ = io.ReadAll(r.Body)
+	}))
+	defer server.Close()
+
+	sink, err := NewSink("s3://archive/litmus/runs", S3Config{
+		Endpoint:        server.URL,
+		Region:          "eu-west-1",
+		AccessKeyID:     "AKIDEXAMPLE",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+	sink.(*s3Sink).now = func() time.Time { return time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC) }
+
+	data := []byte(`{"phase":"Completed"}`)
+	location, err := sink.Archive(context.Background(), "project/experiment/run.json", data)
+	if err != nil {
+		t.Fatalf("Archive() error = %v", err)
+	}
+
+	if location != "s3://archive/litmus/runs/project/experiment/run.json" {
+		t.Errorf("Archive() location = %s", location)
+	}
+	if method != http.MethodPut || path != "/archive/litmus/runs/project/experiment/run.json" {
+		t.Errorf("unexpected request %s %s", method, path)
+	}
+	if string(body) != string(data) {
+		t.Errorf("unexpected body %s", string(body))
+	}
+	if contentHash != sha256Hex(data) {
+		t.Errorf("unexpected content hash %s", contentHash)
+	}
+	wantPrefix := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20240501/eu-west-1/s3/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-content-sha256;x-amz-date, Signature="
+	if !strings.HasPrefix(authorization, wantPrefix) {
+		t.Errorf("unexpected authorization header %s", authorization)
+	}
+}
+
+func TestS3SinkArchiveError(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusForbidden)
+		_, _ = w.Write([]byte("SignatureDoesNotMatch"))
+	}))
+	defer server.Close()
+
+	sink, err := NewSink("s3://archive", S3Config{
+		Endpoint:        server.URL,
+		Region:          "us-east-1",
+		AccessKeyID:     "key",
+		SecretAccessKey: "secret",
+	})
+	if err != nil {
+		t.Fatalf("NewSink() error = %v", err)
+	}
+
+	if _, err = sink.Archive(context.Background(), "run.json", []byte("{}")); err == nil || !strings.Contains(err.Error(), "SignatureDoesNotMatch") {
+		t.Errorf("Archive() error = %v, want the error of the object storage", err)
+	}
+}
+
+func TestEncodeS3Path(t *testing.T) {
+	if got := encodeS3Path("/archive/runs/a b+c.json"); got != "/archive/runs/a%20b%2Bc.json" {
+		t.Errorf("encodeS3Path() = %s", got)
+	}
+}
@@ -0,0 +1,318 @@
+package run_approval
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbRunApproval "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/run_approval"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// Service is the interface for the run approval service
+type Service interface {
+	RequestApproval(ctx context.Context, projectID string, experiment dbChaosExperiment.ChaosExperimentRequest, action model.RunApprovalAction, inputs []*model.ExperimentInputValueRequest, priority *int, username string) (*model.RunApprovalRequest, error)
+	ApproveRunApproval(ctx context.Context, projectID string, approvalID string, comment *string, username string, r *store.StateData) (*model.RunApprovalRequest, error)
+	RejectRunApproval(ctx context.Context, projectID string, approvalID string, comment *string, username string) (*model.RunApprovalRequest, error)
+	ListRunApprovals(ctx context.Context, projectID string, request *model.ListRunApprovalsRequest) (*model.ListRunApprovalsResponse, error)
+}
+
+// ExperimentRunner runs the experiments of the approved requests
+type ExperimentRunner interface {
+	RunChaosWorkFlow(ctx context.Context, projectID string, workflow dbChaosExperiment.ChaosExperimentRequest, inputs []*model.ExperimentInputValueRequest, priority *int, r *store.StateData) (*model.RunChaosExperimentResponse, error)
+}
+
+// CronExperimentUpdater enables the cron schedule of the experiments of the approved requests
+type CronExperimentUpdater interface {
+	UpdateCronExperimentState(ctx context.Context, workflowID string, disable bool, projectID string, r *store.StateData, username string) (bool, error)
+}
+
+// runApprovalService is the implementation of the run approval service
+type runApprovalService struct {
+	runApprovalOperator     *dbRunApproval.Operator
+	chaosExperimentOperator *dbChaosExperiment.Operator
+	mongodbOperator         mongodb.MongoOperator
+	experimentRunner        ExperimentRunner
+	cronExperimentUpdater   CronExperimentUpdater
+}
+
+// NewRunApprovalService returns a new instance of the run approval service
+func NewRunApprovalService(runApprovalOperator *dbRunApproval.Operator, chaosExperimentOperator *dbChaosExperiment.Operator, mongodbOperator mongodb.MongoOperator, experimentRunner ExperimentRunner, cronExperimentUpdater CronExperimentUpdater) Service {
+	return &runApprovalService{
+		runApprovalOperator:     runApprovalOperator,
+		chaosExperimentOperator: chaosExperimentOperator,
+		mongodbOperator:         mongodbOperator,
+		experimentRunner:        experimentRunner,
+		cronExperimentUpdater:   cronExperimentUpdater,
+	}
+}
+
+// RequestApproval creates an approval request for the action if the environment of the experiment's infra requires
+// run approvals, it returns nil if the action doesn't need an approval. A pending request of the same action of the
+// experiment is returned instead of creating a new one
+func (a *runApprovalService) RequestApproval(ctx context.Context, projectID string, experiment dbChaosExperiment.ChaosExperimentRequest, action model.RunApprovalAction, inputs []*model.ExperimentInputValueRequest, priority *int, username string) (*model.RunApprovalRequest, error) {
+	infra, err := dbChaosInfra.NewInfrastructureOperator(a.mongodbOperator).GetInfra(experiment.InfraID)
+	if err != nil {
+		return nil, err
+	}
+	required, err := envHandler.InfraRequiresRunApproval(ctx, a.mongodbOperator, infra)
+	if err != nil || !required {
+		return nil, err
+	}
+
+	pending, err := a.runApprovalOperator.GetRunApproval(ctx, bson.D{
+		{"project_id", projectID},
+		{"experiment_id", experiment.ExperimentID},
+		{"action", action},
+		{"status", model.RunApprovalStatusPending},
+	})
+	if err == nil {
+		return toModel(pending), nil
+	}
+	if !errors.Is(err, mongo.ErrNoDocuments) {
+		return nil, err
+	}
+
+	request := dbRunApproval.RunApprovalRequest{
+		ApprovalID:     uuid.NewString(),
+		ProjectID:      projectID,
+		ExperimentID:   experiment.ExperimentID,
+		ExperimentName: experiment.Name,
+		InfraID:        infra.InfraID,
+		EnvironmentID:  infra.EnvironmentID,
+		Action:         action,
+		Status:         model.RunApprovalStatusPending,
+		Priority:       priority,
+		RequestedBy: mongodb.UserDetailResponse{
+			Username: username,
+		},
+		CreatedAt: time.Now().UnixMilli(),
+	}
+	for _, input := range inputs {
+		request.Inputs = append(request.Inputs, dbChaosExperimentRun.InputValue{
+			Name:  input.Name,
+			Value: input.Value,
+		})
+	}
+	if err := a.runApprovalOperator.CreateRunApproval(ctx, request); err != nil {
+		return nil, err
+	}
+
+	logrus.WithFields(logrus.Fields{
+		"projectId":         projectID,
+		"chaosExperimentId": experiment.ExperimentID,
+		"approvalId":        request.ApprovalID,
+	}).Infof("approval request created for the %s action of the experiment", action)
+	return toModel(request), nil
+}
+
+// ApproveRunApproval records the approval of a pending request and dispatches its action, a failed dispatch is
+// recorded in the request as the decision can't be taken back
+func (a *runApprovalService) ApproveRunApproval(ctx context.Context, projectID string, approvalID string, comment *string, username string, r *store.StateData) (*model.RunApprovalRequest, error) {
+	request, err := a.decide(ctx, projectID, approvalID, model.RunApprovalStatusApproved, comment, username)
+	if err != nil {
+		return nil, err
+	}
+
+	update := bson.D{}
+	notifyID, err := a.dispatch(ctx, request, username, r)
+	if err != nil {
+		logrus.WithFields(logrus.Fields{
+			"projectId":  projectID,
+			"approvalId": approvalID,
+		}).Errorf("failed to dispatch the approved request, error: %v", err)
+		request.DispatchError = err.Error()
+		update = append(update, bson.E{Key: "dispatch_error", Value: request.DispatchError})
+	} else if notifyID != "" {
+		request.NotifyID = notifyID
+		update = append(update, bson.E{Key: "notify_id", Value: notifyID})
+	}
+
+	if len(update) > 0 {
+		if _, err := a.runApprovalOperator.UpdateRunApproval(ctx, bson.D{{"approval_id", approvalID}}, bson.D{{"$set", update}}); err != nil {
+			return nil, err
+		}
+	}
+	return toModel(request), nil
+}
+
+// RejectRunApproval records the rejection of a pending request
+func (a *runApprovalService) RejectRunApproval(ctx context.Context, projectID string, approvalID string, comment *string, username string) (*model.RunApprovalRequest, error) {
+	request, err := a.decide(ctx, projectID, approvalID, model.RunApprovalStatusRejected, comment, username)
+	if err != nil {
+		return nil, err
+	}
+	return toModel(request), nil
+}
+
+// ListRunApprovals returns the approval requests of the project matching the filter, the latest requests first
+func (a *runApprovalService) ListRunApprovals(ctx context.Context, projectID string, request *model.ListRunApprovalsRequest) (*model.ListRunApprovalsResponse, error) {
+	query := bson.D{{"project_id", projectID}}
+	opts := options.Find().SetSort(bson.D{{"created_at", -1}})
+	if request != nil {
+		if request.Filter != nil {
+			if request.Filter.Status != nil {
+				query = append(query, bson.E{Key: "status", Value: *request.Filter.Status})
+			}
+			if request.Filter.ExperimentID != nil {
+				query = append(query, bson.E{Key: "experiment_id", Value: *request.Filter.ExperimentID})
+			}
+		}
+		if request.Pagination != nil && request.Pagination.Limit > 0 {
+			opts.SetSkip(int64(request.Pagination.Page * request.Pagination.Limit)).SetLimit(int64(request.Pagination.Limit))
+		}
+	}
+
+	total, err := a.runApprovalOperator.CountRunApprovals(ctx, query)
+	if err != nil {
+		return nil, err
+	}
+	requests, err := a.runApprovalOperator.ListRunApprovals(ctx, query, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &model.ListRunApprovalsResponse{
+		TotalNoOfRequests: int(total),
+		Requests:          []*model.RunApprovalRequest{},
+	}
+	for _, r := range requests {
+		response.Requests = append(response.Requests, toModel(r))
+	}
+	return response, nil
+}
+
+// decide records the decision on the request if it is still pending, a request is decided only once and is
+// never approved by its requester
+func (a *runApprovalService) decide(ctx context.Context, projectID string, approvalID string, status model.RunApprovalStatus, comment *string, username string) (dbRunApproval.RunApprovalRequest, error) {
+	decision := dbRunApproval.RunApprovalDecision{
+		Status: status,
+		DecidedBy: mongodb.UserDetailResponse{
+			Username: username,
+		},
+		DecidedAt: time.Now().UnixMilli(),
+	}
+	if comment != nil {
+		decision.Comment = *comment
+	}
+
+	query := bson.D{
+		{"approval_id", approvalID},
+		{"project_id", projectID},
+	}
+	pendingQuery := append(bson.D{{"status", model.RunApprovalStatusPending}}, query...)
+	if status == model.RunApprovalStatusApproved {
+		// the requester of an action can't approve it
+		pendingQuery = append(pendingQuery, bson.E{Key: "requested_by.username", Value: bson.D{{"$ne", username}}})
+	}
+	decided, err := a.runApprovalOperator.UpdateRunApproval(ctx, pendingQuery, bson.D{
+		{"$set", bson.D{
+			{"status", status},
+			{"decision", decision},
+		}},
+	})
+	if err != nil {
+		return dbRunApproval.RunApprovalRequest{}, err
+	}
+	if !decided {
+		request, err := a.runApprovalOperator.GetRunApproval(ctx, query)
+		if err == nil && request.Status == model.RunApprovalStatusPending && request.RequestedBy.Username == username {
+			return dbRunApproval.RunApprovalRequest{}, fmt.Errorf("approval request %s can't be approved by its requester", approvalID)
+		}
+		return dbRunApproval.RunApprovalRequest{}, fmt.Errorf("approval request %s doesn't exist or isn't pending", approvalID)
+	}
+
+	return a.runApprovalOperator.GetRunApproval(ctx, query)
+}
+
+// dispatch performs the approved action of the request, the notify ID of the experiment run is returned if
+// the experiment is run
+func (a *runApprovalService) dispatch(ctx context.Context, request dbRunApproval.RunApprovalRequest, username string, r *store.StateData) (string, error) {
+	if request.Action == model.RunApprovalActionEnableCron {
+		_, err := a.cronExperimentUpdater.UpdateCronExperimentState(ctx, request.ExperimentID, false, request.ProjectID, r, username)
+		return "", err
+	}
+
+	experiment, err := a.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", request.ExperimentID},
+		{"project_id", request.ProjectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return "", fmt.Errorf("could not get experiment %s, error: %v", request.ExperimentID, err)
+	}
+
+	var inputs []*model.ExperimentInputValueRequest
+	for _, input := range request.Inputs {
+		inputs = append(inputs, &model.ExperimentInputValueRequest{
+			Name:  input.Name,
+			Value: input.Value,
+		})
+	}
+	response, err := a.experimentRunner.RunChaosWorkFlow(ctx, request.ProjectID, experiment, inputs, request.Priority, r)
+	if err != nil {
+		return "", err
+	}
+	return response.NotifyID, nil
+}
+
+// toModel converts the approval request of the database to the GraphQL model
+func toModel(request dbRunApproval.RunApprovalRequest) *model.RunApprovalRequest {
+	approval := &model.RunApprovalRequest{
+		ApprovalID:     request.ApprovalID,
+		ProjectID:      request.ProjectID,
+		ExperimentID:   request.ExperimentID,
+		ExperimentName: request.ExperimentName,
+		InfraID:        request.InfraID,
+		EnvironmentID:  request.EnvironmentID,
+		Action:         request.Action,
+		Status:         request.Status,
+		Priority:       request.Priority,
+		RequestedBy: &model.UserDetails{
+			UserID:   request.RequestedBy.UserID,
+			Username: request.RequestedBy.Username,
+			Email:    request.RequestedBy.Email,
+		},
+		CreatedAt: strconv.FormatInt(request.CreatedAt, 10),
+	}
+	for _, input := range request.Inputs {
+		approval.Inputs = append(approval.Inputs, &model.ExperimentInputValue{
+			Name:  input.Name,
+			Value: input.Value,
+		})
+	}
+	if request.Decision != nil {
+		approval.Decision = &model.RunApprovalDecision{
+			Status: request.Decision.Status,
+			DecidedBy: &model.UserDetails{
+				UserID:   request.Decision.DecidedBy.UserID,
+				Username: request.Decision.DecidedBy.Username,
+				Email:    request.Decision.DecidedBy.Email,
+			},
+			DecidedAt: strconv.FormatInt(request.Decision.DecidedAt, 10),
+		}
+		if request.Decision.Comment != "" {
+			approval.Decision.Comment = &request.Decision.Comment
+		}
+	}
+	if request.NotifyID != "" {
+		approval.NotifyID = &request.NotifyID
+	}
+	if request.DispatchError != "" {
+		approval.DispatchError = &request.DispatchError
+	}
+	return approval
+}
@@ -0,0 +1,202 @@
+package run_approval
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbMocks "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/mocks"
+	dbRunApproval "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/run_approval"
+	"github.com/stretchr/testify/mock"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+type experimentRunRecorder struct {
+	inputs []*model.ExperimentInputValueRequest
+	runs   int
+}
+
+func (e *experimentRunRecorder) RunChaosWorkFlow(_ context.Context, _ string, _ dbChaosExperiment.ChaosExperimentRequest, inputs []*model.ExperimentInputValueRequest, _ *int, _ *store.StateData) (*model.RunChaosExperimentResponse, error) {
+	e.runs++
+	e.inputs = inputs
+	return &model.RunChaosExperimentResponse{NotifyID: "notify"}, nil
+}
+
+type cronExperimentRecorder struct {
+	enabled int
+}
+
+func (c *cronExperimentRecorder) UpdateCronExperimentState(_ context.Context, _ string, disable bool, _ string, _ *store.StateData, _ string) (bool, error) {
+	if !disable {
+		c.enabled++
+	}
+	return true, nil
+}
+
+func newTestService(mongodbMockOperator *dbMocks.MongoOperator, runner ExperimentRunner, cronUpdater CronExperimentUpdater) Service {
+	return NewRunApprovalService(dbRunApproval.NewRunApprovalOperator(mongodbMockOperator), dbChaosExperiment.NewChaosExperimentOperator(mongodbMockOperator), mongodbMockOperator, runner, cronUpdater)
+}
+
+func approvalDocument(approvalID string, projectID string, action model.RunApprovalAction, status model.RunApprovalStatus) bson.D {
+	return bson.D{
+		{Key: "approval_id", Value: approvalID},
+		{Key: "project_id", Value: projectID},
+		{Key: "experiment_id", Value: "experiment"},
+		{Key: "action", Value: action},
+		{Key: "status", Value: status},
+		{Key: "inputs", Value: bson.A{bson.D{{Key: "name", Value: "duration"}, {Key: "value", Value: "60"}}}},
+		{Key: "decision", Value: bson.D{
+			{Key: "status", Value: status},
+			{Key: "decided_by", Value: bson.D{{Key: "username", Value: "owner"}}},
+			{Key: "comment", Value: "release checked"},
+		}},
+	}
+}
+
+func TestRunApprovalService_RequestApproval(t *testing.T) {
+	mongodbMockOperator := new(dbMocks.MongoOperator)
+	service := newTestService(mongodbMockOperator, &experimentRunRecorder{}, &cronExperimentRecorder{})
+
+	// Infras without an environment never require run approvals
+	infra := mongo.NewSingleResultFromDocument(bson.D{{Key: "infra_id", Value: "infra"}}, nil, nil)
+	mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosInfraCollection, mock.Anything).Return(infra, nil).Once()
+
+	approval, err := service.RequestApproval(context.Background(), uuid.NewString(), dbChaosExperiment.ChaosExperimentRequest{InfraID: "infra"}, model.RunApprovalActionRun, nil, nil, "executor")
+	if err != nil {
+		t.Fatalf("RequestApproval() error = %v", err)
+	}
+	if approval != nil {
+		t.Errorf("RequestApproval() = %+v, want no approval request", approval)
+	}
+}
+
+func TestRunApprovalService_ApproveRunApproval(t *testing.T) {
+	projectID := uuid.NewString()
+	approvalID := uuid.NewString()
+	tests := []struct {
+		name        string
+		action      model.RunApprovalAction
+		wantRuns    int
+		wantEnabled int
+	}{
+		{
+			name:     "success: approved run is dispatched with its inputs",
+			action:   model.RunApprovalActionRun,
+			wantRuns: 1,
+		},
+		{
+			name:        "success: approved cron schedule is enabled",
+			action:      model.RunApprovalActionEnableCron,
+			wantEnabled: 1,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mongodbMockOperator := new(dbMocks.MongoOperator)
+			runner := &experimentRunRecorder{}
+			cronUpdater := &cronExperimentRecorder{}
+			service := newTestService(mongodbMockOperator, runner, cronUpdater)
+
+			mongodbMockOperator.On("Update", mock.Anything, mongodb.RunApprovalCollection, mock.Anything, mock.Anything, mock.Anything).
+				Return(&mongo.UpdateResult{MatchedCount: 1}, nil)
+			request := mongo.NewSingleResultFromDocument(approvalDocument(approvalID, projectID, tc.action, model.RunApprovalStatusApproved), nil, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.RunApprovalCollection, mock.Anything).Return(request, nil).Once()
+			experiment := mongo.NewSingleResultFromDocument(bson.D{{Key: "experiment_id", Value: "experiment"}}, nil, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.ChaosExperimentCollection, mock.Anything).Return(experiment, nil).Once()
+
+			approval, err := service.ApproveRunApproval(context.Background(), projectID, approvalID, nil, "owner", nil)
+			if err != nil {
+				t.Fatalf("ApproveRunApproval() error = %v", err)
+			}
+			if approval.Status != model.RunApprovalStatusApproved || approval.Decision == nil || approval.Decision.DecidedBy.Username != "owner" {
+				t.Errorf("ApproveRunApproval() = %+v, want an approved request decided by the owner", approval)
+			}
+			if runner.runs != tc.wantRuns || cronUpdater.enabled != tc.wantEnabled {
+				t.Errorf("ApproveRunApproval() dispatched %d runs and %d cron schedules, want %d and %d", runner.runs, cronUpdater.enabled, tc.wantRuns, tc.wantEnabled)
+			}
+			if tc.wantRuns > 0 {
+				if len(runner.inputs) != 1 || runner.inputs[0].Name != "duration" || runner.inputs[0].Value != "60" {
+					t.Errorf("ApproveRunApproval() dispatched the inputs %v, want the requested inputs", runner.inputs)
+				}
+				if approval.NotifyID == nil || *approval.NotifyID != "notify" {
+					t.Errorf("ApproveRunApproval() notify ID = %v, want the notify ID of the run", approval.NotifyID)
+				}
+			}
+		})
+	}
+}
+
+func TestRunApprovalService_ApproveOwnRunApproval(t *testing.T) {
+	projectID := uuid.NewString()
+	approvalID := uuid.NewString()
+	mongodbMockOperator := new(dbMocks.MongoOperator)
+	runner := &experimentRunRecorder{}
+	service := newTestService(mongodbMockOperator, runner, &cronExperimentRecorder{})
+
+	mongodbMockOperator.On("Update", mock.Anything, mongodb.RunApprovalCollection, mock.Anything, mock.Anything, mock.Anything).
+		Return(&mongo.UpdateResult{MatchedCount: 0}, nil).Once()
+	document := append(approvalDocument(approvalID, projectID, model.RunApprovalActionRun, model.RunApprovalStatusPending),
+		bson.E{Key: "requested_by", Value: bson.D{{Key: "username", Value: "owner"}}})
+	request := mongo.NewSingleResultFromDocument(document, nil, nil)
+	mongodbMockOperator.On("Get", mock.Anything, mongodb.RunApprovalCollection, mock.Anything).Return(request, nil).Once()
+
+	_, err := service.ApproveRunApproval(context.Background(), projectID, approvalID, nil, "owner", nil)
+	if err == nil || !strings.Contains(err.Error(), "requester") {
+		t.Errorf("ApproveRunApproval() error = %v, want the requester to be refused", err)
+	}
+	if runner.runs != 0 {
+		t.Errorf("ApproveRunApproval() dispatched %d runs, want none", runner.runs)
+	}
+}
+
+func TestRunApprovalService_RejectRunApproval(t *testing.T) {
+	projectID := uuid.NewString()
+	approvalID := uuid.NewString()
+	comment := "release checked"
+	tests := []struct {
+		name         string
+		matchedCount int64
+		wantErr      bool
+	}{
+		{
+			name:         "success: pending request is rejected",
+			matchedCount: 1,
+		},
+		{
+			name:    "failure: request which isn't pending can't be decided again",
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			mongodbMockOperator := new(dbMocks.MongoOperator)
+			runner := &experimentRunRecorder{}
+			service := newTestService(mongodbMockOperator, runner, &cronExperimentRecorder{})
+
+			mongodbMockOperator.On("Update", mock.Anything, mongodb.RunApprovalCollection, mock.Anything, mock.Anything, mock.Anything).
+				Return(&mongo.UpdateResult{MatchedCount: tc.matchedCount}, nil).Once()
+			request := mongo.NewSingleResultFromDocument(approvalDocument(approvalID, projectID, model.RunApprovalActionRun, model.RunApprovalStatusRejected), nil, nil)
+			mongodbMockOperator.On("Get", mock.Anything, mongodb.RunApprovalCollection, mock.Anything).Return(request, nil).Once()
+
+			approval, err := service.RejectRunApproval(context.Background(), projectID, approvalID, &comment, "owner")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("RejectRunApproval() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if runner.runs != 0 {
+				t.Errorf("RejectRunApproval() dispatched %d runs, want none", runner.runs)
+			}
+			if tc.wantErr {
+				return
+			}
+			if approval.Status != model.RunApprovalStatusRejected || approval.Decision == nil || approval.Decision.Comment == nil || *approval.Decision.Comment != comment {
+				t.Errorf("RejectRunApproval() = %+v, want a rejected request with the comment", approval)
+			}
+		})
+	}
+}
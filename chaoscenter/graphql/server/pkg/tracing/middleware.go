@@ -0,0 +1,54 @@
+package tracing
+
+import (
+	"context"
+
+	"github.com/99designs/gqlgen/graphql"
+	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// Middleware continues the trace of the incoming request, if the client sent its trace context in the headers
+func Middleware() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+	}
+}
+
+// ResolverTracing is a gqlgen extension creating a span for every resolver
+type ResolverTracing struct{}
+
+var _ interface {
+	graphql.HandlerExtension
+	graphql.FieldInterceptor
+} = ResolverTracing{}
+
+// ExtensionName implements graphql.HandlerExtension
+func (ResolverTracing) ExtensionName() string {
+	return "ResolverTracing"
+}
+
+// Validate implements graphql.HandlerExtension
+func (ResolverTracing) Validate(graphql.ExecutableSchema) error {
+	return nil
+}
+
+// InterceptField creates a span around the resolvers, the fields which are only read from the parent object are skipped
+func (ResolverTracing) InterceptField(ctx context.Context, next graphql.Resolver) (interface{}, error) {
+	fc := graphql.GetFieldContext(ctx)
+	if fc == nil || !fc.IsResolver {
+		return next(ctx)
+	}
+
+	ctx, span := StartSpan(ctx, fc.Object+"."+fc.Field.Name,
+		attribute.String("graphql.object", fc.Object),
+		attribute.String("graphql.field", fc.Field.Name),
+	)
+	res, err := next(ctx)
+	EndSpan(span, err)
+	return res, err
+}
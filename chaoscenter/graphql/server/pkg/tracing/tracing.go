@@ -0,0 +1,95 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const (
+	ServiceName = "litmus-graphql-server"
+
+	tracerName = "github.com/litmuschaos/litmus/chaoscenter/graphql/server"
+)
+
+// Tracer creates the spans of the server, the spans are dropped until InitTracer sets up the exporter
+var Tracer = otel.Tracer(tracerName)
+
+// InitTracer sets up the export of the spans to the OTLP endpoint configured with the standard
+// OTEL_EXPORTER_OTLP_* environment variables and the W3C trace context propagation, the returned
+// function flushes the pending spans and has to be called before the server exits
+func InitTracer(ctx context.Context, endpoint string) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	if endpoint == "" {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	exporter, err := otlptracehttp.New(ctx)
+	if err != nil {
+		return nil, err
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceName(ServiceName)))
+	if err != nil {
+		return nil, err
+	}
+
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+// StartSpan starts a span of the server with the given attributes
+func StartSpan(ctx context.Context, name string, attributes ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer.Start(ctx, name, trace.WithAttributes(attributes...))
+}
+
+// EndSpan records the error on the span, if any, and ends it
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// GetTraceContext returns the trace context of the span in the context encoded as a JSON map of the
+// W3C propagation headers, it is sent along with the requests to the subscribers so that their spans
+// join the trace of the request. It is nil if the context has no span
+func GetTraceContext(ctx context.Context) *string {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return nil
+	}
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+	data, err := json.Marshal(carrier)
+	if err != nil {
+		return nil
+	}
+	traceContext := string(data)
+	return &traceContext
+}
+
+// StartSubscriberRequestSpan starts the span of a request sent to the subscriber of an infra and returns
+// the trace context which is sent along with the request, the span is ended once the request is sent
+func StartSubscriberRequestSpan(ctx context.Context, infraID string, requestType string) (trace.Span, *string) {
+	ctx, span := Tracer.Start(ctx, "subscriber."+requestType,
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(
+			attribute.String("infra.id", infraID),
+			attribute.String("subscriber.request_type", requestType),
+		),
+	)
+	return span, GetTraceContext(ctx)
+}
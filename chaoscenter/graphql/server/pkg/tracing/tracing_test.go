@@ -0,0 +1,40 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestGetTraceContext(t *testing.T) {
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+
+	if traceContext := GetTraceContext(context.Background()); traceContext != nil {
+		t.Errorf("expected no trace context without a span, got %s", *traceContext)
+	}
+
+	provider := sdktrace.NewTracerProvider()
+	defer provider.Shutdown(context.Background())
+	ctx, span := provider.Tracer("test").Start(context.Background(), "request")
+	defer span.End()
+
+	traceContext := GetTraceContext(ctx)
+	if traceContext == nil {
+		t.Fatal("expected the trace context of the span")
+	}
+
+	carrier := propagation.MapCarrier{}
+	if err := json.Unmarshal([]byte(*traceContext), &carrier); err != nil {
+		t.Fatal(err)
+	}
+	extracted := trace.SpanContextFromContext(propagation.TraceContext{}.Extract(context.Background(), carrier))
+	if extracted.TraceID() != span.SpanContext().TraceID() || extracted.SpanID() != span.SpanContext().SpanID() {
+		t.Errorf("expected span %s of trace %s, got span %s of trace %s", span.SpanContext().SpanID(),
+			span.SpanContext().TraceID(), extracted.SpanID(), extracted.TraceID())
+	}
+}
@@ -0,0 +1,155 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbWebhook "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/webhook"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+)
+
+const (
+	// Headers sent with every delivery, the signature is the hex encoded HMAC-SHA256 of the payload
+	EventHeader     = "X-Litmus-Event"
+	DeliveryHeader  = "X-Litmus-Delivery"
+	SignatureHeader = "X-Litmus-Signature"
+
+	maxDeliveryAttempts = 5
+	deliveryTimeout     = 10 * time.Second
+)
+
+// deliveryBackoff is the wait before the first retry of a failed delivery, it is doubled after every attempt
+var deliveryBackoff = 2 * time.Second
+
+// EventPayload is the JSON payload posted to the webhooks
+type EventPayload struct {
+	DeliveryID      string                 `json:"deliveryID"`
+	Event           model.WebhookEventType `json:"event"`
+	Timestamp       int64                  `json:"timestamp"`
+	ProjectID       string                 `json:"projectID"`
+	ExperimentID    string                 `json:"experimentID"`
+	ExperimentName  string                 `json:"experimentName"`
+	ExperimentRunID string                 `json:"experimentRunID,omitempty"`
+	NotifyID        string                 `json:"notifyID,omitempty"`
+	InfraID         string                 `json:"infraID"`
+	EnvironmentID   string                 `json:"environmentID,omitempty"`
+	Phase           string                 `json:"phase"`
+	ResiliencyScore *float64               `json:"resiliencyScore,omitempty"`
+	FaultsPassed    *int                   `json:"faultsPassed,omitempty"`
+	FaultsFailed    *int                   `json:"faultsFailed,omitempty"`
+	Tags            []string               `json:"tags,omitempty"`
+}
+
+// createDelivery stores a pending delivery of the payload to the webhook in the delivery log
+func (w *webhookService) createDelivery(ctx context.Context, webhook dbWebhook.Webhook, payload EventPayload) (dbWebhook.WebhookDelivery, error) {
+	payload.DeliveryID = uuid.NewString()
+	data, err := json.Marshal(payload)
+	if err != nil {
+		return dbWebhook.WebhookDelivery{}, err
+	}
+
+	currentTime := time.Now().UnixMilli()
+	delivery := dbWebhook.WebhookDelivery{
+		ProjectID:       webhook.ProjectID,
+		DeliveryID:      payload.DeliveryID,
+		WebhookID:       webhook.WebhookID,
+		Event:           payload.Event,
+		ExperimentID:    payload.ExperimentID,
+		ExperimentRunID: payload.ExperimentRunID,
+		NotifyID:        payload.NotifyID,
+		Status:          model.WebhookDeliveryStatusPending,
+		Payload:         string(data),
+		CreatedAt:       currentTime,
+		UpdatedAt:       currentTime,
+	}
+	if err := w.webhookOperator.InsertWebhookDelivery(ctx, delivery); err != nil {
+		return dbWebhook.WebhookDelivery{}, err
+	}
+	return delivery, nil
+}
+
+// deliver posts the delivery to the webhook until the endpoint responds with a 2xx status code,
+// the failed attempts are retried with an exponential backoff and every attempt is recorded in the delivery log
+func (w *webhookService) deliver(webhook dbWebhook.Webhook, delivery dbWebhook.WebhookDelivery) {
+	logFields := logrus.Fields{
+		"projectId":  webhook.ProjectID,
+		"webhookId":  webhook.WebhookID,
+		"deliveryId": delivery.DeliveryID,
+	}
+
+	backoff := deliveryBackoff
+	for attempt := 1; attempt <= maxDeliveryAttempts; attempt++ {
+		statusCode, err := sendDelivery(webhook.URL, webhook.Secret, delivery)
+
+		status := model.WebhookDeliveryStatusPending
+		errMessage := ""
+		switch {
+		case err != nil:
+			errMessage = err.Error()
+		case statusCode < 200 || statusCode > 299:
+			errMessage = fmt.Sprintf("endpoint responded with status code %d", statusCode)
+		default:
+			status = model.WebhookDeliveryStatusSucceeded
+		}
+		if status != model.WebhookDeliveryStatusSucceeded && attempt == maxDeliveryAttempts {
+			status = model.WebhookDeliveryStatusFailed
+		}
+
+		err = w.webhookOperator.UpdateWebhookDelivery(context.Background(), bson.D{
+			{"delivery_id", delivery.DeliveryID},
+		}, bson.D{
+			{"$set", bson.D{
+				{"status", status},
+				{"attempts", attempt},
+				{"response_code", statusCode},
+				{"error", errMessage},
+				{"updated_at", time.Now().UnixMilli()},
+			}},
+		})
+		if err != nil {
+			logrus.WithFields(logFields).Errorf("failed to update the webhook delivery, error: %v", err)
+		}
+
+		if status == model.WebhookDeliveryStatusSucceeded {
+			return
+		}
+		logrus.WithFields(logFields).Warnf("attempt %d of the webhook delivery failed, error: %s", attempt, errMessage)
+		if attempt < maxDeliveryAttempts {
+			time.Sleep(backoff)
+			backoff *= 2
+		}
+	}
+}
+
+// sendDelivery makes a single attempt to post the payload of the delivery to the endpoint
+func sendDelivery(url string, secret string, delivery dbWebhook.WebhookDelivery) (int, error) {
+	statusCode, _, err := utils.RestCall(http.MethodPost, url, []byte(delivery.Payload),
+		utils.WithMaxRetries(1),
+		utils.WithTimeout(deliveryTimeout),
+		utils.WithHeaders(map[string]string{
+			"Content-Type":  "application/json",
+			EventHeader:     string(delivery.Event),
+			DeliveryHeader:  delivery.DeliveryID,
+			SignatureHeader: "sha256=" + GetPayloadSignature([]byte(delivery.Payload), secret),
+		}),
+	)
+	return statusCode, err
+}
+
+// GetPayloadSignature returns the hex encoded HMAC-SHA256 of the payload, the receivers of
+// the webhooks compute it with their secret to verify that the payload was sent by the server
+func GetPayloadSignature(payload []byte, secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write(payload)
+	return hex.EncodeToString(mac.Sum(nil))
+}
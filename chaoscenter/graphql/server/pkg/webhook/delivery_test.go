@@ -0,0 +1,49 @@
+package webhook
+
+import (
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbWebhook "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/webhook"
+)
+
+func TestSendDelivery(t *testing.T) {
+	delivery := dbWebhook.WebhookDelivery{
+		DeliveryID: "delivery-1",
+		Event:      model.WebhookEventTypeRunCompleted,
+		Payload:    `{"event":"RUN_COMPLETED"}`,
+	}
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		if r.Header.Get(SignatureHeader) != "sha256="+GetPayloadSignature(body, "secret") {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.Header.Get(EventHeader) != string(model.WebhookEventTypeRunCompleted) || r.Header.Get(DeliveryHeader) != "delivery-1" {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	statusCode, err := sendDelivery(server.URL, "secret", delivery)
+	if err != nil {
+		t.Fatalf("sendDelivery() error = %v", err)
+	}
+	if statusCode != http.StatusNoContent {
+		t.Errorf("sendDelivery() status code = %d, want %d", statusCode, http.StatusNoContent)
+	}
+
+	statusCode, err = sendDelivery(server.URL, "wrong-secret", delivery)
+	if err != nil {
+		t.Fatalf("sendDelivery() error = %v", err)
+	}
+	if statusCode != http.StatusUnauthorized {
+		t.Errorf("sendDelivery() with a wrong secret status code = %d, want %d", statusCode, http.StatusUnauthorized)
+	}
+}
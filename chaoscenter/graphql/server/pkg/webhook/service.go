@@ -0,0 +1,426 @@
+package webhook
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	store "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
+	dbWebhook "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/webhook"
+	"github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+const (
+	defaultDeliveriesLimit = 50
+	maxDeliveriesLimit     = 500
+)
+
+// Service is the interface for the webhook service
+type Service interface {
+	CreateWebhook(ctx context.Context, projectID string, request model.CreateWebhookRequest, username string) (*model.Webhook, error)
+	UpdateWebhook(ctx context.Context, projectID string, request model.UpdateWebhookRequest, username string) (*model.Webhook, error)
+	DeleteWebhook(ctx context.Context, projectID string, webhookID string, username string) (bool, error)
+	GetWebhook(ctx context.Context, projectID string, webhookID string) (*model.Webhook, error)
+	ListWebhooks(ctx context.Context, projectID string) ([]*model.Webhook, error)
+	ListWebhookDeliveries(ctx context.Context, projectID string, webhookID string, limit *int) ([]*model.WebhookDelivery, error)
+	NotifyExperimentRun(ctx context.Context, event model.WebhookEventType, experimentRun dbChaosExperimentRun.ChaosExperimentRun)
+	ExperimentRunStarted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun)
+	ExperimentRunCompleted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, r *store.StateData)
+}
+
+// webhookService is the implementation of the webhook service
+type webhookService struct {
+	webhookOperator         *dbWebhook.Operator
+	chaosExperimentOperator *dbChaosExperiment.Operator
+	chaosInfraOperator      *dbChaosInfra.Operator
+}
+
+// NewWebhookService returns a new instance of the webhook service
+func NewWebhookService(webhookOperator *dbWebhook.Operator, chaosExperimentOperator *dbChaosExperiment.Operator, chaosInfraOperator *dbChaosInfra.Operator) Service {
+	return &webhookService{
+		webhookOperator:         webhookOperator,
+		chaosExperimentOperator: chaosExperimentOperator,
+		chaosInfraOperator:      chaosInfraOperator,
+	}
+}
+
+// CreateWebhook validates the endpoint of the webhook and stores it in the database
+func (w *webhookService) CreateWebhook(ctx context.Context, projectID string, request model.CreateWebhookRequest, username string) (*model.Webhook, error) {
+	if err := validateWebhookURL(request.URL); err != nil {
+		return nil, err
+	}
+	if request.Secret == "" {
+		return nil, errors.New("secret of the webhook can't be empty")
+	}
+
+	description := ""
+	if request.Description != nil {
+		description = *request.Description
+	}
+	isEnabled := true
+	if request.IsEnabled != nil {
+		isEnabled = *request.IsEnabled
+	}
+	currentTime := time.Now().UnixMilli()
+	webhook := dbWebhook.Webhook{
+		ResourceDetails: mongodb.ResourceDetails{
+			Name:        request.Name,
+			Description: description,
+			Tags:        request.Tags,
+		},
+		Audit: mongodb.Audit{
+			CreatedAt: currentTime,
+			UpdatedAt: currentTime,
+			CreatedBy: mongodb.UserDetailResponse{
+				Username: username,
+			},
+			UpdatedBy: mongodb.UserDetailResponse{
+				Username: username,
+			},
+		},
+		ProjectID: projectID,
+		WebhookID: uuid.NewString(),
+		URL:       request.URL,
+		Secret:    request.Secret,
+		Filter:    getWebhookFilter(request.Filter),
+		IsEnabled: isEnabled,
+	}
+	if err := w.webhookOperator.InsertWebhook(ctx, webhook); err != nil {
+		return nil, err
+	}
+
+	return getModelWebhook(webhook), nil
+}
+
+// UpdateWebhook replaces the details of the webhook, the secret is kept if a new one is not provided
+func (w *webhookService) UpdateWebhook(ctx context.Context, projectID string, request model.UpdateWebhookRequest, username string) (*model.Webhook, error) {
+	if err := validateWebhookURL(request.URL); err != nil {
+		return nil, err
+	}
+
+	description := ""
+	if request.Description != nil {
+		description = *request.Description
+	}
+	set := bson.D{
+		{"name", request.Name},
+		{"description", description},
+		{"tags", request.Tags},
+		{"url", request.URL},
+		{"filter", getWebhookFilter(request.Filter)},
+		{"is_enabled", request.IsEnabled},
+		{"updated_at", time.Now().UnixMilli()},
+		{"updated_by", mongodb.UserDetailResponse{
+			Username: username,
+		}},
+	}
+	if request.Secret != nil {
+		if *request.Secret == "" {
+			return nil, errors.New("secret of the webhook can't be empty")
+		}
+		set = append(set, bson.E{Key: "secret", Value: *request.Secret})
+	}
+
+	matched, err := w.webhookOperator.UpdateWebhook(ctx, bson.D{
+		{"webhook_id", request.WebhookID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}, bson.D{{"$set", set}})
+	if err != nil {
+		return nil, err
+	}
+	if matched == 0 {
+		return nil, fmt.Errorf("webhook %s not found", request.WebhookID)
+	}
+
+	return w.GetWebhook(ctx, projectID, request.WebhookID)
+}
+
+// DeleteWebhook marks the webhook as removed, the pending deliveries are still attempted
+func (w *webhookService) DeleteWebhook(ctx context.Context, projectID string, webhookID string, username string) (bool, error) {
+	matched, err := w.webhookOperator.UpdateWebhook(ctx, bson.D{
+		{"webhook_id", webhookID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	}, bson.D{
+		{"$set", bson.D{
+			{"is_removed", true},
+			{"updated_at", time.Now().UnixMilli()},
+			{"updated_by", mongodb.UserDetailResponse{
+				Username: username,
+			}},
+		}},
+	})
+	if err != nil {
+		return false, err
+	}
+	if matched == 0 {
+		return false, fmt.Errorf("webhook %s not found", webhookID)
+	}
+
+	return true, nil
+}
+
+// GetWebhook returns the details of the webhook
+func (w *webhookService) GetWebhook(ctx context.Context, projectID string, webhookID string) (*model.Webhook, error) {
+	webhook, err := w.webhookOperator.GetWebhook(ctx, bson.D{
+		{"webhook_id", webhookID},
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		if errors.Is(err, mongo.ErrNoDocuments) {
+			return nil, fmt.Errorf("webhook %s not found", webhookID)
+		}
+		return nil, err
+	}
+
+	return getModelWebhook(webhook), nil
+}
+
+// ListWebhooks returns the webhooks of the project
+func (w *webhookService) ListWebhooks(ctx context.Context, projectID string) ([]*model.Webhook, error) {
+	webhooks, err := w.webhookOperator.GetWebhooks(ctx, bson.D{
+		{"project_id", projectID},
+		{"is_removed", false},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.Webhook{}
+	for _, webhook := range webhooks {
+		result = append(result, getModelWebhook(webhook))
+	}
+	return result, nil
+}
+
+// ListWebhookDeliveries returns the latest deliveries of the webhook, the latest delivery first
+func (w *webhookService) ListWebhookDeliveries(ctx context.Context, projectID string, webhookID string, limit *int) ([]*model.WebhookDelivery, error) {
+	deliveriesLimit := defaultDeliveriesLimit
+	if limit != nil && *limit > 0 {
+		deliveriesLimit = *limit
+	}
+	if deliveriesLimit > maxDeliveriesLimit {
+		deliveriesLimit = maxDeliveriesLimit
+	}
+
+	deliveries, err := w.webhookOperator.GetWebhookDeliveries(ctx, bson.D{
+		{"webhook_id", webhookID},
+		{"project_id", projectID},
+	}, int64(deliveriesLimit))
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*model.WebhookDelivery{}
+	for _, delivery := range deliveries {
+		result = append(result, getModelWebhookDelivery(delivery))
+	}
+	return result, nil
+}
+
+// ExperimentRunStarted posts the start of the experiment run to the webhooks of its project
+func (w *webhookService) ExperimentRunStarted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun) {
+	w.NotifyExperimentRun(ctx, model.WebhookEventTypeRunStarted, experimentRun)
+}
+
+// ExperimentRunCompleted posts the completion of the experiment run to the webhooks of its project,
+// the event is decided by the phase of the experiment run
+func (w *webhookService) ExperimentRunCompleted(ctx context.Context, experimentRun dbChaosExperimentRun.ChaosExperimentRun, _ *store.StateData) {
+	event, ok := getCompletedRunEvent(experimentRun.Phase)
+	if !ok {
+		return
+	}
+	w.NotifyExperimentRun(ctx, event, experimentRun)
+}
+
+// NotifyExperimentRun creates a delivery of the event for every enabled webhook of the project whose filter
+// matches the experiment run, the deliveries are sent in the background so that the caller isn't blocked
+func (w *webhookService) NotifyExperimentRun(ctx context.Context, event model.WebhookEventType, experimentRun dbChaosExperimentRun.ChaosExperimentRun) {
+	logFields := logrus.Fields{
+		"projectId":            experimentRun.ProjectID,
+		"chaosExperimentId":    experimentRun.ExperimentID,
+		"chaosExperimentRunId": experimentRun.ExperimentRunID,
+		"event":                event,
+	}
+
+	webhooks, err := w.webhookOperator.GetWebhooks(ctx, bson.D{
+		{"project_id", experimentRun.ProjectID},
+		{"is_enabled", true},
+		{"is_removed", false},
+	})
+	if err != nil {
+		logrus.WithFields(logFields).Errorf("failed to get the webhooks of the project, error: %v", err)
+		return
+	}
+	if len(webhooks) == 0 {
+		return
+	}
+
+	payload := EventPayload{
+		Event:           event,
+		Timestamp:       time.Now().UnixMilli(),
+		ProjectID:       experimentRun.ProjectID,
+		ExperimentID:    experimentRun.ExperimentID,
+		ExperimentName:  experimentRun.ExperimentName,
+		ExperimentRunID: experimentRun.ExperimentRunID,
+		InfraID:         experimentRun.InfraID,
+		Phase:           experimentRun.Phase,
+	}
+	if experimentRun.NotifyID != nil {
+		payload.NotifyID = *experimentRun.NotifyID
+	}
+	if event != model.WebhookEventTypeRunStarted {
+		payload.ResiliencyScore = experimentRun.ResiliencyScore
+		payload.FaultsPassed = experimentRun.FaultsPassed
+		payload.FaultsFailed = experimentRun.FaultsFailed
+	}
+
+	experiment, err := w.chaosExperimentOperator.GetExperiment(ctx, bson.D{
+		{"experiment_id", experimentRun.ExperimentID},
+	})
+	if err != nil {
+		logrus.WithFields(logFields).Warnf("failed to get the experiment of the experiment run, error: %v", err)
+	} else {
+		payload.ExperimentName = experiment.Name
+		payload.Tags = experiment.Tags
+	}
+	if infra, err := w.chaosInfraOperator.GetInfra(experimentRun.InfraID); err != nil {
+		logrus.WithFields(logFields).Warnf("failed to get the infra of the experiment run, error: %v", err)
+	} else {
+		payload.EnvironmentID = infra.EnvironmentID
+	}
+
+	for _, webhook := range webhooks {
+		if !matchesWebhookFilter(webhook.Filter, event, payload.EnvironmentID, payload.Tags) {
+			continue
+		}
+
+		delivery, err := w.createDelivery(ctx, webhook, payload)
+		if err != nil {
+			logrus.WithFields(logFields).Errorf("failed to create the delivery of webhook %s, error: %v", webhook.WebhookID, err)
+			continue
+		}
+		go w.deliver(webhook, delivery)
+	}
+}
+
+// getCompletedRunEvent returns the event of a completed experiment run, the skipped runs don't have an event
+func getCompletedRunEvent(phase string) (model.WebhookEventType, bool) {
+	switch model.ExperimentRunStatus(phase) {
+	case model.ExperimentRunStatusCompleted:
+		return model.WebhookEventTypeRunCompleted, true
+	case model.ExperimentRunStatusCompletedWithError, model.ExperimentRunStatusError, model.ExperimentRunStatusTerminated:
+		return model.WebhookEventTypeRunFailed, true
+	case model.ExperimentRunStatusTimeout:
+		return model.WebhookEventTypeRunTimedOut, true
+	case model.ExperimentRunStatusStopped:
+		return model.WebhookEventTypeRunStopped, true
+	}
+	return "", false
+}
+
+// matchesWebhookFilter checks if the event of an experiment run is posted to a webhook, empty fields of the filter match everything
+func matchesWebhookFilter(filter dbWebhook.WebhookFilter, event model.WebhookEventType, environmentID string, tags []string) bool {
+	if len(filter.Events) > 0 && !slices.Contains(filter.Events, event) {
+		return false
+	}
+	if len(filter.EnvironmentIDs) > 0 && !slices.Contains(filter.EnvironmentIDs, environmentID) {
+		return false
+	}
+	if len(filter.Tags) > 0 {
+		for _, tag := range tags {
+			if slices.Contains(filter.Tags, tag) {
+				return true
+			}
+		}
+		return false
+	}
+	return true
+}
+
+func validateWebhookURL(webhookURL string) error {
+	parsedURL, err := url.ParseRequestURI(webhookURL)
+	if err != nil || (parsedURL.Scheme != "http" && parsedURL.Scheme != "https") || parsedURL.Host == "" {
+		return fmt.Errorf("invalid webhook url %s, it should be an absolute http or https url", webhookURL)
+	}
+	return nil
+}
+
+func getWebhookFilter(filter *model.WebhookFilterInput) dbWebhook.WebhookFilter {
+	if filter == nil {
+		return dbWebhook.WebhookFilter{}
+	}
+	return dbWebhook.WebhookFilter{
+		Events:         filter.Events,
+		EnvironmentIDs: filter.EnvironmentIDs,
+		Tags:           filter.Tags,
+	}
+}
+
+func getModelWebhook(webhook dbWebhook.Webhook) *model.Webhook {
+	description := webhook.Description
+	return &model.Webhook{
+		ProjectID:   webhook.ProjectID,
+		WebhookID:   webhook.WebhookID,
+		Name:        webhook.Name,
+		Description: &description,
+		Tags:        webhook.Tags,
+		URL:         webhook.URL,
+		Filter: &model.WebhookFilter{
+			Events:         webhook.Filter.Events,
+			EnvironmentIDs: webhook.Filter.EnvironmentIDs,
+			Tags:           webhook.Filter.Tags,
+		},
+		IsEnabled: webhook.IsEnabled,
+		CreatedAt: strconv.FormatInt(webhook.CreatedAt, 10),
+		UpdatedAt: strconv.FormatInt(webhook.UpdatedAt, 10),
+		CreatedBy: &model.UserDetails{
+			Username: webhook.CreatedBy.Username,
+		},
+		UpdatedBy: &model.UserDetails{
+			Username: webhook.UpdatedBy.Username,
+		},
+		IsRemoved: webhook.IsRemoved,
+	}
+}
+
+func getModelWebhookDelivery(delivery dbWebhook.WebhookDelivery) *model.WebhookDelivery {
+	result := &model.WebhookDelivery{
+		DeliveryID:   delivery.DeliveryID,
+		WebhookID:    delivery.WebhookID,
+		Event:        delivery.Event,
+		ExperimentID: delivery.ExperimentID,
+		Status:       delivery.Status,
+		Attempts:     delivery.Attempts,
+		Payload:      delivery.Payload,
+		CreatedAt:    strconv.FormatInt(delivery.CreatedAt, 10),
+		UpdatedAt:    strconv.FormatInt(delivery.UpdatedAt, 10),
+	}
+	if delivery.ExperimentRunID != "" {
+		result.ExperimentRunID = &delivery.ExperimentRunID
+	}
+	if delivery.NotifyID != "" {
+		result.NotifyID = &delivery.NotifyID
+	}
+	if delivery.ResponseCode != 0 {
+		result.ResponseCode = &delivery.ResponseCode
+	}
+	if delivery.Error != "" {
+		result.Error = &delivery.Error
+	}
+	return result
+}
@@ -0,0 +1,114 @@
+package webhook
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	dbWebhook "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/webhook"
+)
+
+func TestMatchesWebhookFilter(t *testing.T) {
+	tests := []struct {
+		name          string
+		filter        dbWebhook.WebhookFilter
+		event         model.WebhookEventType
+		environmentID string
+		tags          []string
+		want          bool
+	}{
+		{
+			name:  "empty filter matches all the runs",
+			event: model.WebhookEventTypeRunStarted,
+			want:  true,
+		},
+		{
+			name: "all the fields of the filter match",
+			filter: dbWebhook.WebhookFilter{
+				Events:         []model.WebhookEventType{model.WebhookEventTypeRunFailed, model.WebhookEventTypeRunTimedOut},
+				EnvironmentIDs: []string{"prod"},
+				Tags:           []string{"payments", "checkout"},
+			},
+			event:         model.WebhookEventTypeRunFailed,
+			environmentID: "prod",
+			tags:          []string{"checkout"},
+			want:          true,
+		},
+		{
+			name: "event is not in the filter",
+			filter: dbWebhook.WebhookFilter{
+				Events: []model.WebhookEventType{model.WebhookEventTypeRunFailed},
+			},
+			event: model.WebhookEventTypeRunCompleted,
+			want:  false,
+		},
+		{
+			name: "environment is not in the filter",
+			filter: dbWebhook.WebhookFilter{
+				EnvironmentIDs: []string{"prod"},
+			},
+			event:         model.WebhookEventTypeRunCompleted,
+			environmentID: "staging",
+			want:          false,
+		},
+		{
+			name: "experiment has none of the tags",
+			filter: dbWebhook.WebhookFilter{
+				Tags: []string{"payments"},
+			},
+			event: model.WebhookEventTypeRunCompleted,
+			tags:  []string{"checkout"},
+			want:  false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := matchesWebhookFilter(tc.filter, tc.event, tc.environmentID, tc.tags); got != tc.want {
+				t.Errorf("matchesWebhookFilter() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGetCompletedRunEvent(t *testing.T) {
+	tests := []struct {
+		phase     model.ExperimentRunStatus
+		wantEvent model.WebhookEventType
+		wantOk    bool
+	}{
+		{phase: model.ExperimentRunStatusCompleted, wantEvent: model.WebhookEventTypeRunCompleted, wantOk: true},
+		{phase: model.ExperimentRunStatusCompletedWithError, wantEvent: model.WebhookEventTypeRunFailed, wantOk: true},
+		{phase: model.ExperimentRunStatusError, wantEvent: model.WebhookEventTypeRunFailed, wantOk: true},
+		{phase: model.ExperimentRunStatusTerminated, wantEvent: model.WebhookEventTypeRunFailed, wantOk: true},
+		{phase: model.ExperimentRunStatusTimeout, wantEvent: model.WebhookEventTypeRunTimedOut, wantOk: true},
+		{phase: model.ExperimentRunStatusStopped, wantEvent: model.WebhookEventTypeRunStopped, wantOk: true},
+		{phase: model.ExperimentRunStatusSkipped, wantOk: false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.phase.String(), func(t *testing.T) {
+			gotEvent, gotOk := getCompletedRunEvent(tc.phase.String())
+			if gotEvent != tc.wantEvent || gotOk != tc.wantOk {
+				t.Errorf("getCompletedRunEvent() = (%v, %v), want (%v, %v)", gotEvent, gotOk, tc.wantEvent, tc.wantOk)
+			}
+		})
+	}
+}
+
+func TestValidateWebhookURL(t *testing.T) {
+	tests := []struct {
+		url     string
+		wantErr bool
+	}{
+		{url: "https://hooks.example.com/litmus", wantErr: false},
+		{url: "http://10.0.0.1:8080/events", wantErr: false},
+		{url: "ftp://hooks.example.com", wantErr: true},
+		{url: "/relative/path", wantErr: true},
+		{url: "", wantErr: true},
+	}
+	for _, tc := range tests {
+		t.Run(tc.url, func(t *testing.T) {
+			if err := validateWebhookURL(tc.url); (err != nil) != tc.wantErr {
+				t.Errorf("validateWebhookURL() error = %v, wantErr %v", err, tc.wantErr)
+			}
+		})
+	}
+}
@@ -16,21 +16,42 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/gorilla/websocket"
 	"github.com/kelseyhightower/envconfig"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
 	log "github.com/sirupsen/logrus"
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/api/middleware"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/generated"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/audit"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/authorization"
+	chaosExperimentOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment/ops"
+	chaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaos_experiment_run"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub"
 	handler2 "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/chaoshub/handler"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/cloudevents"
+	dataStore "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/data-store"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb"
+	dbAudit "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/audit"
+	dbChaosExperiment "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment"
+	dbChaosExperimentRun "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_experiment_run"
 	dbSchemaChaosHub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_hub"
+	dbChaosInfra "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/chaos_infrastructure"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/config"
+	dbGitOps "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/gitops"
+	dbSchemaProbe "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/probe"
+	dbPubSub "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/database/mongodb/pubsub"
+	envHandler "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/environment/handler"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/gitops"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/handlers"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/leader"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/metrics"
+	probe "github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/probe/handler"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/projects"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/retention"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/pkg/tracing"
 	pb "github.com/litmuschaos/litmus/chaoscenter/graphql/server/protos"
 	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/utils"
 )
@@ -76,12 +97,22 @@ func setupGin() *gin.Engine {
 	router.Use(middleware.DefaultStructuredLogger())
 	router.Use(gin.Recovery())
 	router.Use(middleware.ValidateCors())
+	router.Use(tracing.Middleware())
 	return router
 }
 
 func main() {
+	shutdownTracer, err := tracing.InitTracer(context.Background(), utils.Config.OtelExporterOtlpEndpoint)
+	if err != nil {
+		log.Fatalf("unable to set up the tracer %v", err)
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			log.Errorf("unable to flush the spans %v", err)
+		}
+	}()
+
 	router := setupGin()
-	var err error
 	mongodb.MgoClient, err = mongodb.MongoConnection()
 	if err != nil {
 		log.Fatal(err)
@@ -96,6 +127,18 @@ func main() {
 		log.Fatal(err)
 	}
 
+	// the subscriptions are shared between the replicas of the server through the pub/sub backend
+	switch utils.Config.PubSubBackend {
+	case "memory":
+	case "mongodb":
+		pubSub := dataStore.NewMongoPubSub(dbPubSub.NewPubSubOperator(mongodbOperator))
+		if err := dataStore.Store.UsePubSub(context.Background(), pubSub); err != nil {
+			log.Fatalf("unable to subscribe to the pub/sub messages %v", err)
+		}
+	default:
+		log.Fatalf("unknown pub/sub backend %s, it should be memory or mongodb", utils.Config.PubSubBackend)
+	}
+
 	enableHTTPSConnection, err := strconv.ParseBool(utils.Config.EnableInternalTls)
 	if err != nil {
 		log.Errorf("unable to parse boolean value %v", err)
@@ -110,7 +153,8 @@ func main() {
 		go startGRPCServer(utils.Config.GrpcPort, mongodbOperator) // start GRPC serve
 	}
 
-	srv := handler.New(generated.NewExecutableSchema(graph.NewConfig(mongodbOperator)))
+	gqlConfig := graph.NewConfig(mongodbOperator)
+	srv := handler.New(generated.NewExecutableSchema(gqlConfig))
 	srv.AddTransport(transport.POST{})
 	srv.AddTransport(transport.GET{})
 	srv.AddTransport(transport.Websocket{
@@ -139,10 +183,55 @@ func main() {
 	if enableIntrospection {
 		srv.Use(extension.Introspection{})
 	}
+	srv.Use(metrics.ResolverLatency{})
+	srv.Use(tracing.ResolverTracing{})
+	srv.Use(audit.Recorder{Service: audit.NewAuditService(dbAudit.NewAuditOperator(mongodbOperator), mongodbOperator)})
 
-	// go routine for syncing chaos hubs
-	go chaoshub.NewService(dbSchemaChaosHub.NewChaosHubOperator(mongodbOperator)).RecurringHubSync()
-	go chaoshub.NewService(dbSchemaChaosHub.NewChaosHubOperator(mongodbOperator)).SyncDefaultChaosHubs()
+	if err := metrics.RegisterStateCollector(dataStore.Store); err != nil {
+		log.Errorf("unable to register the state metrics collector %v", err)
+	}
+
+	// the chaos hubs are cloned in the local directories of every replica which serves their faults and experiments
+	hubService := chaoshub.NewService(dbSchemaChaosHub.NewChaosHubOperator(mongodbOperator))
+	go hubService.RecurringHubSync(context.Background())
+	go hubService.SyncDefaultChaosHubs(context.Background())
+
+	// the background jobs changing the database only run on the replica holding their lease, another replica takes
+	// over a job once its leader fails
+	probeService := probe.NewProbeService(dbSchemaProbe.NewChaosProbeOperator(mongodbOperator))
+	chaosExperimentOperator := dbChaosExperiment.NewChaosExperimentOperator(mongodbOperator)
+	chaosExperimentService := chaosExperimentOps.NewChaosExperimentService(chaosExperimentOperator,
+		dbChaosInfra.NewInfrastructureOperator(mongodbOperator), dbChaosExperimentRun.NewChaosExperimentRunOperator(mongodbOperator), probeService)
+	gitOpsService := gitops.NewGitOpsService(dbGitOps.NewGitOpsOperator(mongodbOperator), chaosExperimentService, *chaosExperimentOperator, mongodbOperator)
+	blackoutService := envHandler.NewBlackoutService(chaosExperimentService, probeService, mongodbOperator)
+	runDispatcher := chaosExperimentRun.NewRunDispatcher(mongodbOperator)
+	projectEventChannel := make(chan string)
+
+	elector := leader.NewElector()
+	elector.Register("gitops-sync", func(ctx context.Context) {
+		gitOpsService.GitOpsSyncHandler(ctx, false)
+	})
+	elector.Register("project-events", func(ctx context.Context) {
+		projects.ProjectEvents(ctx, projectEventChannel, mongodb.MgoClient, mongodbOperator)
+	})
+	// suspends and resumes the cron experiments during the blackout windows of environments
+	elector.Register("blackout-sync", func(ctx context.Context) {
+		blackoutService.RecurringBlackoutSync(ctx, dataStore.Store)
+	})
+	elector.Register("run-dispatch", func(ctx context.Context) {
+		runDispatcher.RecurringDispatch(ctx, dataStore.Store)
+	})
+	// prunes the execution data of the experiment runs beyond the retention policies
+	elector.Register("run-retention", retention.NewRunPruner(mongodbOperator).RecurringPrune)
+	// completes the experiment runs which aren't updated by their infra anymore, the handler of the resolvers
+	// is used so that the experiment run listeners are notified of the runs which timed out
+	if utils.Config.RunTimeoutMinutes > 0 {
+		runHandler := gqlConfig.Resolvers.(*graph.Resolver).ExperimentRunHandler()
+		elector.Register("run-timeout", func(ctx context.Context) {
+			runHandler.RecurringTimeoutReconcile(ctx, time.Duration(utils.Config.RunTimeoutMinutes)*time.Minute, dataStore.Store)
+		})
+	}
+	elector.Start(context.Background())
 
 	// routers
 	router.GET("/", handlers.PlaygroundHandler())
@@ -154,12 +243,13 @@ func main() {
 	router.GET("/icon/:projectId/:hubName/:chartName/:iconName", handler2.ChaosHubIconHandler())
 	router.GET("/icon/default/:hubName/:chartName/:iconName", handler2.DefaultChaosHubIconHandler())
 
+	//cloudevents routers
+	router.GET("/cloudevents/schemas/:schema", cloudevents.SchemaHandler())
+
 	//general routers
 	router.GET("/status", handlers.StatusHandler())
 	router.GET("/readiness", handlers.ReadinessHandler())
-
-	projectEventChannel := make(chan string)
-	go projects.ProjectEvents(projectEventChannel, mongodb.MgoClient, mongodbOperator)
+	router.GET("/metrics", gin.WrapH(promhttp.Handler()))
 
 	if enableHTTPSConnection {
 		if utils.Config.TlsCertPath == "" || utils.Config.TlsKeyPath == "" {
@@ -191,7 +281,7 @@ func startGRPCServer(port string, mongodbOperator mongodb.MongoOperator) {
 		log.Fatal("failed to listen: %w", err)
 	}
 
-	grpcServer := grpc.NewServer()
+	grpcServer := grpc.NewServer(grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
 	// Register services
 
@@ -216,7 +306,7 @@ func startGRPCServerWithTLS(mongodbOperator mongodb.MongoOperator) {
 	tlsCredentials := credentials.NewTLS(conf)
 
 	// create grpc server with tls credential
-	grpcServer := grpc.NewServer(grpc.Creds(tlsCredentials))
+	grpcServer := grpc.NewServer(grpc.Creds(tlsCredentials), grpc.StatsHandler(otelgrpc.NewServerHandler()))
 
 	// Register services
 
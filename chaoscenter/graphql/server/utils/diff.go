@@ -0,0 +1,153 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+// DiffManifests returns the structured differences between two YAML or JSON manifests.
+// Every changed leaf is reported with its path, list elements having a unique name are
+// addressed by name instead of index and embedded kubernetes manifests (e.g. the raw
+// ChaosEngine artifacts of a workflow) are expanded so that changes inside them are reported as well
+func DiffManifests(oldManifest, newManifest string) ([]*model.ManifestDiff, error) {
+	oldFields, err := flattenManifest(oldManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse old manifest, error: %v", err)
+	}
+	newFields, err := flattenManifest(newManifest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse new manifest, error: %v", err)
+	}
+
+	var diffs []*model.ManifestDiff
+	for path, oldValue := range oldFields {
+		oldValue := oldValue
+		newValue, ok := newFields[path]
+		if !ok {
+			diffs = append(diffs, &model.ManifestDiff{
+				Path:       path,
+				ChangeType: model.DiffChangeTypeRemoved,
+				OldValue:   &oldValue,
+			})
+			continue
+		}
+		if newValue != oldValue {
+			diffs = append(diffs, &model.ManifestDiff{
+				Path:       path,
+				ChangeType: model.DiffChangeTypeModified,
+				OldValue:   &oldValue,
+				NewValue:   &newValue,
+			})
+		}
+	}
+	for path, newValue := range newFields {
+		newValue := newValue
+		if _, ok := oldFields[path]; !ok {
+			diffs = append(diffs, &model.ManifestDiff{
+				Path:       path,
+				ChangeType: model.DiffChangeTypeAdded,
+				NewValue:   &newValue,
+			})
+		}
+	}
+
+	sort.Slice(diffs, func(i, j int) bool {
+		return diffs[i].Path < diffs[j].Path
+	})
+
+	return diffs, nil
+}
+
+// flattenManifest converts a manifest into a map of leaf paths and their JSON encoded values
+func flattenManifest(manifest string) (map[string]string, error) {
+	fields := make(map[string]string)
+	if strings.TrimSpace(manifest) == "" {
+		return fields, nil
+	}
+
+	var obj interface{}
+	if err := yaml.Unmarshal([]byte(manifest), &obj); err != nil {
+		return nil, err
+	}
+	flattenValue("", obj, fields)
+
+	return fields, nil
+}
+
+func flattenValue(path string, value interface{}, fields map[string]string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		if len(v) == 0 {
+			fields[path] = "{}"
+			return
+		}
+		for key, child := range v {
+			childPath := key
+			if path != "" {
+				childPath = path + "." + key
+			}
+			flattenValue(childPath, child, fields)
+		}
+	case []interface{}:
+		if len(v) == 0 {
+			fields[path] = "[]"
+			return
+		}
+		names, byName := listElementNames(v)
+		for i, child := range v {
+			if byName {
+				flattenValue(fmt.Sprintf("%s[name=%s]", path, names[i]), child, fields)
+			} else {
+				flattenValue(fmt.Sprintf("%s[%d]", path, i), child, fields)
+			}
+		}
+	case string:
+		if embedded, ok := parseEmbeddedManifest(v); ok {
+			flattenValue(path, embedded, fields)
+			return
+		}
+		fields[path] = v
+	default:
+		encoded, _ := json.Marshal(v)
+		fields[path] = string(encoded)
+	}
+}
+
+// listElementNames returns the names of all list elements if each of them is an object with a unique name
+func listElementNames(list []interface{}) ([]string, bool) {
+	names := make([]string, len(list))
+	seen := make(map[string]bool)
+	for i, element := range list {
+		obj, ok := element.(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := obj["name"].(string)
+		if !ok || name == "" || seen[name] {
+			return nil, false
+		}
+		seen[name] = true
+		names[i] = name
+	}
+	return names, true
+}
+
+// parseEmbeddedManifest parses a string value which holds a kubernetes manifest
+func parseEmbeddedManifest(value string) (map[string]interface{}, bool) {
+	if !strings.Contains(value, "kind") || !strings.Contains(value, "apiVersion") {
+		return nil, false
+	}
+	var obj map[string]interface{}
+	if err := yaml.Unmarshal([]byte(value), &obj); err != nil {
+		return nil, false
+	}
+	if _, ok := obj["kind"]; !ok {
+		return nil, false
+	}
+	return obj, true
+}
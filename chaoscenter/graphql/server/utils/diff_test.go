@@ -0,0 +1,107 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+)
+
+func TestDiffManifests(t *testing.T) {
+	oldManifest := `
+kind: Workflow
+apiVersion: argoproj.io/v1alpha1
+spec:
+  templates:
+    - name: pod-delete
+      inputs:
+        artifacts:
+          - name: pod-delete
+            raw:
+              data: |
+                apiVersion: litmuschaos.io/v1alpha1
+                kind: ChaosEngine
+                spec:
+                  experiments:
+                    - name: pod-delete
+                      spec:
+                        components:
+                          env:
+                            - name: TOTAL_CHAOS_DURATION
+                              value: "60"
+    - name: cleanup
+`
+	newManifest := `
+kind: Workflow
+apiVersion: argoproj.io/v1alpha1
+metadata:
+  labels:
+    team: sre
+spec:
+  templates:
+    - name: pod-delete
+      inputs:
+        artifacts:
+          - name: pod-delete
+            raw:
+              data: |
+                apiVersion: litmuschaos.io/v1alpha1
+                kind: ChaosEngine
+                spec:
+                  experiments:
+                    - name: pod-delete
+                      spec:
+                        components:
+                          env:
+                            - name: TOTAL_CHAOS_DURATION
+                              value: "120"
+`
+	tests := []struct {
+		name        string
+		oldManifest string
+		newManifest string
+		want        map[string]model.DiffChangeType
+		wantErr     bool
+	}{
+		{
+			name:        "success: identical manifests",
+			oldManifest: oldManifest,
+			newManifest: oldManifest,
+			want:        map[string]model.DiffChangeType{},
+		},
+		{
+			name:        "success: changes are reported inside embedded manifests",
+			oldManifest: oldManifest,
+			newManifest: newManifest,
+			want: map[string]model.DiffChangeType{
+				"metadata.labels.team":              model.DiffChangeTypeAdded,
+				"spec.templates[name=cleanup].name": model.DiffChangeTypeRemoved,
+				"spec.templates[name=pod-delete].inputs.artifacts[name=pod-delete].raw.data.spec.experiments[name=pod-delete].spec.components.env[name=TOTAL_CHAOS_DURATION].value": model.DiffChangeTypeModified,
+			},
+		},
+		{
+			name:        "failure: invalid manifest",
+			oldManifest: "kind: [",
+			newManifest: newManifest,
+			wantErr:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := DiffManifests(tc.oldManifest, tc.newManifest)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("DiffManifests() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("DiffManifests() returned %d changes, want %d", len(got), len(tc.want))
+			}
+			for _, diff := range got {
+				if changeType, ok := tc.want[diff.Path]; !ok || changeType != diff.ChangeType {
+					t.Errorf("DiffManifests() unexpected change %s %s", diff.ChangeType, diff.Path)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,160 @@
+package utils
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/tidwall/gjson"
+)
+
+// ExperimentInputsAnnotation is the annotation of the experiment manifest which declares the inputs of the experiment
+const ExperimentInputsAnnotation = "litmuschaos.io/experiment-inputs"
+
+var (
+	// experimentInputRegex matches the references of experiment inputs in a manifest, e.g. {{experiment.inputs.namespace}}
+	experimentInputRegex     = regexp.MustCompile(`\{\{\s*experiment\.inputs\.([A-Za-z0-9_-]+)\s*\}\}`)
+	experimentInputNameRegex = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// GetExperimentInputs returns the inputs declared in the annotation of the experiment manifest
+func GetExperimentInputs(manifest string) ([]*model.ExperimentInput, error) {
+	annotationPath := "metadata.annotations." + strings.ReplaceAll(ExperimentInputsAnnotation, ".", `\.`)
+	declaration := gjson.Get(manifest, annotationPath).String()
+	if strings.TrimSpace(declaration) == "" {
+		return nil, nil
+	}
+
+	var inputs []*model.ExperimentInput
+	if err := json.Unmarshal([]byte(declaration), &inputs); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal %s annotation, error: %v", ExperimentInputsAnnotation, err)
+	}
+
+	names := make(map[string]bool)
+	for _, input := range inputs {
+		if !experimentInputNameRegex.MatchString(input.Name) {
+			return nil, fmt.Errorf("invalid experiment input name: %q", input.Name)
+		}
+		if names[input.Name] {
+			return nil, fmt.Errorf("experiment input %s is declared more than once", input.Name)
+		}
+		names[input.Name] = true
+
+		input.Type = model.ExperimentInputType(strings.ToUpper(string(input.Type)))
+		if !input.Type.IsValid() {
+			return nil, fmt.Errorf("experiment input %s has invalid type %s", input.Name, input.Type)
+		}
+		if input.Default != nil {
+			if err := validateExperimentInputValue(input, *input.Default); err != nil {
+				return nil, fmt.Errorf("invalid default value of experiment input %s, error: %v", input.Name, err)
+			}
+		}
+	}
+
+	return inputs, nil
+}
+
+// ResolveExperimentInputs returns the value of every input declared in the experiment manifest. The provided values
+// are validated against the type of the inputs and the default values are used for the inputs without a value
+func ResolveExperimentInputs(manifest string, values []*model.ExperimentInputValueRequest) ([]*model.ExperimentInputValue, error) {
+	inputs, err := GetExperimentInputs(manifest)
+	if err != nil {
+		return nil, err
+	}
+
+	declared := make(map[string]*model.ExperimentInput)
+	for _, input := range inputs {
+		declared[input.Name] = input
+	}
+
+	provided := make(map[string]string)
+	for _, value := range values {
+		input, ok := declared[value.Name]
+		if !ok {
+			return nil, fmt.Errorf("experiment input %s is not declared in the experiment", value.Name)
+		}
+		if err := validateExperimentInputValue(input, value.Value); err != nil {
+			return nil, fmt.Errorf("invalid value of experiment input %s, error: %v", value.Name, err)
+		}
+		provided[value.Name] = value.Value
+	}
+
+	var resolved []*model.ExperimentInputValue
+	for _, input := range inputs {
+		value, ok := provided[input.Name]
+		if !ok {
+			if input.Default == nil {
+				if input.Required {
+					return nil, fmt.Errorf("value of required experiment input %s not provided", input.Name)
+				}
+				continue
+			}
+			value = *input.Default
+		}
+		resolved = append(resolved, &model.ExperimentInputValue{
+			Name:  input.Name,
+			Value: value,
+		})
+	}
+
+	return resolved, nil
+}
+
+// SubstituteExperimentInputs replaces the references of the experiment inputs in the JSON manifest with their values,
+// an error is returned if the manifest references an input without a value
+func SubstituteExperimentInputs(manifest string, values []*model.ExperimentInputValue) (string, error) {
+	valueMap := make(map[string]string)
+	for _, value := range values {
+		// Values are placed inside JSON strings of the manifest, hence they are escaped as JSON
+		escaped, err := json.Marshal(value.Value)
+		if err != nil {
+			return "", err
+		}
+		valueMap[value.Name] = string(escaped[1 : len(escaped)-1])
+	}
+
+	var missing []string
+	result := experimentInputRegex.ReplaceAllStringFunc(manifest, func(reference string) string {
+		name := experimentInputRegex.FindStringSubmatch(reference)[1]
+		value, ok := valueMap[name]
+		if !ok {
+			missing = append(missing, name)
+			return reference
+		}
+		return value
+	})
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return "", fmt.Errorf("experiment manifest references inputs without a value: %s", strings.Join(missing, ", "))
+	}
+
+	return result, nil
+}
+
+// ApplyDefaultExperimentInputs substitutes the default values of the experiment inputs in the manifest,
+// it is used where an experiment is sent to the infra without run-time inputs, e.g. for cron experiments
+func ApplyDefaultExperimentInputs(manifest string) (string, error) {
+	values, err := ResolveExperimentInputs(manifest, nil)
+	if err != nil {
+		return "", err
+	}
+	return SubstituteExperimentInputs(manifest, values)
+}
+
+func validateExperimentInputValue(input *model.ExperimentInput, value string) error {
+	switch input.Type {
+	case model.ExperimentInputTypeInteger:
+		if _, err := strconv.Atoi(value); err != nil {
+			return fmt.Errorf("%q is not an integer", value)
+		}
+	case model.ExperimentInputTypeBoolean:
+		if _, err := strconv.ParseBool(value); err != nil {
+			return fmt.Errorf("%q is not a boolean", value)
+		}
+	}
+	return nil
+}
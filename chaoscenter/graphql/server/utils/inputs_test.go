@@ -0,0 +1,150 @@
+package utils
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/litmuschaos/litmus/chaoscenter/graphql/server/graph/model"
+	"github.com/tidwall/gjson"
+)
+
+func getInputsTestManifest(t *testing.T, declaration string) string {
+	engine := "apiVersion: litmuschaos.io/v1alpha1\nkind: ChaosEngine\nspec:\n  appinfo:\n    appns: {{experiment.inputs.namespace}}\n  experiments:\n    - name: pod-delete\n      spec:\n        components:\n          env:\n            - name: TOTAL_CHAOS_DURATION\n              value: \"{{ experiment.inputs.duration }}\"\n"
+	engineData, err := json.Marshal(engine)
+	if err != nil {
+		t.Fatal(err)
+	}
+	annotation, err := json.Marshal(declaration)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return `{"kind":"Workflow","metadata":{"name":"exp","annotations":{"` + ExperimentInputsAnnotation + `":` + string(annotation) + `}},"spec":{"templates":[{"name":"pod-delete","inputs":{"artifacts":[{"name":"pod-delete","raw":{"data":` + string(engineData) + `}}]}}]}}`
+}
+
+func TestResolveExperimentInputs(t *testing.T) {
+	declaration := `[{"name":"namespace","type":"string","required":true},{"name":"duration","type":"integer","default":"60"},{"name":"dryRun","type":"boolean"}]`
+
+	tests := []struct {
+		name        string
+		declaration string
+		values      []*model.ExperimentInputValueRequest
+		want        map[string]string
+		wantErr     bool
+	}{
+		{
+			name:        "success: default values are used for the inputs without a value",
+			declaration: declaration,
+			values:      []*model.ExperimentInputValueRequest{{Name: "namespace", Value: "payments"}},
+			want:        map[string]string{"namespace": "payments", "duration": "60"},
+		},
+		{
+			name:        "success: provided values override the default values",
+			declaration: declaration,
+			values: []*model.ExperimentInputValueRequest{
+				{Name: "namespace", Value: "payments"},
+				{Name: "duration", Value: "30"},
+				{Name: "dryRun", Value: "true"},
+			},
+			want: map[string]string{"namespace": "payments", "duration": "30", "dryRun": "true"},
+		},
+		{
+			name:        "failure: required input without a value",
+			declaration: declaration,
+			wantErr:     true,
+		},
+		{
+			name:        "failure: value does not match the type of the input",
+			declaration: declaration,
+			values: []*model.ExperimentInputValueRequest{
+				{Name: "namespace", Value: "payments"},
+				{Name: "duration", Value: "one minute"},
+			},
+			wantErr: true,
+		},
+		{
+			name:        "failure: value of an undeclared input",
+			declaration: declaration,
+			values: []*model.ExperimentInputValueRequest{
+				{Name: "namespace", Value: "payments"},
+				{Name: "replicas", Value: "2"},
+			},
+			wantErr: true,
+		},
+		{
+			name:        "failure: input with an invalid type",
+			declaration: `[{"name":"namespace","type":"list"}]`,
+			wantErr:     true,
+		},
+		{
+			name:        "failure: input declared more than once",
+			declaration: `[{"name":"namespace","type":"string"},{"name":"namespace","type":"string"}]`,
+			wantErr:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ResolveExperimentInputs(getInputsTestManifest(t, tc.declaration), tc.values)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("ResolveExperimentInputs() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if len(got) != len(tc.want) {
+				t.Fatalf("ResolveExperimentInputs() returned %d values, want %d", len(got), len(tc.want))
+			}
+			for _, value := range got {
+				if want, ok := tc.want[value.Name]; !ok || want != value.Value {
+					t.Errorf("ResolveExperimentInputs() unexpected value %s=%s", value.Name, value.Value)
+				}
+			}
+		})
+	}
+}
+
+func TestSubstituteExperimentInputs(t *testing.T) {
+	manifest := getInputsTestManifest(t, `[{"name":"namespace","type":"string"},{"name":"duration","type":"integer"}]`)
+
+	tests := []struct {
+		name       string
+		values     []*model.ExperimentInputValue
+		wantEngine string
+		wantErr    bool
+	}{
+		{
+			name: "success: references are replaced with the values",
+			values: []*model.ExperimentInputValue{
+				{Name: "namespace", Value: "payments"},
+				{Name: "duration", Value: "30"},
+			},
+			wantEngine: "apiVersion: litmuschaos.io/v1alpha1\nkind: ChaosEngine\nspec:\n  appinfo:\n    appns: payments\n  experiments:\n    - name: pod-delete\n      spec:\n        components:\n          env:\n            - name: TOTAL_CHAOS_DURATION\n              value: \"30\"\n",
+		},
+		{
+			name: "success: values are escaped inside the manifest",
+			values: []*model.ExperimentInputValue{
+				{Name: "namespace", Value: `pay"ments`},
+				{Name: "duration", Value: "30"},
+			},
+			wantEngine: "apiVersion: litmuschaos.io/v1alpha1\nkind: ChaosEngine\nspec:\n  appinfo:\n    appns: pay\"ments\n  experiments:\n    - name: pod-delete\n      spec:\n        components:\n          env:\n            - name: TOTAL_CHAOS_DURATION\n              value: \"30\"\n",
+		},
+		{
+			name:    "failure: referenced input without a value",
+			values:  []*model.ExperimentInputValue{{Name: "namespace", Value: "payments"}},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := SubstituteExperimentInputs(manifest, tc.values)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SubstituteExperimentInputs() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if engine := gjson.Get(got, "spec.templates.0.inputs.artifacts.0.raw.data").String(); engine != tc.wantEngine {
+				t.Errorf("SubstituteExperimentInputs() engine = %q, want %q", engine, tc.wantEngine)
+			}
+		})
+	}
+}
@@ -39,6 +39,20 @@ type Configuration struct {
 	TlsCertPath                 string   `split_words:"true"`
 	TlsKeyPath                  string   `split_words:"true"`
 	CaCertTlsPath               string   `split_words:"true"`
+	CloudEventsSinkUrl          string   `split_words:"true"`
+	CloudEventsMode             string   `split_words:"true" default:"binary"`
+	CloudEventsSchemaBaseUrl    string   `split_words:"true"`
+	OtelExporterOtlpEndpoint    string   `split_words:"true"`
+	RunRetentionMaxAgeDays      int      `split_words:"true"`
+	RunRetentionMaxRuns         int      `split_words:"true"`
+	RunRetentionMode            string   `split_words:"true" default:"DELETE"`
+	RunArchiveSink              string   `split_words:"true"`
+	RunArchiveS3Endpoint        string   `split_words:"true"`
+	RunArchiveS3Region          string   `split_words:"true" default:"us-east-1"`
+	RunArchiveS3AccessKeyId     string   `split_words:"true"`
+	RunArchiveS3SecretAccessKey string   `split_words:"true"`
+	PubSubBackend               string   `split_words:"true" default:"memory"`
+	RunTimeoutMinutes           int      `split_words:"true"`
 	AllowedOrigins              []string `split_words:"true" default:"^(http://|https://|)litmuschaos.io(:[0-9]+|)?,^(http://|https://|)localhost(:[0-9]+|)"`
 }
 
@@ -139,8 +139,9 @@ func (ev *subscriberEvents) GetWorkflowObj(uid string) (*v1alpha1.Workflow, erro
 		return nil, err
 	}
 
+	// the workflows of the runs which aren't reported to the server yet are only known by their notify ID
 	for _, wf := range listWf.Items {
-		if string(wf.UID) == uid {
+		if string(wf.UID) == uid || wf.Labels["notify_id"] == uid {
 			return &wf, nil
 		}
 	}
@@ -189,3 +190,15 @@ func (ev *subscriberEvents) GenerateWorkflowPayload(cid, accessKey, version, com
 	var payload = []byte(`{"query":"mutation { chaosExperimentRun(request:` + mutation + ` )}"}`)
 	return payload, nil
 }
+
+// generateReconcilePayload generates graphql mutation payload reporting the experiment runs whose workflow exists on the infra
+func generateReconcilePayload(cid, accessKey, version string, experimentRunIDs []string) []byte {
+	infraID := `{infraID: \"` + cid + `\", version: \"` + version + `\", accessKey: \"` + accessKey + `\"}`
+	runIDs := make([]string, 0, len(experimentRunIDs))
+	for _, id := range experimentRunIDs {
+		runIDs = append(runIDs, `\"`+id+`\"`)
+	}
+
+	mutation := `{ infraID: ` + infraID + `, experimentRunIDs: [` + strings.Join(runIDs, ", ") + `]}`
+	return []byte(`{"query":"mutation { reconcileExperimentRuns(request:` + mutation + ` )}"}`)
+}
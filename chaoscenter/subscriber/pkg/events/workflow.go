@@ -65,6 +65,32 @@ func (ev *subscriberEvents) WorkflowEventWatcher(stopCh chan struct{}, stream ch
 		// Start Event Watch
 	}
 	go ev.startWatchWorkflow(stopCh, informer, stream, int64(startTime))
+	go ev.reconcileWorkflowRuns(stopCh, informer, infraData)
+}
+
+// reconcileWorkflowRuns reports the runs of the workflows on the infra to the server once the informer has synced,
+// the server terminates the running experiment runs of the infra whose workflow no longer exists
+func (ev *subscriberEvents) reconcileWorkflowRuns(stopCh <-chan struct{}, s cache.SharedIndexInformer, infraData map[string]string) {
+	if !cache.WaitForCacheSync(stopCh, s.HasSynced) {
+		return
+	}
+
+	experimentRunIDs := []string{}
+	for _, obj := range s.GetStore().List() {
+		workflowObj, ok := obj.(*v1alpha1.Workflow)
+		if !ok || workflowObj.Labels["workflow_id"] == "" {
+			continue
+		}
+		experimentRunIDs = append(experimentRunIDs, string(workflowObj.ObjectMeta.UID))
+	}
+
+	payload := generateReconcilePayload(infraData["INFRA_ID"], infraData["ACCESS_KEY"], infraData["VERSION"], experimentRunIDs)
+	body, err := ev.gqlSubscriberServer.SendRequest(infraData["SERVER_ADDR"], payload)
+	if err != nil {
+		logrus.WithError(err).Error("Failed to reconcile the experiment runs with the server")
+		return
+	}
+	logrus.Print("Response from the server: ", body)
 }
 
 // handles the different events events - add, update and delete
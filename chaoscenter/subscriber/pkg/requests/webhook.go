@@ -8,13 +8,16 @@ import (
 	"time"
 
 	"subscriber/pkg/types"
+	"subscriber/pkg/utils"
 
 	"github.com/gorilla/websocket"
 	"github.com/sirupsen/logrus"
 )
 
 func (req *subscriberRequests) AgentConnect(infraData map[string]string) {
-	query := `{"query":"subscription {\n    infraConnect(request: {infraID: \"` + infraData["INFRA_ID"] + `\", version: \"` + infraData["VERSION"] + `\", accessKey: \"` + infraData["ACCESS_KEY"] + `\"}) {\n     action{\n      k8sManifest,\n      externalData,\n      requestID\n requestType\n     username\n     namespace\n     }\n  }\n}\n"}`
+	// The trace context of the requests is only subscribed to when the tracing is enabled, the servers older than
+	// the tracing support reject the field in which case the subscription is started again without it
+	withTraceContext := infraData["OTEL_EXPORTER_OTLP_ENDPOINT"] != ""
 	serverURL, err := url.Parse(infraData["SERVER_ADDR"])
 	if err != nil {
 		logrus.WithError(err).Fatal("Failed to parse URL")
@@ -49,21 +52,7 @@ func (req *subscriberRequests) AgentConnect(infraData map[string]string) {
 			return
 		}
 
-		payload = types.OperationMessage{
-			Payload: []byte(query),
-			Type:    "start",
-		}
-
-		data, err = json.Marshal(payload)
-		if err != nil {
-			logrus.WithError(err).Fatal("Failed to marshal message")
-		}
-
-		err = c.WriteMessage(websocket.TextMessage, data)
-		if err != nil {
-			logrus.WithError(err).Fatal("Failed to write message after start")
-			return
-		}
+		startInfraConnect(c, infraConnectQuery(infraData, withTraceContext))
 	}()
 
 	for {
@@ -86,6 +75,12 @@ func (req *subscriberRequests) AgentConnect(infraData map[string]string) {
 			continue
 		}
 		if r.Payload.Errors != nil {
+			if withTraceContext && strings.Contains(string(message), "traceContext") {
+				logrus.Warn("Server doesn't support the trace context of the requests, subscribing without it")
+				withTraceContext = false
+				startInfraConnect(c, infraConnectQuery(infraData, withTraceContext))
+				continue
+			}
 			logrus.Error("Error response from the server : ", string(message))
 			panicWhen("ALREADY CONNECTED", message)
 			continue
@@ -98,6 +93,34 @@ func (req *subscriberRequests) AgentConnect(infraData map[string]string) {
 	}
 }
 
+// infraConnectQuery returns the infraConnect subscription of the infra, the trace context of the requests is only
+// requested if withTraceContext is set as it isn't known by the servers older than the tracing support
+func infraConnectQuery(infraData map[string]string, withTraceContext bool) string {
+	traceContextField := ""
+	if withTraceContext {
+		traceContextField = `     traceContext\n`
+	}
+	return `{"query":"subscription {\n    infraConnect(request: {infraID: \"` + infraData["INFRA_ID"] + `\", version: \"` + infraData["VERSION"] + `\", accessKey: \"` + infraData["ACCESS_KEY"] + `\"}) {\n     action{\n      k8sManifest,\n      externalData,\n      requestID\n requestType\n     username\n     namespace\n` + traceContextField + `     }\n  }\n}\n"}`
+}
+
+// startInfraConnect starts the infraConnect subscription with the query on the connection
+func startInfraConnect(c *websocket.Conn, query string) {
+	payload := types.OperationMessage{
+		Payload: []byte(query),
+		Type:    "start",
+	}
+
+	data, err := json.Marshal(payload)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to marshal message")
+	}
+
+	err = c.WriteMessage(websocket.TextMessage, data)
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to write message after start")
+	}
+}
+
 func panicWhen(errorMessage string, message []byte) {
 	if strings.Contains(string(message), errorMessage) {
 		logrus.Panic("Server error: ", errorMessage)
@@ -105,6 +128,14 @@ func panicWhen(errorMessage string, message []byte) {
 }
 
 func (req *subscriberRequests) RequestProcessor(infraData map[string]string, r types.RawData) error {
+	action := r.Payload.Data.InfraConnect.Action
+	_, span := utils.StartRequestSpan(infraData["INFRA_ID"], action.RequestType, action.TraceContext)
+	err := req.processRequest(infraData, r)
+	utils.EndSpan(span, err)
+	return err
+}
+
+func (req *subscriberRequests) processRequest(infraData map[string]string, r types.RawData) error {
 	if strings.Index("kubeobject kubeobjects", strings.ToLower(r.Payload.Data.InfraConnect.Action.RequestType)) >= 0 {
 		KubeObjRequest := types.KubeObjRequest{
 			RequestID: r.Payload.Data.InfraConnect.Action.RequestID,
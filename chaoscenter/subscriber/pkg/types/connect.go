@@ -41,6 +41,8 @@ type Action struct {
 	RequestType  string `json:"requestType"`
 	Username     string `json:"username"`
 	Namespace    string `json:"namespace"`
+	// TraceContext is only sent by the servers with the tracing support, it is empty for the older servers
+	TraceContext string `json:"traceContext"`
 }
 
 type WorkflowSyncExternalData struct {
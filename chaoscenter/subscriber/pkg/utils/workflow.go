@@ -31,6 +31,10 @@ func (utils *subscriberUtils) WorkflowRequest(agentData map[string]string, reque
 		if err != nil {
 			return err
 		}
+		if wfOb == nil {
+			logrus.Info("workflow of the experiment run not found: ", externalData)
+			return nil
+		}
 
 		err = utils.DeleteWorkflow(wfOb.Name, agentData)
 		if err != nil {
@@ -43,6 +47,10 @@ func (utils *subscriberUtils) WorkflowRequest(agentData map[string]string, reque
 		if err != nil {
 			return err
 		}
+		if wfOb == nil {
+			logrus.Info("workflow of the experiment run not found: ", externalData)
+			return nil
+		}
 		err = utils.subscriberEventOperations.StopChaosEngineState(agentData["INFRA_NAMESPACE"], &externalData)
 		if err != nil {
 			logrus.Info("failed to stop chaosEngine for : ", wfOb.Name, " namespace: ", wfOb.Namespace, " : ", err)
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"crypto/tls"
 	"crypto/x509"
 	"encoding/base64"
@@ -29,17 +30,18 @@ import (
 
 var (
 	infraData = map[string]string{
-		"ACCESS_KEY":         os.Getenv("ACCESS_KEY"),
-		"INFRA_ID":           os.Getenv("INFRA_ID"),
-		"SERVER_ADDR":        os.Getenv("SERVER_ADDR"),
-		"IS_INFRA_CONFIRMED": os.Getenv("IS_INFRA_CONFIRMED"),
-		"INFRA_SCOPE":        os.Getenv("INFRA_SCOPE"),
-		"COMPONENTS":         os.Getenv("COMPONENTS"),
-		"INFRA_NAMESPACE":    os.Getenv("INFRA_NAMESPACE"),
-		"VERSION":            os.Getenv("VERSION"),
-		"START_TIME":         os.Getenv("START_TIME"),
-		"SKIP_SSL_VERIFY":    os.Getenv("SKIP_SSL_VERIFY"),
-		"CUSTOM_TLS_CERT":    os.Getenv("CUSTOM_TLS_CERT"),
+		"ACCESS_KEY":                  os.Getenv("ACCESS_KEY"),
+		"INFRA_ID":                    os.Getenv("INFRA_ID"),
+		"SERVER_ADDR":                 os.Getenv("SERVER_ADDR"),
+		"IS_INFRA_CONFIRMED":          os.Getenv("IS_INFRA_CONFIRMED"),
+		"INFRA_SCOPE":                 os.Getenv("INFRA_SCOPE"),
+		"COMPONENTS":                  os.Getenv("COMPONENTS"),
+		"INFRA_NAMESPACE":             os.Getenv("INFRA_NAMESPACE"),
+		"VERSION":                     os.Getenv("VERSION"),
+		"START_TIME":                  os.Getenv("START_TIME"),
+		"SKIP_SSL_VERIFY":             os.Getenv("SKIP_SSL_VERIFY"),
+		"CUSTOM_TLS_CERT":             os.Getenv("CUSTOM_TLS_CERT"),
+		"OTEL_EXPORTER_OTLP_ENDPOINT": os.Getenv("OTEL_EXPORTER_OTLP_ENDPOINT"),
 	}
 
 	err error
@@ -140,6 +142,16 @@ func main() {
 	sigCh := make(chan os.Signal)
 	stream := make(chan types.WorkflowEvent, 10)
 
+	shutdownTracer, err := utils.InitTracer(context.Background(), infraData["OTEL_EXPORTER_OTLP_ENDPOINT"])
+	if err != nil {
+		logrus.WithError(err).Fatal("Failed to set up the tracer")
+	}
+	defer func() {
+		if err := shutdownTracer(context.Background()); err != nil {
+			logrus.WithError(err).Error("Failed to flush the spans")
+		}
+	}()
+
 	subscriberGraphql := graphql.NewSubscriberGql()
 	subscriberK8s := k8s.NewK8sSubscriber(subscriberGraphql)
 	subscriberEvents := events.NewSubscriberEventsOperator(subscriberGraphql, subscriberK8s)
@@ -10,4 +10,6 @@ const (
 	AdminDB                = "admin"
 	UsersCollection        = "users"
 	WorkflowCollection     = "workflow-collection"
+
+	ChaosExperimentRunsCollection = "chaosExperimentRuns"
 )
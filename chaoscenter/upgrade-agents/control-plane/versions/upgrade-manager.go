@@ -11,6 +11,8 @@ import (
 
 	v3_9_0 "github.com/litmuschaos/litmus/chaoscenter/upgrader-agents/control-plane/versions/v3.9.0"
 
+	v3_15_0 "github.com/litmuschaos/litmus/chaoscenter/upgrader-agents/control-plane/versions/v3.15.0"
+
 	"github.com/litmuschaos/litmus/chaoscenter/upgrader-agents/control-plane/pkg/database"
 	log "github.com/sirupsen/logrus"
 	"go.mongodb.org/mongo-driver/mongo"
@@ -129,6 +131,10 @@ func (m *UpgradeManager) getVersionMap() map[string]UpgradeExecutor {
 			NextVersion:    "",
 			VersionManager: v3_9_0.NewVersionManger(m.Logger, m.DBClient),
 		},
+		"3.15.0": {
+			NextVersion:    "",
+			VersionManager: v3_15_0.NewVersionManger(m.Logger, m.DBClient),
+		},
 	}
 }
 
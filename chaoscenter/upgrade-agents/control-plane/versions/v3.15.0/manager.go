@@ -0,0 +1,29 @@
+package v3_15_0
+
+import (
+	"context"
+
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/mongo"
+)
+
+// VersionManager implements IVersionManger
+type VersionManager struct {
+	Logger   *log.Logger
+	DBClient *mongo.Client
+	Context  *context.Context
+}
+
+// NewVersionManger provides a new instance of a new VersionManager
+func NewVersionManger(logger *log.Logger, dbClient *mongo.Client) *VersionManager {
+	return &VersionManager{Logger: logger, DBClient: dbClient}
+}
+
+// Run executes all the steps required for the Version Manger
+// to upgrade from the previous version to `this` version
+func (vm VersionManager) Run() error {
+	// The runs are migrated one by one instead of in a transaction, the collection can be larger
+	// than what a transaction can hold and the migration of a run can safely be run again
+	ctx := context.Background()
+	return upgradeExperimentRunsExecutionData(vm.Logger, vm.DBClient, ctx)
+}
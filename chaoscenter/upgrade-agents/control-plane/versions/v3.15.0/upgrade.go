@@ -0,0 +1,105 @@
+package v3_15_0
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+
+	"github.com/litmuschaos/litmus/chaoscenter/upgrader-agents/control-plane/pkg/database"
+	log "github.com/sirupsen/logrus"
+	"go.mongodb.org/mongo-driver/bson"
+	"go.mongodb.org/mongo-driver/mongo"
+	"go.mongodb.org/mongo-driver/mongo/options"
+)
+
+// batchSize is the number of runs fetched at a time from the database
+const batchSize = 100
+
+type experimentRun struct {
+	ID            interface{} `bson:"_id"`
+	ExecutionData string      `bson:"execution_data"`
+}
+
+// upgradeExperimentRunsExecutionData moves the execution data of the experiment runs to the gzip compressed
+// compressed_execution_data field, the server only stores the execution data of the new runs compressed
+func upgradeExperimentRunsExecutionData(logger *log.Logger, dbClient *mongo.Client, ctx context.Context) error {
+	collection := dbClient.Database(database.LitmusDB).Collection(database.ChaosExperimentRunsCollection)
+
+	logFields := log.Fields{
+		"version":    "3.15.0",
+		"database":   database.LitmusDB,
+		"collection": database.ChaosExperimentRunsCollection,
+	}
+	logger.WithFields(logFields).Info("Compressing the execution data of the experiment runs")
+
+	filter := bson.D{
+		{"execution_data", bson.D{{"$type", "string"}}},
+	}
+	opts := options.Find().SetBatchSize(batchSize).SetProjection(bson.D{{"execution_data", 1}})
+	cursor, err := collection.Find(ctx, filter, opts)
+	if err != nil {
+		logger.WithFields(logFields).Error("Error while fetching the experiment runs in version v3.15.0: ", err)
+		return err
+	}
+	defer cursor.Close(ctx)
+
+	var migrated, removed int64
+	for cursor.Next(ctx) {
+		var run experimentRun
+		if err := cursor.Decode(&run); err != nil {
+			logger.WithFields(logFields).Error("Error while decoding an experiment run in version v3.15.0: ", err)
+			return err
+		}
+
+		update := bson.D{
+			{"$unset", bson.D{{"execution_data", ""}}},
+		}
+		if run.ExecutionData != "" {
+			compressedExecutionData, err := compress(run.ExecutionData)
+			if err != nil {
+				logger.WithFields(logFields).Error("Error while compressing the execution data in version v3.15.0: ", err)
+				return err
+			}
+			update = append(update, bson.E{Key: "$set", Value: bson.D{{"compressed_execution_data", compressedExecutionData}}})
+			migrated++
+		} else {
+			removed++
+		}
+
+		// the run is only updated if its execution data was not changed meanwhile by the server
+		_, err = collection.UpdateOne(ctx, bson.D{
+			{"_id", run.ID},
+			{"execution_data", run.ExecutionData},
+		}, update)
+		if err != nil {
+			logger.WithFields(logFields).Error("Error while updating an experiment run in version v3.15.0: ", err)
+			return err
+		}
+	}
+	if err := cursor.Err(); err != nil {
+		logger.WithFields(logFields).Error("Error while iterating the experiment runs in version v3.15.0: ", err)
+		return err
+	}
+
+	logDocumentsCount := log.Fields{
+		"documents_compressed": migrated,
+		"documents_cleaned":    removed,
+		"version":              "3.15.0",
+	}
+	logger.WithFields(logDocumentsCount).Infof("Compressed the execution data of %v documents and removed the empty execution data of %v documents in chaosExperimentRuns collection", migrated, removed)
+
+	return nil
+}
+
+// compress returns the gzip compressed data, in the format read by the server
+func compress(data string) ([]byte, error) {
+	var buffer bytes.Buffer
+	writer := gzip.NewWriter(&buffer)
+	if _, err := writer.Write([]byte(data)); err != nil {
+		return nil, err
+	}
+	if err := writer.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
@@ -63,6 +63,8 @@ export function listExperimentRunForHistory({
               username
             }
             experimentManifest
+            runSequence
+            createdAt
             updatedAt
             resiliencyScore
             phase
@@ -70,86 +72,6 @@ export function listExperimentRunForHistory({
               faultName
               weightage
             }
-            executionData
-          }
-        }
-      }
-    `,
-    {
-      variables: {
-        projectID,
-        request: {
-          experimentIDs,
-          experimentRunIDs,
-          pagination,
-          sort,
-          filter
-        }
-      },
-      fetchPolicy: options.fetchPolicy ?? 'cache-and-network',
-      ...options
-    }
-  );
-
-  return {
-    data,
-    loading: loading,
-    exists: data ? Boolean(data.listExperimentRun.totalNoOfExperimentRuns) : undefined,
-    ...rest
-  };
-}
-
-export function listExperimentRunWithExecutionData({
-  // String
-  projectID,
-  // Params
-  experimentIDs,
-  experimentRunIDs,
-  pagination,
-  sort,
-  filter,
-  // Options
-  options = {}
-}: GqlAPIQueryRequest<ListExperimentRunResponse, ListExperimentRunRequest>): GqlAPIQueryResponse<
-  ListExperimentRunResponse,
-  ListExperimentRunRequest
-> {
-  const { data, loading, ...rest } = useQuery<ListExperimentRunResponse, ListExperimentRunRequest>(
-    gql`
-      query listExperimentRun($projectID: ID!, $request: ListExperimentRunRequest!) {
-        listExperimentRun(projectID: $projectID, request: $request) {
-          totalNoOfExperimentRuns
-          experimentRuns {
-            experimentRunID
-            experimentID
-            updatedAt
-            infra {
-              infraID
-              name
-              infraNamespace
-              infraScope
-              infraType
-            }
-            experimentName
-            experimentType
-            experimentManifest
-            phase
-            resiliencyScore
-            faultsPassed
-            faultsFailed
-            faultsAwaited
-            faultsStopped
-            faultsNa
-            totalFaults
-            isRemoved
-            updatedBy {
-              username
-            }
-            weightages {
-              faultName
-              weightage
-            }
-            executionData
           }
         }
       }
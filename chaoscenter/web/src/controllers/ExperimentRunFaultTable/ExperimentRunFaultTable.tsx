@@ -0,0 +1,47 @@
+import React from 'react';
+import { useToaster } from '@harnessio/uicore';
+import { getExperimentRun } from '@api/core/experiments/getExperimentRun';
+import { ExecutionData, ExperimentRunStatus } from '@api/entities';
+import { getScope } from '@utils';
+import Loader from '@components/Loader';
+import { MemoisedExperimentRunFaultsTable } from '@views/ExperimentRunHistory/ExperimentRunFaultTable';
+import type { ExperimentRunFaultTableData } from '@controllers/ExperimentRunHistory';
+import { generateFaultTableContent } from '@controllers/ExperimentRunHistory/helpers';
+
+// <!-- execution data is only fetched once a run is expanded in the run history table -->
+export default function ExperimentRunFaultTableController({
+  experimentID,
+  experimentRunID,
+  experimentStatus,
+  weightages
+}: ExperimentRunFaultTableData): React.ReactElement {
+  const scope = getScope();
+  const { showError } = useToaster();
+
+  const { data: experimentRunData, loading } = getExperimentRun({
+    ...scope,
+    experimentRunID,
+    options: {
+      skip: !experimentRunID,
+      onError: error => showError(error.message),
+      pollInterval: experimentStatus === ExperimentRunStatus.RUNNING ? 10000 : 0
+    }
+  });
+
+  const executionData = experimentRunData?.getExperimentRun?.executionData;
+  const content = React.useMemo(() => {
+    if (!executionData) return [];
+    const parsedExecutionData = JSON.parse(executionData) as ExecutionData;
+    return generateFaultTableContent(weightages, parsedExecutionData.nodes);
+  }, [executionData, weightages]);
+
+  return (
+    <Loader loading={loading && !experimentRunData} small>
+      <MemoisedExperimentRunFaultsTable
+        experimentID={experimentID}
+        experimentRunID={experimentRunID}
+        content={content}
+      />
+    </Loader>
+  );
+}
@@ -0,0 +1,3 @@
+import ExperimentRunFaultTableController from './ExperimentRunFaultTable';
+
+export default ExperimentRunFaultTableController;
@@ -52,11 +52,8 @@ const Tooltip = ({ experimentRun }: { experimentRun: ExperimentRun }): React.Rea
   );
 };
 
-function generateColumnGraphData(
-  experimentRunsWithExecutionData: Array<ExperimentRun>,
-  paths: UseRouteDefinitionsProps
-): ColumnData[] {
-  const content: ColumnData[] = experimentRunsWithExecutionData.map(individualRun => {
+function generateColumnGraphData(experimentRuns: Array<ExperimentRun>, paths: UseRouteDefinitionsProps): ColumnData[] {
+  const content: ColumnData[] = experimentRuns.map(individualRun => {
     return {
       color: Utils.getRealCSSColor(getColorBasedOnResilienceScore(individualRun.resiliencyScore).primary),
       height: individualRun.resiliencyScore ?? 0,
@@ -115,19 +112,19 @@ export default function ExperimentRunHistoryController(): React.ReactElement {
   });
 
   const totalExperimentRuns = experimentRunData?.listExperimentRun?.totalNoOfExperimentRuns;
-  const experimentRunsWithExecutionData = experimentRunData?.listExperimentRun?.experimentRuns;
-  const experimentName = experimentRunsWithExecutionData?.[0]?.experimentName;
-  const experimentPhase = experimentRunsWithExecutionData?.[0]?.phase;
-  const experimentType = experimentRunsWithExecutionData?.[0]?.experimentType;
-  const experimentManifest = experimentRunsWithExecutionData?.[0]?.experimentManifest;
+  const experimentRuns = experimentRunData?.listExperimentRun?.experimentRuns;
+  const experimentName = experimentRuns?.[0]?.experimentName;
+  const experimentPhase = experimentRuns?.[0]?.phase;
+  const experimentType = experimentRuns?.[0]?.experimentType;
+  const experimentManifest = experimentRuns?.[0]?.experimentManifest;
 
   React.useEffect(() => {
     if (experimentName) setExperimentNamePersistent(experimentName);
   }, [experimentName]),
     [experimentName];
 
-  const experimentRunsTableData: ExperimentRunHistoryTableProps | undefined = experimentRunsWithExecutionData && {
-    content: generateExperimentRunTableContent(experimentRunsWithExecutionData),
+  const experimentRunsTableData: ExperimentRunHistoryTableProps | undefined = experimentRuns && {
+    content: generateExperimentRunTableContent(experimentRuns),
     pagination: {
       gotoPage: event => setPage(event),
       itemCount: totalExperimentRuns ?? 0,
@@ -139,8 +136,7 @@ export default function ExperimentRunHistoryController(): React.ReactElement {
     }
   };
 
-  const experimentRunsColumnGraphData =
-    experimentRunsWithExecutionData && generateColumnGraphData(experimentRunsWithExecutionData, paths);
+  const experimentRunsColumnGraphData = experimentRuns && generateColumnGraphData(experimentRuns, paths);
 
   const filterProps: FilterProps = {
     state,
@@ -152,8 +148,7 @@ export default function ExperimentRunHistoryController(): React.ReactElement {
 
   const parsedManifest = experimentManifest && JSON.parse(experimentManifest);
 
-  const isCronEnabled =
-    experimentRunsWithExecutionData && experimentType === ExperimentType.CRON && cronEnabled(parsedManifest);
+  const isCronEnabled = experimentRuns && experimentType === ExperimentType.CRON && cronEnabled(parsedManifest);
 
   const rightSideBarV2 = (
     <RightSideBarV2
@@ -1,4 +1,5 @@
-import type { Weightages, Nodes, ExperimentRun, ExecutionData } from '@api/entities';
+import { ExperimentRunStatus } from '@api/entities';
+import type { Weightages, Nodes, ExperimentRun } from '@api/entities';
 import { calculateTotalProbeStatusFromChaosData, handleTimestampAmbiguity, isValidNodeType } from '@utils';
 import type { ExperimentRunFaultDetails, ExperimentRunDetails } from './types';
 
@@ -37,28 +38,26 @@ export function generateFaultTableContent(
   return content;
 }
 
-export function generateExperimentRunTableContent(
-  experimentRunsWithExecutionData: Array<ExperimentRun>
-): Array<ExperimentRunDetails> {
-  const experimentRunsWithNonEmptyExecutionData = experimentRunsWithExecutionData.filter(
-    individualRun => individualRun.executionData !== ''
-  );
-  const content: Array<ExperimentRunDetails> = experimentRunsWithNonEmptyExecutionData.map(individualRun => {
-    const experimentRunExecutionData = JSON.parse(individualRun.executionData) as ExecutionData;
+// <!-- execution data is loaded per run by the fault table, only the run metadata is listed here -->
+export function generateExperimentRunTableContent(experimentRuns: Array<ExperimentRun>): Array<ExperimentRunDetails> {
+  const content: Array<ExperimentRunDetails> = experimentRuns.map(individualRun => {
+    const isRunActive =
+      individualRun.phase === ExperimentRunStatus.RUNNING || individualRun.phase === ExperimentRunStatus.QUEUED;
     return {
       experimentID: individualRun.experimentID,
-      experimentRunName: experimentRunExecutionData.name,
+      experimentRunName: `${individualRun.experimentName}-${individualRun.runSequence ?? ''}`,
       experimentRunID: individualRun.experimentRunID,
       experimentStatus: individualRun.phase,
       executedBy: individualRun.updatedBy,
       resilienceScore: individualRun.resiliencyScore,
-      startedAt: parseInt(handleTimestampAmbiguity(experimentRunExecutionData?.startedAt)),
-      finishedAt: parseInt(handleTimestampAmbiguity(experimentRunExecutionData?.finishedAt)),
+      startedAt: individualRun.phase === ExperimentRunStatus.QUEUED ? NaN : parseInt(individualRun.createdAt ?? ''),
+      finishedAt: isRunActive ? NaN : parseInt(individualRun.updatedAt ?? ''),
       executedAt: parseInt(individualRun.updatedAt ?? ''),
       faultTableData: {
         experimentID: individualRun.experimentID,
         experimentRunID: individualRun.experimentRunID,
-        content: generateFaultTableContent(individualRun.weightages, experimentRunExecutionData.nodes)
+        experimentStatus: individualRun.phase,
+        weightages: individualRun.weightages
       }
     };
   });
@@ -1,5 +1,5 @@
 import type { PaginationProps } from '@harnessio/uicore';
-import type { ExperimentRunFaultStatus, ExperimentRunStatus, UserDetails } from '@api/entities';
+import type { ExperimentRunFaultStatus, ExperimentRunStatus, UserDetails, Weightages } from '@api/entities';
 
 interface ProbeStatus {
   passed: number;
@@ -22,6 +22,13 @@ export interface ExperimentRunFaultDetailsTableProps {
   content: Array<ExperimentRunFaultDetails>;
 }
 
+export interface ExperimentRunFaultTableData {
+  experimentID: string;
+  experimentRunID: string;
+  experimentStatus: ExperimentRunStatus;
+  weightages: Array<Weightages>;
+}
+
 export interface ExperimentRunDetails {
   experimentID: string;
   experimentRunName: string;
@@ -32,7 +39,7 @@ export interface ExperimentRunDetails {
   startedAt: number;
   finishedAt: number;
   executedAt: number;
-  faultTableData: ExperimentRunFaultDetailsTableProps;
+  faultTableData: ExperimentRunFaultTableData;
 }
 
 export interface ExperimentRunHistoryTableProps {
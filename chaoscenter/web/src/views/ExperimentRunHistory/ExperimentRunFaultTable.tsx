@@ -133,7 +133,7 @@ const ExperimentRunFaultsTable = ({ content, ...props }: ExperimentRunFaultDetai
       }
     ];
     // eslint-disable-next-line react-hooks/exhaustive-deps
-  }, []);
+  }, [content.length]);
 
   return (
     <Container onClick={killEvent} style={{ backgroundColor: 'var(--grey-50)', borderRadius: 6 }}>
@@ -13,7 +13,7 @@ import CopyButton from '@components/CopyButton';
 import StatusBadgeV2, { StatusBadgeEntity } from '@components/StatusBadgeV2';
 import { ExperimentRunStatus } from '@api/entities';
 import Duration from '@components/Duration';
-import { MemoisedExperimentRunFaultsTable } from './ExperimentRunFaultTable';
+import ExperimentRunFaultTableController from '@controllers/ExperimentRunFaultTable';
 import css from './ExperimentRunHistoryTable.module.scss';
 
 const ExperimentRunHistoryTable = ({ content, pagination }: ExperimentRunHistoryTableProps): React.ReactElement => {
@@ -198,7 +198,7 @@ const ExperimentRunHistoryTable = ({ content, pagination }: ExperimentRunHistory
       pagination={pagination}
       sortable
       renderRowSubComponent={({ row: { original: data } }: { row: Row<ExperimentRunDetails> }) => (
-        <MemoisedExperimentRunFaultsTable {...data.faultTableData} />
+        <ExperimentRunFaultTableController {...data.faultTableData} />
       )}
       onRowClick={rowDetails =>
         rowDetails.experimentRunID &&